@@ -18,6 +18,7 @@ import (
 	"github.com/rafaelsanzio/go-flashscore/pkg/config"
 	"github.com/rafaelsanzio/go-flashscore/pkg/config/key"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 	"github.com/rafaelsanzio/go-flashscore/pkg/store"
 )
 
@@ -57,6 +58,11 @@ func main() {
 
 	log.Println("MongoDB server is healthy.")
 
+	err_ = repo.EnsureTournamentIndexes(ctx)
+	if err_ != nil {
+		_ = err_.Annotatef(applog.Log, "unable to create tournament indexes: %v", err_)
+	}
+
 	appPort, err_ := config.Value(key.AppPort)
 	if err_ != nil {
 		_ = err_.Annotatef(applog.Log, "unable to get app port config: %v", err_)
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandleEventMatchCorrection(ctx context.Context, data map[string]string) errs.AppError {
+	tournamentID := data["tournamentID"]
+	matchID := data["matchID"]
+	eventID := data["eventID"]
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
+	if err != nil || tournament == nil {
+		return errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, tournamentID)
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournamentID)
+	if err != nil || match == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	correction, err := repo.GetEventRepo().Get(ctx, eventID)
+	if err != nil || correction == nil || correction.MatchID != match.ID {
+		return errs.ErrEventIsNotFound.Throwf(applog.Log, errs.ErrFmt, eventID)
+	}
+
+	err = match.ApplyEvent(*correction)
+	if err != nil {
+		return err
+	}
+
+	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
+	if err != nil || matchUpdated == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	if matchUpdated.Status != model.MatchStatusFinished {
+		return nil
+	}
+
+	return refreshStandings(ctx, *tournament)
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockGetRetractionEventFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	e := event.New("1", prototype.PrototypeMatch().ID, event.Retraction{EventID: "any-event-id", Reason: "Offside"})
+	return &e, nil
+}
+
+func mockGetEventFromAnotherMatchFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	e := event.New("1", "another-match-id", event.Retraction{EventID: "any-event-id", Reason: "Offside"})
+	return &e, nil
+}
+
+func mockGetEventThrowFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func mockFindMatchFinishedForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusFinished
+	return &matchMock, nil
+}
+
+func TestHandleEventMatchCorrection(t *testing.T) {
+	ctx := context.Background()
+
+	data := map[string]string{
+		"matchEventType": "Retraction",
+		"tournamentID":   "any-tournament-id",
+		"matchID":        "any-match-id",
+		"eventID":        "any-retraction-id",
+	}
+
+	testCases := []struct {
+		Name                             string
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleUpdateMatchFunc            func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleGetEventFunc               func(ctx context.Context, id string) (*event.Event, errs.AppError)
+		HandleSaveStandingsFunc          func(ctx context.Context, s match.TournamentStandings) errs.AppError
+		ExpectedError                    bool
+		ExpectedStandingsSaved           bool
+	}{
+		{
+			Name:                             "Handle event match correction correct",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetRetractionEventFunc,
+			ExpectedError:                    false,
+		}, {
+			Name:                             "Handle event match correction throw error on get tournament function",
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetRetractionEventFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match correction throw error on find match for tournament function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandleGetEventFunc:               mockGetRetractionEventFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match correction throw error on get event function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetEventThrowFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match correction with event from another match",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetEventFromAnotherMatchFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match correction throw error on update match function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetRetractionEventFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match correction of a finished match refreshes the standings",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchFinishedForTournamentFunc,
+			HandleGetEventFunc:               mockGetRetractionEventFunc,
+			HandleSaveStandingsFunc:          mockSaveStandingsFunc,
+			ExpectedError:                    false,
+			ExpectedStandingsSaved:           true,
+		}, {
+			Name:                             "Handle event match correction of a finished match throw error on save standings function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchFinishedForTournamentFunc,
+			HandleGetEventFunc:               mockGetRetractionEventFunc,
+			HandleSaveStandingsFunc:          mockSaveStandingsThrowFunc,
+			ExpectedError:                    true,
+			ExpectedStandingsSaved:           true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc:                 tc.HandleUpdateMatchFunc,
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+			ListByTournamentFunc:       mockListMatchByTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		saved := false
+		repo.SetStandingsRepo(repo.MockStandingsRepo{
+			SaveFunc: func(ctx context.Context, s match.TournamentStandings) errs.AppError {
+				saved = true
+				return tc.HandleSaveStandingsFunc(ctx, s)
+			},
+		})
+		defer repo.SetStandingsRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			GetFunc: tc.HandleGetEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		err := HandleEventMatchCorrection(ctx, data)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, tc.ExpectedStandingsSaved, saved)
+	}
+}
@@ -2,17 +2,17 @@ package handlers
 
 import (
 	"context"
-	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
-	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 )
 
 func HandleEventMatchExtratime(ctx context.Context, data map[string]string) errs.AppError {
 	tournamentID := data["tournamentID"]
 	matchID := data["matchID"]
+	eventID := data["eventID"]
 	extratime := data["extratime"]
 
 	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
@@ -30,16 +30,20 @@ func HandleEventMatchExtratime(ctx context.Context, data map[string]string) errs
 		return errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, err_.Error())
 	}
 
-	events := struct {
-		MatchEvent model.EventsMatchType
-		Extratime  int
-		Created    time.Time
-	}{
-		MatchEvent: model.EventExtratime,
-		Extratime:  extratimeAsInt,
-		Created:    time.Now(),
+	period, err := parsePeriod(data["period"])
+	if err != nil {
+		return err
+	}
+
+	matchEvent := event.New(tournamentID, matchID, event.Extratime{
+		Period:    period,
+		Extratime: extratimeAsInt,
+	})
+	setEventID(&matchEvent, eventID)
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
 	}
-	match.Events = append(match.Events, events)
 
 	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
 	if err != nil || matchUpdated == nil {
@@ -33,21 +33,21 @@ func TestHandleEventMatchExtratime(t *testing.T) {
 			Name:                             "Handle event match extratime correct",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
 			ExpectedError:                    false,
 		}, {
 			Name:                             "Handle event match extratime throw error on get tournament function",
 			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
 			ExpectedError:                    true,
 		}, {
 			Name:                             "Handle event match extratime throw error on update match function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
 			ExpectedError:                    true,
 		}, {
@@ -61,7 +61,7 @@ func TestHandleEventMatchExtratime(t *testing.T) {
 			Name:                             "Handle event match extratime throw error on strconv Atoi function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			StrconvAtoiFunc:                  fakeStrconvAtoi,
 			ExpectedError:                    true,
 		},
@@ -2,18 +2,32 @@ package handlers
 
 import (
 	"context"
-	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
 )
 
 func HandleEventMatchFinish(ctx context.Context, data map[string]string) errs.AppError {
 	tournamentID := data["tournamentID"]
 	matchID := data["matchID"]
+	eventID := data["eventID"]
 	timeFinished := data["timeFinished"]
+	decidedBy := data["decidedBy"]
+
+	attendance := 0
+	if data["attendance"] != "" {
+		var err_ error
+		attendance, err_ = strconvAtoi(data["attendance"])
+		if err_ != nil {
+			return errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+		}
+	}
 
 	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
 	if err != nil || tournament == nil {
@@ -25,23 +39,86 @@ func HandleEventMatchFinish(ctx context.Context, data map[string]string) errs.Ap
 		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, err.Error())
 	}
 
-	events := struct {
-		MatchEvent   model.EventsMatchType
-		TimeFinished string
-		Created      time.Time
-	}{
-		MatchEvent:   model.EventFinish,
-		TimeFinished: timeFinished,
-		Created:      time.Now(),
+	if decidedBy == "" {
+		decidedBy = string(match.Decision())
 	}
-	match.Events = append(match.Events, events)
 
-	match.Status = model.MatchStatusFinished
+	matchEvent := event.New(tournamentID, matchID, event.Finish{
+		TimeFinished: timeFinished,
+		DecidedBy:    model.MatchDecision(decidedBy),
+		Attendance:   attendance,
+	})
+	setEventID(&matchEvent, eventID)
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
+	}
 
 	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
 	if err != nil || matchUpdated == nil {
 		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, err.Error())
 	}
 
+	err = advanceBracket(ctx, *matchUpdated)
+	if err != nil {
+		return err
+	}
+
+	return refreshStandings(ctx, *tournament)
+}
+
+// advanceBracket fills the next bracket matches with the winner of the
+// finished match, and with the loser when it plays for the third place.
+func advanceBracket(ctx context.Context, mt match.Match) errs.AppError {
+	winner, loser, ok := mt.Result()
+	if mt.Bracket == nil || !ok {
+		return nil
+	}
+
+	advancing := []struct {
+		slot *match.Slot
+		team team.Team
+	}{
+		{slot: mt.Bracket.Winner, team: winner},
+		{slot: mt.Bracket.Loser, team: loser},
+	}
+
+	for _, a := range advancing {
+		if a.slot == nil {
+			continue
+		}
+
+		next, err := repo.GetMatchRepo().FindMatchForTournament(ctx, a.slot.MatchID, mt.Tournament.ID)
+		if err != nil || next == nil {
+			return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, a.slot.MatchID)
+		}
+
+		err = next.FillSlot(a.slot.Home, a.team)
+		if err != nil {
+			return err
+		}
+
+		_, err = repo.GetMatchRepo().Update(ctx, *next)
+		if err != nil {
+			return errs.ErrHandlingGameEventFinish.Throwf(applog.Log, errs.ErrFmt, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// refreshStandings stores the table of the tournament with the matches as they
+// are now, the api computes it on each request and never waits on it.
+func refreshStandings(ctx context.Context, t tournament.Tournament) errs.AppError {
+	matches, err := match.FindMatches(ctx, repo.GetMatchRepo(), t.ID, "")
+	if err != nil {
+		return errs.ErrHandlingGameEventFinish.Throwf(applog.Log, errs.ErrFmt, err.Error())
+	}
+
+	err = repo.GetStandingsRepo().Save(ctx, match.NewTournamentStandings(t, matches))
+	if err != nil {
+		return errs.ErrHandlingGameEventFinish.Throwf(applog.Log, errs.ErrFmt, err.Error())
+	}
+
 	return nil
 }
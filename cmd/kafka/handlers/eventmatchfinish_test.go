@@ -7,11 +7,34 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
 	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
 )
 
+func mockListMatchByTournamentFunc(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	matchMock, err := mockFindMatchInProgressForTournamentFunc(ctx, "any-match-id", tournamentID)
+	matchMock.Status = model.MatchStatusFinished
+	return []match.Match{*matchMock}, err
+}
+
+func mockListMatchByTournamentThrowFunc(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func mockSaveStandingsFunc(ctx context.Context, s match.TournamentStandings) errs.AppError {
+	return nil
+}
+
+func mockSaveStandingsThrowFunc(ctx context.Context, s match.TournamentStandings) errs.AppError {
+	return errs.ErrRepoMockAction
+}
+
 func TestHandleEventMatchFinish(t *testing.T) {
 	ctx := context.Background()
 
@@ -26,31 +49,65 @@ func TestHandleEventMatchFinish(t *testing.T) {
 		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
 		HandleUpdateMatchFunc            func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
 		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleListMatchFunc              func(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError)
+		HandleSaveStandingsFunc          func(ctx context.Context, s match.TournamentStandings) errs.AppError
 		ExpectedError                    bool
 	}{
 		{
 			Name:                             "Handle event match finish correct",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleListMatchFunc:              mockListMatchByTournamentFunc,
+			HandleSaveStandingsFunc:          mockSaveStandingsFunc,
 			ExpectedError:                    false,
 		}, {
 			Name:                             "Handle event match finish throw error on get tournament function",
 			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleListMatchFunc:              mockListMatchByTournamentFunc,
+			HandleSaveStandingsFunc:          mockSaveStandingsFunc,
 			ExpectedError:                    true,
 		}, {
 			Name:                             "Handle event match finish throw error on update match function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleListMatchFunc:              mockListMatchByTournamentFunc,
+			HandleSaveStandingsFunc:          mockSaveStandingsFunc,
 			ExpectedError:                    true,
 		}, {
 			Name:                             "Handle event match finish throw error on find match fot tournament function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
 			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandleListMatchFunc:              mockListMatchByTournamentFunc,
+			HandleSaveStandingsFunc:          mockSaveStandingsFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match finish throw error on illegal transition when match is not in progress",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleListMatchFunc:              mockListMatchByTournamentFunc,
+			HandleSaveStandingsFunc:          mockSaveStandingsFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match finish throw error on list match function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleListMatchFunc:              mockListMatchByTournamentThrowFunc,
+			HandleSaveStandingsFunc:          mockSaveStandingsFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match finish throw error on save standings function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleListMatchFunc:              mockListMatchByTournamentFunc,
+			HandleSaveStandingsFunc:          mockSaveStandingsThrowFunc,
 			ExpectedError:                    true,
 		},
 	}
@@ -66,9 +123,15 @@ func TestHandleEventMatchFinish(t *testing.T) {
 		repo.SetMatchRepo(repo.MockMatchRepo{
 			UpdateFunc:                 tc.HandleUpdateMatchFunc,
 			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+			ListByTournamentFunc:       tc.HandleListMatchFunc,
 		})
 		defer repo.SetMatchRepo(nil)
 
+		repo.SetStandingsRepo(repo.MockStandingsRepo{
+			SaveFunc: tc.HandleSaveStandingsFunc,
+		})
+		defer repo.SetStandingsRepo(nil)
+
 		err := HandleEventMatchFinish(ctx, data)
 		if tc.ExpectedError {
 			assert.NotNil(t, err)
@@ -78,3 +141,214 @@ func TestHandleEventMatchFinish(t *testing.T) {
 	}
 
 }
+
+func TestHandleEventMatchFinishAttendance(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name               string
+		Attendance         string
+		Capacity           int
+		ExpectedAttendance int
+		ExpectedError      bool
+	}{
+		{
+			Name:               "Handle event match finish recording the attendance",
+			Attendance:         "45000",
+			Capacity:           81044,
+			ExpectedAttendance: 45000,
+		}, {
+			Name:          "Handle event match finish throw error on attendance over capacity",
+			Attendance:    "90000",
+			Capacity:      81044,
+			ExpectedError: true,
+		}, {
+			Name:          "Handle event match finish throw error on invalid attendance",
+			Attendance:    "full",
+			Capacity:      81044,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		var updated *match.Match
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: mockGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc: func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
+				updated = &mt
+				return &mt, nil
+			},
+			FindMatchForTournamentFunc: func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+				matchMock, err := mockFindMatchInProgressForTournamentFunc(ctx, id, tournamentID)
+				matchMock.Venue = &venue.Venue{ID: "1", Capacity: tc.Capacity}
+				return matchMock, err
+			},
+			ListByTournamentFunc: mockListMatchByTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetStandingsRepo(repo.MockStandingsRepo{
+			SaveFunc: mockSaveStandingsFunc,
+		})
+		defer repo.SetStandingsRepo(nil)
+
+		err := HandleEventMatchFinish(ctx, map[string]string{
+			"tournamentID": "any-tournament-id",
+			"matchID":      "any-match-id",
+			"timeFinished": "17:50",
+			"attendance":   tc.Attendance,
+		})
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+			assert.Nil(t, updated)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.ExpectedAttendance, updated.Attendance)
+	}
+}
+
+func TestHandleEventMatchFinishBracket(t *testing.T) {
+	ctx := context.Background()
+
+	home := team.Team{ID: "home", Name: "Home"}
+	away := team.Team{ID: "away", Name: "Away"}
+
+	repo.SetTournamentRepo(repo.MockTournamentRepo{
+		GetFunc: mockGetTournamentFunc,
+	})
+	defer repo.SetTournamentRepo(nil)
+
+	var matches map[string]match.Match
+
+	repo.SetMatchRepo(repo.MockMatchRepo{
+		UpdateFunc: func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
+			matches[mt.ID] = mt
+			return &mt, nil
+		},
+		FindMatchForTournamentFunc: func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+			mt, ok := matches[id]
+			if !ok {
+				return nil, nil
+			}
+			return &mt, nil
+		},
+		ListByTournamentFunc: func(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+			list := []match.Match{}
+			for _, mt := range matches {
+				list = append(list, mt)
+			}
+			return list, nil
+		},
+	})
+	defer repo.SetMatchRepo(nil)
+
+	repo.SetStandingsRepo(repo.MockStandingsRepo{
+		SaveFunc: mockSaveStandingsFunc,
+	})
+	defer repo.SetStandingsRepo(nil)
+
+	semi, err := mockFindMatchInProgressForTournamentFunc(ctx, "semi", "any-tournament-id")
+	assert.Nil(t, err)
+	semi.ID = "semi"
+	semi.HomeTeam = home
+	semi.AwayTeam = away
+	semi.Bracket = &match.Bracket{
+		Winner: &match.Slot{MatchID: "final", Home: true},
+		Loser:  &match.Slot{MatchID: "third-place", Home: false},
+	}
+
+	final := match.Match{ID: "final", Tournament: semi.Tournament, Status: model.MatchStatusNotStart, Bracket: &match.Bracket{}}
+	thirdPlace := match.Match{ID: "third-place", Tournament: semi.Tournament, Status: model.MatchStatusNotStart, Bracket: &match.Bracket{ThirdPlace: true}}
+
+	data := map[string]string{
+		"tournamentID": "any-tournament-id",
+		"matchID":      "semi",
+		"timeFinished": "17:50",
+	}
+
+	t.Log("Handle event match finish of a level bracket match throw error")
+	matches = map[string]match.Match{"semi": *semi, "final": final, "third-place": thirdPlace}
+	err = HandleEventMatchFinish(ctx, data)
+	assert.NotNil(t, err)
+	assert.Empty(t, matches["final"].HomeTeam.ID)
+
+	t.Log("Handle event match finish of a bracket match advances the winner and the loser")
+	assert.Nil(t, semi.ApplyEvent(event.New("any-tournament-id", "semi", event.Goal{Team: away, Player: player.Player{ID: "p1"}, Minute: 30})))
+	matches = map[string]match.Match{"semi": *semi, "final": final, "third-place": thirdPlace}
+	err = HandleEventMatchFinish(ctx, data)
+	assert.Nil(t, err)
+	assert.Equal(t, away, matches["final"].HomeTeam)
+	assert.Empty(t, matches["final"].AwayTeam.ID)
+	assert.Equal(t, home, matches["third-place"].AwayTeam)
+
+	t.Log("Handle event match finish of a bracket match throw error when the next match is not found")
+	matches = map[string]match.Match{"semi": *semi, "final": final}
+	err = HandleEventMatchFinish(ctx, data)
+	assert.NotNil(t, err)
+}
+
+func TestHandleEventMatchFinishStandings(t *testing.T) {
+	ctx := context.Background()
+
+	home := team.Team{ID: "home", Name: "Home"}
+	away := team.Team{ID: "away", Name: "Away"}
+
+	var updated match.Match
+	var saved *match.TournamentStandings
+
+	repo.SetTournamentRepo(repo.MockTournamentRepo{
+		GetFunc: mockGetTournamentFunc,
+	})
+	defer repo.SetTournamentRepo(nil)
+
+	repo.SetMatchRepo(repo.MockMatchRepo{
+		UpdateFunc: func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
+			updated = mt
+			return &mt, nil
+		},
+		FindMatchForTournamentFunc: func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+			matchMock, err := mockFindMatchInProgressForTournamentFunc(ctx, id, tournamentID)
+			matchMock.HomeTeam = home
+			matchMock.AwayTeam = away
+			_ = matchMock.ApplyEvent(event.New(tournamentID, id, event.Goal{Team: home, Player: player.Player{ID: "p1"}, Minute: 30}))
+			return matchMock, err
+		},
+		ListByTournamentFunc: func(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+			return []match.Match{updated}, nil
+		},
+	})
+	defer repo.SetMatchRepo(nil)
+
+	repo.SetStandingsRepo(repo.MockStandingsRepo{
+		SaveFunc: func(ctx context.Context, s match.TournamentStandings) errs.AppError {
+			saved = &s
+			return nil
+		},
+	})
+	defer repo.SetStandingsRepo(nil)
+
+	err := HandleEventMatchFinish(ctx, map[string]string{
+		"tournamentID": "any-tournament-id",
+		"matchID":      "any-match-id",
+		"timeFinished": "17:50",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, saved)
+	assert.Equal(t, updated.Tournament.ID, saved.ID)
+
+	points := map[string]int{}
+	for _, row := range saved.Standings {
+		points[row.TeamID] = row.Points
+	}
+	assert.Equal(t, 3, points["home"])
+	assert.Equal(t, 0, points["away"])
+}
@@ -2,22 +2,24 @@ package handlers
 
 import (
 	"context"
-	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 	"github.com/rafaelsanzio/go-flashscore/pkg/player"
-	"github.com/rafaelsanzio/go-flashscore/pkg/team"
 )
 
 func HandleEventMatchGoal(ctx context.Context, data map[string]string) errs.AppError {
 	tournamentID := data["tournamentID"]
 	matchID := data["matchID"]
+	eventID := data["eventID"]
 	teamScoreID := data["teamScore"]
 	playerScoreID := data["player"]
 	goalMinute := data["goalMinute"]
+	goalTypeName := data["goalType"]
+	assistID := data["assist"]
 
 	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
 	if err != nil || tournament == nil {
@@ -34,9 +36,30 @@ func HandleEventMatchGoal(ctx context.Context, data map[string]string) errs.AppE
 		return errs.ErrTeamIsNotFound.Throwf(applog.Log, errs.ErrFmt, err.Error())
 	}
 
-	playerScore, err := repo.GetPlayerRepo().GetTeamPlayer(ctx, playerScoreID, teamScore.ID)
+	goalType := model.GoalOpenPlay
+	if goalTypeName != "" {
+		err_ := goalType.UnmarshalText([]byte(goalTypeName))
+		if err_ != nil {
+			return errs.ErrConvertingPayload.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+		}
+	}
+
+	playerTeamID := teamScore.ID
+	if goalType == model.GoalOwnGoal {
+		playerTeamID = match.OpposingTeam(teamScore.ID).ID
+	}
+
+	playerScore, err := repo.GetPlayerRepo().GetTeamPlayer(ctx, playerScoreID, playerTeamID)
 	if err != nil || playerScore == nil {
-		return errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmt, err.Error())
+		return errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, playerTeamID, playerScoreID)
+	}
+
+	var assist *player.Player
+	if assistID != "" {
+		assist, err = repo.GetPlayerRepo().GetTeamPlayer(ctx, assistID, teamScore.ID)
+		if err != nil || assist == nil {
+			return errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, teamScore.ID, assistID)
+		}
 	}
 
 	goalMinuteAsInt, err_ := strconvAtoi(goalMinute)
@@ -44,20 +67,30 @@ func HandleEventMatchGoal(ctx context.Context, data map[string]string) errs.AppE
 		return errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, err_.Error())
 	}
 
-	events := struct {
-		MatchEvent model.EventsMatchType
-		TeamScore  team.Team
-		Player     player.Player
-		GoalMinute int
-		Created    time.Time
-	}{
-		MatchEvent: model.EventGoal,
-		TeamScore:  *teamScore,
-		Player:     *playerScore,
-		GoalMinute: goalMinuteAsInt,
-		Created:    time.Now(),
+	period, err := parsePeriod(data["period"])
+	if err != nil {
+		return err
+	}
+
+	stoppage, err := parseStoppage(data["stoppage"])
+	if err != nil {
+		return err
+	}
+
+	matchEvent := event.New(tournamentID, matchID, event.Goal{
+		Team:     *teamScore,
+		Player:   *playerScore,
+		Period:   period,
+		Minute:   goalMinuteAsInt,
+		Stoppage: stoppage,
+		Type:     goalType,
+		Assist:   assist,
+	})
+	setEventID(&matchEvent, eventID)
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
 	}
-	match.Events = append(match.Events, events)
 
 	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
 	if err != nil || matchUpdated == nil {
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 	"github.com/rafaelsanzio/go-flashscore/pkg/player"
 	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
@@ -24,6 +26,14 @@ func mockGetTeamPlayerThrowFunc(ctx context.Context, id, teamID string) (*player
 	return nil, errs.ErrRepoMockAction
 }
 
+func mockGetTeamPlayerOnlyScorerFunc(ctx context.Context, id, teamID string) (*player.Player, errs.AppError) {
+	if id != "any-player-id" {
+		return nil, nil
+	}
+	playerMock := prototype.PrototypePlayer()
+	return &playerMock, nil
+}
+
 func TestHandleEventMatchGoal(t *testing.T) {
 	ctx := context.Background()
 
@@ -50,7 +60,7 @@ func TestHandleEventMatchGoal(t *testing.T) {
 			Name:                             "Handle event match goal correct",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -59,7 +69,7 @@ func TestHandleEventMatchGoal(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get tournament function",
 			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -68,7 +78,7 @@ func TestHandleEventMatchGoal(t *testing.T) {
 			Name:                             "Handle event match goal throw error on update match function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -86,7 +96,7 @@ func TestHandleEventMatchGoal(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get team function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamThrowFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -95,7 +105,7 @@ func TestHandleEventMatchGoal(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get player function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -104,7 +114,7 @@ func TestHandleEventMatchGoal(t *testing.T) {
 			Name:                             "Handle event match goal throw error on strconv Atoi function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  fakeStrconvAtoi,
@@ -148,3 +158,271 @@ func TestHandleEventMatchGoal(t *testing.T) {
 	}
 
 }
+
+func TestHandleEventMatchGoalTypes(t *testing.T) {
+	ctx := context.Background()
+
+	data := func(goalType, assist string) map[string]string {
+		return map[string]string{
+			"matchEventType": "Goal",
+			"tournamentID":   "any-tournament-id",
+			"matchID":        "any-match-id",
+			"teamScore":      "any-team-id",
+			"player":         "any-player-id",
+			"goalMinute":     "10",
+			"goalType":       goalType,
+			"assist":         assist,
+		}
+	}
+
+	testCases := []struct {
+		Name                    string
+		Data                    map[string]string
+		HandleGetTeamPlayerFunc func(ctx context.Context, id, teamID string) (*player.Player, errs.AppError)
+		ExpectedError           bool
+	}{
+		{
+			Name:                    "Handle event match goal with header and assist",
+			Data:                    data("Header", "any-assist-id"),
+			HandleGetTeamPlayerFunc: mockGetTeamPlayerFunc,
+			ExpectedError:           false,
+		}, {
+			Name:                    "Handle event match own goal",
+			Data:                    data("OwnGoal", ""),
+			HandleGetTeamPlayerFunc: mockGetTeamPlayerFunc,
+			ExpectedError:           false,
+		}, {
+			Name:                    "Handle event match goal with unknown goal type",
+			Data:                    data("Volley", ""),
+			HandleGetTeamPlayerFunc: mockGetTeamPlayerFunc,
+			ExpectedError:           true,
+		}, {
+			Name:                    "Handle event match goal throw error on get assist function",
+			Data:                    data("OpenPlay", "any-assist-id"),
+			HandleGetTeamPlayerFunc: mockGetTeamPlayerOnlyScorerFunc,
+			ExpectedError:           true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: mockGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc:                 mockUpdateMatchFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: mockGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: tc.HandleGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		err := HandleEventMatchGoal(ctx, tc.Data)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestHandleEventMatchGoalPeriod(t *testing.T) {
+	ctx := context.Background()
+
+	data := func(period, stoppage string) map[string]string {
+		return map[string]string{
+			"matchEventType": "Goal",
+			"tournamentID":   "any-tournament-id",
+			"matchID":        "any-match-id",
+			"teamScore":      "any-team-id",
+			"player":         "any-player-id",
+			"period":         period,
+			"goalMinute":     "45",
+			"stoppage":       stoppage,
+		}
+	}
+
+	testCases := []struct {
+		Name             string
+		Data             map[string]string
+		ExpectedPeriod   model.MatchPeriod
+		ExpectedStoppage int
+		ExpectedError    bool
+	}{
+		{
+			Name:             "Handle event match goal in stoppage time",
+			Data:             data("FirstHalf", "2"),
+			ExpectedPeriod:   model.PeriodFirstHalf,
+			ExpectedStoppage: 2,
+			ExpectedError:    false,
+		}, {
+			Name:          "Handle event match goal published before periods were tracked",
+			Data:          data("", ""),
+			ExpectedError: false,
+		}, {
+			Name:          "Handle event match goal with unknown period",
+			Data:          data("ThirdHalf", ""),
+			ExpectedError: true,
+		}, {
+			Name:          "Handle event match goal with invalid stoppage",
+			Data:          data("FirstHalf", "two"),
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		var updated match.Match
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: mockGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc: func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
+				updated = mt
+				return &mt, nil
+			},
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: mockGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: mockGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		err := HandleEventMatchGoal(ctx, tc.Data)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.NoError(t, err)
+		goal := updated.Events[len(updated.Events)-1].Value.(event.Goal)
+		assert.Equal(t, tc.ExpectedPeriod, goal.Period)
+		assert.Equal(t, tc.ExpectedStoppage, goal.Stoppage)
+	}
+}
+
+func TestHandleEventMatchGoalAlreadyApplied(t *testing.T) {
+	ctx := context.Background()
+
+	data := map[string]string{
+		"matchEventType": "Goal",
+		"tournamentID":   "any-tournament-id",
+		"matchID":        "any-match-id",
+		"eventID":        "goal-1",
+		"teamScore":      "any-team-id",
+		"player":         "any-player-id",
+		"goalMinute":     "10",
+	}
+
+	var updated match.Match
+
+	repo.SetTournamentRepo(repo.MockTournamentRepo{
+		GetFunc: mockGetTournamentFunc,
+	})
+	defer repo.SetTournamentRepo(nil)
+
+	repo.SetMatchRepo(repo.MockMatchRepo{
+		UpdateFunc: func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
+			updated = mt
+			return &mt, nil
+		},
+		FindMatchForTournamentFunc: func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+			return &updated, nil
+		},
+	})
+	defer repo.SetMatchRepo(nil)
+
+	repo.SetTeamRepo(repo.MockTeamRepo{
+		GetFunc: mockGetTeamFunc,
+	})
+	defer repo.SetTeamRepo(nil)
+
+	repo.SetPlayerRepo(repo.MockPlayerRepo{
+		GetTeamPlayerFunc: mockGetTeamPlayerFunc,
+	})
+	defer repo.SetPlayerRepo(nil)
+
+	updated = prototype.PrototypeMatch()
+	updated.Status = model.MatchStatusInProgress
+
+	assert.NoError(t, HandleEventMatchGoal(ctx, data))
+	assert.NoError(t, HandleEventMatchGoal(ctx, data))
+
+	assert.Len(t, updated.Events, 1)
+	assert.Equal(t, "goal-1", updated.Events[0].ID)
+	assert.Equal(t, 1, updated.Score.Home)
+}
+
+func TestHandleEventMatchGoalWithoutEventID(t *testing.T) {
+	ctx := context.Background()
+
+	data := map[string]string{
+		"matchEventType": "Goal",
+		"tournamentID":   "any-tournament-id",
+		"matchID":        "any-match-id",
+		"teamScore":      "any-team-id",
+		"player":         "any-player-id",
+		"goalMinute":     "10",
+	}
+
+	var updated match.Match
+
+	repo.SetTournamentRepo(repo.MockTournamentRepo{
+		GetFunc: mockGetTournamentFunc,
+	})
+	defer repo.SetTournamentRepo(nil)
+
+	repo.SetMatchRepo(repo.MockMatchRepo{
+		UpdateFunc: func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
+			updated = mt
+			return &mt, nil
+		},
+		FindMatchForTournamentFunc: func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+			return &updated, nil
+		},
+	})
+	defer repo.SetMatchRepo(nil)
+
+	repo.SetTeamRepo(repo.MockTeamRepo{
+		GetFunc: mockGetTeamFunc,
+	})
+	defer repo.SetTeamRepo(nil)
+
+	repo.SetPlayerRepo(repo.MockPlayerRepo{
+		GetTeamPlayerFunc: mockGetTeamPlayerFunc,
+	})
+	defer repo.SetPlayerRepo(nil)
+
+	updated = prototype.PrototypeMatch()
+	updated.Status = model.MatchStatusInProgress
+
+	assert.NoError(t, HandleEventMatchGoal(ctx, data))
+	assert.NoError(t, HandleEventMatchGoal(ctx, data))
+
+	assert.Len(t, updated.Events, 2)
+	assert.NotEmpty(t, updated.Events[0].ID)
+	assert.NotEqual(t, updated.Events[0].ID, updated.Events[1].ID)
+	assert.Equal(t, 2, updated.Score.Home)
+}
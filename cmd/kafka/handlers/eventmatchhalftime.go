@@ -2,17 +2,17 @@ package handlers
 
 import (
 	"context"
-	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
-	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 )
 
 func HandleEventMatchHalfTime(ctx context.Context, data map[string]string) errs.AppError {
 	tournamentID := data["tournamentID"]
 	matchID := data["matchID"]
+	eventID := data["eventID"]
 	halftime := data["halftime"]
 
 	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
@@ -25,18 +25,14 @@ func HandleEventMatchHalfTime(ctx context.Context, data map[string]string) errs.
 		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, err.Error())
 	}
 
-	events := struct {
-		MatchEvent model.EventsMatchType
-		Halftime   string
-		Created    time.Time
-	}{
-		MatchEvent: model.EventHalftime,
-		Halftime:   halftime,
-		Created:    time.Now(),
+	matchEvent := event.New(tournamentID, matchID, event.Halftime{
+		Halftime: halftime,
+	})
+	setEventID(&matchEvent, eventID)
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
 	}
-	match.Events = append(match.Events, events)
-
-	match.Status = model.MatchStatusHalftime
 
 	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
 	if err != nil || matchUpdated == nil {
@@ -32,19 +32,19 @@ func TestHandleEventMatchHalfTime(t *testing.T) {
 			Name:                             "Handle event match halftime correct",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			ExpectedError:                    false,
 		}, {
 			Name:                             "Handle event match halftime throw error on get tournament function",
 			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			ExpectedError:                    true,
 		}, {
 			Name:                             "Handle event match halftime throw error on update match function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			ExpectedError:                    true,
 		}, {
 			Name:                             "Handle event match halftime throw error on find match fot tournament function",
@@ -52,6 +52,12 @@ func TestHandleEventMatchHalfTime(t *testing.T) {
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
 			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
 			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match halftime throw error on illegal transition when match is not in progress",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			ExpectedError:                    true,
 		},
 	}
 
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+)
+
+func HandleEventMatchLineup(ctx context.Context, data map[string]string) errs.AppError {
+	tournamentID := data["tournamentID"]
+	matchID := data["matchID"]
+	eventID := data["eventID"]
+	teamID := data["teamID"]
+	startingXI := data["startingXI"]
+	bench := data["bench"]
+	captainID := data["captainID"]
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
+	if err != nil || tournament == nil {
+		return errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, tournamentID)
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournamentID)
+	if err != nil || match == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	team, err := repo.GetTeamRepo().Get(ctx, teamID)
+	if err != nil || team == nil {
+		return errs.ErrTeamIsNotFound.Throwf(applog.Log, errs.ErrFmt, teamID)
+	}
+
+	lineup := event.Lineup{
+		Team: *team,
+	}
+
+	lineup.StartingXI, err = getTeamPlayers(ctx, team.ID, splitIDs(startingXI))
+	if err != nil {
+		return err
+	}
+
+	lineup.Bench, err = getTeamPlayers(ctx, team.ID, splitIDs(bench))
+	if err != nil {
+		return err
+	}
+
+	for _, p := range lineup.StartingXI {
+		if p.ID == captainID {
+			lineup.Captain = p
+		}
+	}
+
+	matchEvent := event.New(tournamentID, matchID, lineup)
+	setEventID(&matchEvent, eventID)
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
+	}
+
+	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
+	if err != nil || matchUpdated == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	return nil
+}
+
+func splitIDs(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+	return strings.Split(ids, ",")
+}
+
+func getTeamPlayers(ctx context.Context, teamID string, ids []string) ([]player.Player, errs.AppError) {
+	players := []player.Player{}
+	for _, playerID := range ids {
+		p, err := repo.GetPlayerRepo().GetTeamPlayer(ctx, playerID, teamID)
+		if err != nil || p == nil {
+			return nil, errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, teamID, playerID)
+		}
+		players = append(players, *p)
+	}
+	return players, nil
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func TestHandleEventMatchLineup(t *testing.T) {
+	ctx := context.Background()
+
+	data := map[string]string{
+		"matchEventType": "Lineup",
+		"tournamentID":   "any-tournament-id",
+		"matchID":        "any-match-id",
+		"teamID":         "any-team-id",
+		"startingXI":     "1,2,3,4,5,6,7,8,9,10,11",
+		"bench":          "12,13",
+		"captainID":      "1",
+	}
+
+	testCases := []struct {
+		Name                             string
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleUpdateMatchFunc            func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleGetTeamFunc                func(ctx context.Context, id string) (*team.Team, errs.AppError)
+		HandleGetTeamPlayerFunc          func(ctx context.Context, id, teamID string) (*player.Player, errs.AppError)
+		ExpectedError                    bool
+	}{
+		{
+			Name:                             "Handle event match lineup correct",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    false,
+		}, {
+			Name:                             "Handle event match lineup throw error when match is started",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match lineup throw error on get tournament function",
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match lineup throw error on get team function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamThrowFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match lineup throw error on get team player function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match lineup throw error on update match function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc:                 tc.HandleUpdateMatchFunc,
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: tc.HandleGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: tc.HandleGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		err := HandleEventMatchLineup(ctx, data)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandleEventMatchPenaltyKick(ctx context.Context, data map[string]string) errs.AppError {
+	tournamentID := data["tournamentID"]
+	matchID := data["matchID"]
+	eventID := data["eventID"]
+	teamID := data["teamID"]
+	playerID := data["playerID"]
+	scored := data["scored"]
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
+	if err != nil || tournament == nil {
+		return errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, tournamentID)
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournamentID)
+	if err != nil || match == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	teamKick, err := repo.GetTeamRepo().Get(ctx, teamID)
+	if err != nil || teamKick == nil {
+		return errs.ErrTeamIsNotFound.Throwf(applog.Log, errs.ErrFmt, teamID)
+	}
+
+	playerKick, err := repo.GetPlayerRepo().GetTeamPlayer(ctx, playerID, teamID)
+	if err != nil || playerKick == nil {
+		return errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, teamID, playerID)
+	}
+
+	scoredAsBool, err_ := strconv.ParseBool(scored)
+	if err_ != nil {
+		return errs.ErrConvertingPayload.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+	}
+
+	matchEvent := event.New(tournamentID, matchID, event.PenaltyKick{
+		Team:   *teamKick,
+		Player: *playerKick,
+		Scored: scoredAsBool,
+	})
+	setEventID(&matchEvent, eventID)
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
+	}
+
+	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
+	if err != nil || matchUpdated == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockFindMatchPenaltiesForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusPenalties
+	matchMock.Period = model.PeriodPenalties
+	return &matchMock, nil
+}
+
+func TestHandleEventMatchPenaltyKick(t *testing.T) {
+	ctx := context.Background()
+
+	data := map[string]string{
+		"matchEventType": "PenaltyKick",
+		"tournamentID":   "any-tournament-id",
+		"matchID":        "any-match-id",
+		"teamID":         "any-team-id",
+		"playerID":       "any-player-id",
+		"scored":         "true",
+	}
+
+	badData := map[string]string{
+		"matchEventType": "PenaltyKick",
+		"tournamentID":   "any-tournament-id",
+		"matchID":        "any-match-id",
+		"teamID":         "any-team-id",
+		"playerID":       "any-player-id",
+		"scored":         "maybe",
+	}
+
+	testCases := []struct {
+		Name                             string
+		Data                             map[string]string
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleUpdateMatchFunc            func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleGetTeamFunc                func(ctx context.Context, id string) (*team.Team, errs.AppError)
+		HandleGetTeamPlayerFunc          func(ctx context.Context, id, teamID string) (*player.Player, errs.AppError)
+		ExpectedError                    bool
+	}{
+		{
+			Name:                             "Handle event match penalty kick correct",
+			Data:                             data,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchPenaltiesForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    false,
+		}, {
+			Name:                             "Handle event match penalty kick throw error when match is not in a shootout",
+			Data:                             data,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match penalty kick throw error parsing scored",
+			Data:                             badData,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchPenaltiesForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match penalty kick throw error on get tournament function",
+			Data:                             data,
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchPenaltiesForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match penalty kick throw error on get team function",
+			Data:                             data,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchPenaltiesForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamThrowFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match penalty kick throw error on get team player function",
+			Data:                             data,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchPenaltiesForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match penalty kick throw error on update match function",
+			Data:                             data,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchPenaltiesForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedError:                    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc:                 tc.HandleUpdateMatchFunc,
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: tc.HandleGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: tc.HandleGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		err := HandleEventMatchPenaltyKick(ctx, tc.Data)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
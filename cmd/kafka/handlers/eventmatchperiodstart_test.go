@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockFindMatchAfterSecondHalfForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusHalftime
+	matchMock.Period = model.PeriodSecondHalf
+	return &matchMock, nil
+}
+
+func TestHandleEventMatchPeriodStart(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name                             string
+		MatchEventType                   model.EventsMatchType
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleUpdateMatchFunc            func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		ExpectedError                    bool
+	}{
+		{
+			Name:                             "Handle event match extra time first half correct",
+			MatchEventType:                   model.EventExtraTimeFirstHalf,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			ExpectedError:                    false,
+		}, {
+			Name:                             "Handle event match penalty shootout correct",
+			MatchEventType:                   model.EventPenaltyShootout,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			ExpectedError:                    false,
+		}, {
+			Name:                             "Handle event match extra time second half throw error on illegal transition",
+			MatchEventType:                   model.EventExtraTimeSecondHalf,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match period start throw error on unknown event type",
+			MatchEventType:                   model.EventGoal,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match period start throw error on get tournament function",
+			MatchEventType:                   model.EventExtraTimeFirstHalf,
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match period start throw error on find match for tournament function",
+			MatchEventType:                   model.EventExtraTimeFirstHalf,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match period start throw error on update match function",
+			MatchEventType:                   model.EventExtraTimeFirstHalf,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			ExpectedError:                    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc:                 tc.HandleUpdateMatchFunc,
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		data := map[string]string{
+			"matchEventType": string(tc.MatchEventType),
+			"tournamentID":   "any-tournament-id",
+			"matchID":        "any-match-id",
+			"timeStarted":    "17:55",
+		}
+
+		err := HandleEventMatchPeriodStart(ctx, data)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
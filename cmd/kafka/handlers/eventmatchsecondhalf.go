@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandleEventMatchSecondHalf(ctx context.Context, data map[string]string) errs.AppError {
+	tournamentID := data["tournamentID"]
+	matchID := data["matchID"]
+	eventID := data["eventID"]
+	timeStarted := data["timeStarted"]
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
+	if err != nil || tournament == nil {
+		return errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, tournamentID)
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournamentID)
+	if err != nil || match == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	created, err := parseCreated(data["created"])
+	if err != nil {
+		return err
+	}
+
+	matchEvent := event.New(tournamentID, matchID, event.SecondHalf{
+		TimeStarted: timeStarted,
+	})
+	setEventID(&matchEvent, eventID)
+	if !created.IsZero() {
+		matchEvent.Created = created
+	}
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
+	}
+
+	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
+	if err != nil || matchUpdated == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockFindMatchHalftimeForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusHalftime
+	return &matchMock, nil
+}
+
+func mockGetTournamentNilFunc(ctx context.Context, id string) (*tournament.Tournament, errs.AppError) {
+	return nil, nil
+}
+
+func mockFindMatchForTournamentNilFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	return nil, nil
+}
+
+func TestHandleEventMatchSecondHalf(t *testing.T) {
+	ctx := context.Background()
+
+	data := map[string]string{
+		"tournamentID": "any-player-id",
+		"matchID":      "any-match-id",
+		"timeStarted":  "17:00",
+	}
+
+	testCases := []struct {
+		Name                             string
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleUpdateMatchFunc            func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		ExpectedError                    bool
+	}{
+		{
+			Name:                             "Handle event match second half correct",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchHalftimeForTournamentFunc,
+			ExpectedError:                    false,
+		}, {
+			Name:                             "Handle event match second half throw error on get tournament function",
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchHalftimeForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match second half throw error on update match function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchHalftimeForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match second half throw error on find match fot tournament function",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match second half throw error on illegal transition when match is not in halftime",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match second half throw error when the tournament is not found",
+			HandleGetTournamentFunc:          mockGetTournamentNilFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchHalftimeForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match second half throw error when the match is not found",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			ExpectedError:                    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc:                 tc.HandleUpdateMatchFunc,
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		err := HandleEventMatchSecondHalf(ctx, data)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+
+}
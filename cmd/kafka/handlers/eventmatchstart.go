@@ -2,17 +2,17 @@ package handlers
 
 import (
 	"context"
-	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
-	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 )
 
 func HandleEventMatchStart(ctx context.Context, data map[string]string) errs.AppError {
 	tournamentID := data["tournamentID"]
 	matchID := data["matchID"]
+	eventID := data["eventID"]
 	timeStarted := data["timeStarted"]
 
 	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
@@ -25,18 +25,22 @@ func HandleEventMatchStart(ctx context.Context, data map[string]string) errs.App
 		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, err.Error())
 	}
 
-	events := struct {
-		MatchEvent  model.EventsMatchType
-		TimeStarted string
-		Created     time.Time
-	}{
-		MatchEvent:  model.EventStart,
-		TimeStarted: timeStarted,
-		Created:     time.Now(),
+	created, err := parseCreated(data["created"])
+	if err != nil {
+		return err
 	}
-	match.Events = append(match.Events, events)
 
-	match.Status = model.MatchStatusInProgress
+	matchEvent := event.New(tournamentID, matchID, event.Start{
+		TimeStarted: timeStarted,
+	})
+	setEventID(&matchEvent, eventID)
+	if !created.IsZero() {
+		matchEvent.Created = created
+	}
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
+	}
 
 	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
 	if err != nil || matchUpdated == nil {
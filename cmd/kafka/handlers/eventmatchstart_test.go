@@ -3,11 +3,13 @@ package handlers
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
 	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
@@ -27,6 +29,12 @@ func mockFindMatchForTournamentFunc(ctx context.Context, id, tournamentID string
 	return &matchMock, nil
 }
 
+func mockFindMatchInProgressForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusInProgress
+	return &matchMock, nil
+}
+
 func mockFindMatchForTournamentThrowFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
 	return nil, errs.ErrRepoMockAction
 }
@@ -79,6 +87,12 @@ func TestHandleEventMatchStart(t *testing.T) {
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
 			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
 			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match start throw error on illegal transition when match is already started",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			ExpectedError:                    true,
 		},
 	}
 
@@ -105,3 +119,59 @@ func TestHandleEventMatchStart(t *testing.T) {
 	}
 
 }
+
+func TestHandleEventMatchStartCreated(t *testing.T) {
+	ctx := context.Background()
+
+	created := time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name          string
+		Created       string
+		ExpectedError bool
+	}{
+		{
+			Name:          "Handle event match start keeps the time the event was stored",
+			Created:       created.Format(time.RFC3339Nano),
+			ExpectedError: false,
+		}, {
+			Name:          "Handle event match start with invalid created time",
+			Created:       "16:00",
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		var updated match.Match
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: mockGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc: func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
+				updated = mt
+				return &mt, nil
+			},
+			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		err := HandleEventMatchStart(ctx, map[string]string{
+			"tournamentID": "any-tournament-id",
+			"matchID":      "any-match-id",
+			"timeStarted":  "16:00",
+			"created":      tc.Created,
+		})
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.True(t, created.Equal(updated.Events[0].Created))
+	}
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+func HandleEventMatchStatistic(ctx context.Context, data map[string]string) errs.AppError {
+	tournamentID := data["tournamentID"]
+	matchID := data["matchID"]
+	eventID := data["eventID"]
+	matchEventType := model.EventsMatchType(data["matchEventType"])
+	teamID := data["teamID"]
+	playerID := data["playerID"]
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
+	if err != nil || tournament == nil {
+		return errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, tournamentID)
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournamentID)
+	if err != nil || match == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	minute, err_ := strconvAtoi(data["minute"])
+	if err_ != nil {
+		return errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+	}
+
+	period, err := parsePeriod(data["period"])
+	if err != nil {
+		return err
+	}
+
+	stoppage, err := parseStoppage(data["stoppage"])
+	if err != nil {
+		return err
+	}
+
+	var teamStat *team.Team
+	if matchEventType != model.EventPossession {
+		teamStat, err = repo.GetTeamRepo().Get(ctx, teamID)
+		if err != nil || teamStat == nil {
+			return errs.ErrTeamIsNotFound.Throwf(applog.Log, errs.ErrFmt, teamID)
+		}
+	}
+
+	var playerStat *player.Player
+	if matchEventType != model.EventPossession && matchEventType != model.EventCorner {
+		playerStat, err = repo.GetPlayerRepo().GetTeamPlayer(ctx, playerID, teamID)
+		if err != nil || playerStat == nil {
+			return errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, teamID, playerID)
+		}
+	}
+
+	var value event.Value
+	switch matchEventType {
+	case model.EventShot:
+		onTarget, err_ := strconv.ParseBool(data["onTarget"])
+		if err_ != nil {
+			return errs.ErrConvertingPayload.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+		}
+		value = event.Shot{Team: *teamStat, Player: *playerStat, OnTarget: onTarget, Period: period, Minute: minute, Stoppage: stoppage}
+	case model.EventCorner:
+		value = event.Corner{Team: *teamStat, Period: period, Minute: minute, Stoppage: stoppage}
+	case model.EventFoul:
+		value = event.Foul{Team: *teamStat, Player: *playerStat, Period: period, Minute: minute, Stoppage: stoppage}
+	case model.EventOffside:
+		value = event.Offside{Team: *teamStat, Player: *playerStat, Period: period, Minute: minute, Stoppage: stoppage}
+	case model.EventSave:
+		value = event.Save{Team: *teamStat, Player: *playerStat, Period: period, Minute: minute, Stoppage: stoppage}
+	case model.EventPossession:
+		home, err_ := strconvAtoi(data["home"])
+		if err_ != nil {
+			return errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+		}
+
+		away, err_ := strconvAtoi(data["away"])
+		if err_ != nil {
+			return errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+		}
+		value = event.Possession{Home: home, Away: away, Period: period, Minute: minute, Stoppage: stoppage}
+	default:
+		return errs.ErrUnknownEventType.Throwf(applog.Log, errs.ErrFmt, matchEventType)
+	}
+
+	matchEvent := event.New(tournamentID, matchID, value)
+	setEventID(&matchEvent, eventID)
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
+	}
+
+	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
+	if err != nil || matchUpdated == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func TestHandleEventMatchStatistic(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name                             string
+		Data                             map[string]string
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleUpdateMatchFunc            func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleGetTeamFunc                func(ctx context.Context, id string) (*team.Team, errs.AppError)
+		HandleGetTeamPlayerFunc          func(ctx context.Context, id, teamID string) (*player.Player, errs.AppError)
+		StrconvAtoiFunc                  func(s string) (int, error)
+		ExpectedError                    bool
+	}{
+		{
+			Name:                             "Handle event match shot correct",
+			Data:                             map[string]string{"matchEventType": "Shot", "teamID": "1", "playerID": "1", "onTarget": "true", "period": "FirstHalf", "minute": "12"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+		}, {
+			Name:                             "Handle event match corner correct without a player",
+			Data:                             map[string]string{"matchEventType": "Corner", "teamID": "1", "minute": "20"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+		}, {
+			Name:                             "Handle event match foul correct",
+			Data:                             map[string]string{"matchEventType": "Foul", "teamID": "1", "playerID": "1", "minute": "25"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+		}, {
+			Name:                             "Handle event match offside correct",
+			Data:                             map[string]string{"matchEventType": "Offside", "teamID": "1", "playerID": "1", "minute": "28"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+		}, {
+			Name:                             "Handle event match save correct",
+			Data:                             map[string]string{"matchEventType": "Save", "teamID": "1", "playerID": "1", "minute": "30"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+		}, {
+			Name:                             "Handle event match possession correct without a team",
+			Data:                             map[string]string{"matchEventType": "Possession", "home": "58", "away": "42", "minute": "30"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamThrowFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+		}, {
+			Name:                             "Handle event match possession throw error not adding up to 100",
+			Data:                             map[string]string{"matchEventType": "Possession", "home": "58", "away": "40", "minute": "30"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match shot throw error on invalid on target",
+			Data:                             map[string]string{"matchEventType": "Shot", "teamID": "1", "playerID": "1", "onTarget": "yes", "minute": "12"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match statistic throw error on match not in progress",
+			Data:                             map[string]string{"matchEventType": "Foul", "teamID": "1", "playerID": "1", "minute": "25"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match statistic throw error on unknown event type",
+			Data:                             map[string]string{"matchEventType": "Goal", "teamID": "1", "playerID": "1", "minute": "25"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match statistic throw error on get tournament function",
+			Data:                             map[string]string{"matchEventType": "Foul", "teamID": "1", "playerID": "1", "minute": "25"},
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match statistic throw error on find match for tournament function",
+			Data:                             map[string]string{"matchEventType": "Foul", "teamID": "1", "playerID": "1", "minute": "25"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match statistic throw error on get team function",
+			Data:                             map[string]string{"matchEventType": "Corner", "teamID": "1", "minute": "20"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamThrowFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match statistic throw error on get team player function",
+			Data:                             map[string]string{"matchEventType": "Save", "teamID": "1", "playerID": "1", "minute": "30"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match statistic throw error on strconv function",
+			Data:                             map[string]string{"matchEventType": "Foul", "teamID": "1", "playerID": "1", "minute": "25"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  fakeStrconvAtoi,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match statistic throw error on update match function",
+			Data:                             map[string]string{"matchEventType": "Foul", "teamID": "1", "playerID": "1", "minute": "25"},
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			StrconvAtoiFunc:                  strconvAtoi,
+			ExpectedError:                    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc:                 tc.HandleUpdateMatchFunc,
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: tc.HandleGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: tc.HandleGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		strconvAtoi = tc.StrconvAtoiFunc
+		defer restoreStrconvAtoi(strconvAtoi)
+
+		data := map[string]string{
+			"tournamentID": "any-tournament-id",
+			"matchID":      "any-match-id",
+			"eventID":      "any-event-id",
+		}
+		for k, v := range tc.Data {
+			data[k] = v
+		}
+
+		err := HandleEventMatchStatistic(ctx, data)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
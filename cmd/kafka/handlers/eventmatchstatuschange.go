@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandleEventMatchStatusChange(ctx context.Context, data map[string]string) errs.AppError {
+	tournamentID := data["tournamentID"]
+	matchID := data["matchID"]
+	eventID := data["eventID"]
+	matchEventType := model.EventsMatchType(data["matchEventType"])
+	reason := data["reason"]
+	now := data["time"]
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
+	if err != nil || tournament == nil {
+		return errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, tournamentID)
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournamentID)
+	if err != nil || match == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	created, err := parseCreated(data["created"])
+	if err != nil {
+		return err
+	}
+
+	var value event.Value
+	switch matchEventType {
+	case model.EventPostpone:
+		postpone := event.Postpone{Reason: reason}
+		if data["kickoff"] != "" {
+			kickoff, err_ := time.Parse(time.RFC3339, data["kickoff"])
+			if err_ != nil {
+				return errs.ErrConvertingPayload.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+			}
+			kickoff = kickoff.UTC()
+			postpone.Kickoff = &kickoff
+		}
+		value = postpone
+	case model.EventSuspend:
+		value = event.Suspend{Reason: reason, TimeSuspended: now}
+	case model.EventResume:
+		value = event.Resume{TimeResumed: now}
+	case model.EventAbandon:
+		value = event.Abandon{Reason: reason, TimeAbandoned: now}
+	case model.EventCancel:
+		value = event.Cancel{Reason: reason}
+	default:
+		return errs.ErrUnknownEventType.Throwf(applog.Log, errs.ErrFmt, matchEventType)
+	}
+
+	matchEvent := event.New(tournamentID, matchID, value)
+	setEventID(&matchEvent, eventID)
+	if !created.IsZero() {
+		matchEvent.Created = created
+	}
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
+	}
+
+	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
+	if err != nil || matchUpdated == nil {
+		return errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+	}
+
+	return nil
+}
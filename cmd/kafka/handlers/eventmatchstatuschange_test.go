@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func TestHandleEventMatchStatusChange(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name                             string
+		MatchEventType                   model.EventsMatchType
+		Kickoff                          string
+		Created                          string
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleUpdateMatchFunc            func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		ExpectedError                    bool
+	}{
+		{
+			Name:                             "Handle event match postpone correct",
+			MatchEventType:                   model.EventPostpone,
+			Kickoff:                          "2022-02-08T19:00:00Z",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			ExpectedError:                    false,
+		}, {
+			Name:                             "Handle event match suspend correct",
+			MatchEventType:                   model.EventSuspend,
+			Created:                          "2022-02-01T16:20:00Z",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			ExpectedError:                    false,
+		}, {
+			Name:                             "Handle event match abandon correct",
+			MatchEventType:                   model.EventAbandon,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			ExpectedError:                    false,
+		}, {
+			Name:                             "Handle event match cancel correct",
+			MatchEventType:                   model.EventCancel,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			ExpectedError:                    false,
+		}, {
+			Name:                             "Handle event match resume throw error on illegal transition",
+			MatchEventType:                   model.EventResume,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match postpone throw error on invalid kickoff",
+			MatchEventType:                   model.EventPostpone,
+			Kickoff:                          "2022-02-08 19:00",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match status change throw error on invalid created",
+			MatchEventType:                   model.EventSuspend,
+			Created:                          "16:20",
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match status change throw error on unknown event type",
+			MatchEventType:                   model.EventGoal,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match status change throw error on get tournament function",
+			MatchEventType:                   model.EventPostpone,
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match status change throw error on find match for tournament function",
+			MatchEventType:                   model.EventPostpone,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			ExpectedError:                    true,
+		}, {
+			Name:                             "Handle event match status change throw error on update match function",
+			MatchEventType:                   model.EventPostpone,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			ExpectedError:                    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc:                 tc.HandleUpdateMatchFunc,
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		data := map[string]string{
+			"matchEventType": string(tc.MatchEventType),
+			"tournamentID":   "any-tournament-id",
+			"matchID":        "any-match-id",
+			"reason":         "any-reason",
+			"time":           "16:20",
+			"kickoff":        tc.Kickoff,
+			"created":        tc.Created,
+		}
+
+		err := HandleEventMatchStatusChange(ctx, data)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestHandleEventMatchPostponeKickoff(t *testing.T) {
+	var updated match.Match
+
+	repo.SetTournamentRepo(repo.MockTournamentRepo{
+		GetFunc: mockGetTournamentFunc,
+	})
+	defer repo.SetTournamentRepo(nil)
+
+	repo.SetMatchRepo(repo.MockMatchRepo{
+		UpdateFunc: func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
+			updated = mt
+			return &mt, nil
+		},
+		FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+	})
+	defer repo.SetMatchRepo(nil)
+
+	err := HandleEventMatchStatusChange(context.Background(), map[string]string{
+		"matchEventType": string(model.EventPostpone),
+		"tournamentID":   "any-tournament-id",
+		"matchID":        "any-match-id",
+		"reason":         "Waterlogged pitch",
+		"kickoff":        "2022-02-08T16:00:00-03:00",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, model.MatchStatusPostponed, updated.Status)
+	assert.Equal(t, "Waterlogged pitch", updated.Reason)
+	assert.True(t, time.Date(2022, 2, 8, 19, 0, 0, 0, time.UTC).Equal(updated.Kickoff))
+}
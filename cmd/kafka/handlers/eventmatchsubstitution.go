@@ -2,23 +2,23 @@ package handlers
 
 import (
 	"context"
-	"time"
+	"strconv"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
-	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
-	"github.com/rafaelsanzio/go-flashscore/pkg/player"
-	"github.com/rafaelsanzio/go-flashscore/pkg/team"
 )
 
 func HandleEventMatchSubstitution(ctx context.Context, data map[string]string) errs.AppError {
 	tournamentID := data["tournamentID"]
 	matchID := data["matchID"]
+	eventID := data["eventID"]
 	teamID := data["teamID"]
 	playerOutID := data["playerOutID"]
 	playerInID := data["playerInID"]
 	substitutionMinute := data["substitutionMinute"]
+	concussion := data["concussion"]
 
 	tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
 	if err != nil || tournament == nil {
@@ -50,22 +50,50 @@ func HandleEventMatchSubstitution(ctx context.Context, data map[string]string) e
 		return errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, err_.Error())
 	}
 
-	events := struct {
-		MatchEvent         model.EventsMatchType
-		Team               team.Team
-		PlayerOut          player.Player
-		PlayerIn           player.Player
-		SubstitutionMinute int
-		Created            time.Time
-	}{
-		MatchEvent:         model.EventSubstitution,
-		Team:               *teamSub,
-		PlayerOut:          *playerOut,
-		PlayerIn:           *playerIn,
-		SubstitutionMinute: substitutionMinuteAsInt,
-		Created:            time.Now(),
+	period, err := parsePeriod(data["period"])
+	if err != nil {
+		return err
+	}
+
+	stoppage, err := parseStoppage(data["stoppage"])
+	if err != nil {
+		return err
+	}
+
+	concussionAsBool := false
+	if concussion != "" {
+		concussionAsBool, err_ = strconv.ParseBool(concussion)
+		if err_ != nil {
+			return errs.ErrConvertingPayload.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+		}
+	}
+
+	matchEvent := event.New(tournamentID, matchID, event.Substitution{
+		Team:       *teamSub,
+		PlayerOut:  *playerOut,
+		PlayerIn:   *playerIn,
+		Period:     period,
+		Minute:     substitutionMinuteAsInt,
+		Stoppage:   stoppage,
+		Concussion: concussionAsBool,
+	})
+	setEventID(&matchEvent, eventID)
+
+	// a redelivered substitution counts itself against the limits, it is
+	// ignored before they are checked
+	if match.HasEvent(matchEvent.ID) {
+		return nil
+	}
+
+	err = match.CheckSubstitutionRules(tournament.Substitutions, matchEvent)
+	if err != nil {
+		return err
+	}
+
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
 	}
-	match.Events = append(match.Events, events)
 
 	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
 	if err != nil || matchUpdated == nil {
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 	"github.com/rafaelsanzio/go-flashscore/pkg/player"
 	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
@@ -50,7 +52,7 @@ func TestHandleEventMatchSubstitution(t *testing.T) {
 			Name:                             "Handle event match goal correct",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -59,7 +61,7 @@ func TestHandleEventMatchSubstitution(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get tournament function",
 			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -68,7 +70,7 @@ func TestHandleEventMatchSubstitution(t *testing.T) {
 			Name:                             "Handle event match goal throw error on update match function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -86,7 +88,7 @@ func TestHandleEventMatchSubstitution(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get team function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamThrowFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -95,7 +97,7 @@ func TestHandleEventMatchSubstitution(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get team player function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -104,7 +106,7 @@ func TestHandleEventMatchSubstitution(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get team player function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerInThrowFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -113,7 +115,7 @@ func TestHandleEventMatchSubstitution(t *testing.T) {
 			Name:                             "Handle event match goal throw error on strconv Atoi function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  fakeStrconvAtoi,
@@ -157,3 +159,123 @@ func TestHandleEventMatchSubstitution(t *testing.T) {
 	}
 
 }
+
+func mockGetTournamentConcussionFunc(ctx context.Context, id string) (*tournament.Tournament, errs.AppError) {
+	tournamentMock := prototype.PrototypeTournament()
+	tournamentMock.Substitutions = tournament.SubstitutionRules{ConcussionSubstitutions: 1}
+	return &tournamentMock, nil
+}
+
+func mockGetTournamentOneSubstitutionFunc(ctx context.Context, id string) (*tournament.Tournament, errs.AppError) {
+	tournamentMock := prototype.PrototypeTournament()
+	tournamentMock.Substitutions = tournament.SubstitutionRules{MaxSubstitutions: 1}
+	return &tournamentMock, nil
+}
+
+func mockFindMatchWithSubstitutionFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusInProgress
+
+	substitution := event.New(tournamentID, id, event.Substitution{
+		Team:      matchMock.HomeTeam,
+		PlayerOut: player.Player{ID: "any-player-out-id"},
+		PlayerIn:  player.Player{ID: "any-player-in-id"},
+		Minute:    10,
+	})
+	substitution.ID = "substitution-1"
+	matchMock.Events = []event.Event{substitution}
+
+	return &matchMock, nil
+}
+
+func TestHandleEventMatchSubstitutionRules(t *testing.T) {
+	ctx := context.Background()
+
+	data := func(eventID, concussion string) map[string]string {
+		return map[string]string{
+			"matchEventType":     "Substitution",
+			"eventID":            eventID,
+			"tournamentID":       "any-tournament-id",
+			"matchID":            "any-match-id",
+			"teamID":             "any-team-id",
+			"playerOutID":        "any-player-out-id",
+			"playerInID":         "any-player-in-id",
+			"substitutionMinute": "10",
+			"concussion":         concussion,
+		}
+	}
+
+	testCases := []struct {
+		Name                    string
+		Data                    map[string]string
+		HandleGetTournamentFunc func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchFunc     func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		ExpectedError           bool
+	}{
+		{
+			Name:                    "Handle event match concussion substitution",
+			Data:                    data("", "true"),
+			HandleGetTournamentFunc: mockGetTournamentConcussionFunc,
+			ExpectedError:           false,
+		}, {
+			Name:                    "Handle event match concussion substitution not allowed by the tournament",
+			Data:                    data("", "true"),
+			HandleGetTournamentFunc: mockGetTournamentFunc,
+			ExpectedError:           true,
+		}, {
+			Name:                    "Handle event match substitution throw error parsing concussion",
+			Data:                    data("", "maybe"),
+			HandleGetTournamentFunc: mockGetTournamentConcussionFunc,
+			ExpectedError:           true,
+		}, {
+			Name:                    "Handle event match substitution delivered again after using the last change",
+			Data:                    data("substitution-1", ""),
+			HandleGetTournamentFunc: mockGetTournamentOneSubstitutionFunc,
+			HandleFindMatchFunc:     mockFindMatchWithSubstitutionFunc,
+			ExpectedError:           false,
+		}, {
+			Name:                    "Handle event match substitution over the limit",
+			Data:                    data("substitution-2", ""),
+			HandleGetTournamentFunc: mockGetTournamentOneSubstitutionFunc,
+			HandleFindMatchFunc:     mockFindMatchWithSubstitutionFunc,
+			ExpectedError:           true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		findMatchFunc := tc.HandleFindMatchFunc
+		if findMatchFunc == nil {
+			findMatchFunc = mockFindMatchInProgressForTournamentFunc
+		}
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			UpdateFunc:                 mockUpdateMatchFunc,
+			FindMatchForTournamentFunc: findMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: mockGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: mockGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		err := HandleEventMatchSubstitution(ctx, tc.Data)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
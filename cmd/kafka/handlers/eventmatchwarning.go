@@ -2,19 +2,18 @@ package handlers
 
 import (
 	"context"
-	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
-	"github.com/rafaelsanzio/go-flashscore/pkg/player"
-	"github.com/rafaelsanzio/go-flashscore/pkg/team"
 )
 
 func HandleEventMatchWarning(ctx context.Context, data map[string]string) errs.AppError {
 	tournamentID := data["tournamentID"]
 	matchID := data["matchID"]
+	eventID := data["eventID"]
 	teamID := data["teamID"]
 	playerID := data["playerID"]
 	warning := data["warning"]
@@ -45,22 +44,29 @@ func HandleEventMatchWarning(ctx context.Context, data map[string]string) errs.A
 		return errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, err_.Error())
 	}
 
-	events := struct {
-		MatchEvent    model.EventsMatchType
-		Team          team.Team
-		Player        player.Player
-		Warning       model.Warnings
-		WarningMinute int
-		Created       time.Time
-	}{
-		MatchEvent:    model.EventWarning,
-		Team:          *teamWarn,
-		Player:        *playerWarn,
-		Warning:       model.Warnings(warning),
-		WarningMinute: warningMinuteAsInt,
-		Created:       time.Now(),
+	period, err := parsePeriod(data["period"])
+	if err != nil {
+		return err
+	}
+
+	stoppage, err := parseStoppage(data["stoppage"])
+	if err != nil {
+		return err
+	}
+
+	matchEvent := event.New(tournamentID, matchID, event.Warning{
+		Team:     *teamWarn,
+		Player:   *playerWarn,
+		Warning:  model.Warnings(warning),
+		Period:   period,
+		Minute:   warningMinuteAsInt,
+		Stoppage: stoppage,
+	})
+	setEventID(&matchEvent, eventID)
+	err = match.ApplyEvent(matchEvent)
+	if err != nil {
+		return err
 	}
-	match.Events = append(match.Events, events)
 
 	matchUpdated, err := repo.GetMatchRepo().Update(ctx, *match)
 	if err != nil || matchUpdated == nil {
@@ -42,7 +42,7 @@ func TestHandleEventMatchWarning(t *testing.T) {
 			Name:                             "Handle event match goal correct",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -51,7 +51,7 @@ func TestHandleEventMatchWarning(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get tournament function",
 			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -60,7 +60,7 @@ func TestHandleEventMatchWarning(t *testing.T) {
 			Name:                             "Handle event match goal throw error on update match function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchThrowFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -78,7 +78,7 @@ func TestHandleEventMatchWarning(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get team function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamThrowFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -87,7 +87,7 @@ func TestHandleEventMatchWarning(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get team player function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -96,7 +96,7 @@ func TestHandleEventMatchWarning(t *testing.T) {
 			Name:                             "Handle event match goal throw error on get team player function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
 			StrconvAtoiFunc:                  strconvAtoi,
@@ -105,7 +105,7 @@ func TestHandleEventMatchWarning(t *testing.T) {
 			Name:                             "Handle event match goal throw error on strconv Atoi function",
 			HandleGetTournamentFunc:          mockGetTournamentFunc,
 			HandleUpdateMatchFunc:            mockUpdateMatchFunc,
-			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			StrconvAtoiFunc:                  fakeStrconvAtoi,
@@ -2,8 +2,12 @@ package handlers
 
 import (
 	"strconv"
+	"time"
 
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 )
 
 var strconvAtoi = strconv.Atoi
@@ -15,3 +19,54 @@ func fakeStrconvAtoi(s string) (int, error) {
 func restoreStrconvAtoi(replace func(s string) (int, error)) {
 	strconvAtoi = replace
 }
+
+// messages published before periods were tracked have no period or stoppage
+func parsePeriod(name string) (model.MatchPeriod, errs.AppError) {
+	var period model.MatchPeriod
+	if name == "" {
+		return period, nil
+	}
+
+	err_ := period.UnmarshalText([]byte(name))
+	if err_ != nil {
+		return period, errs.ErrConvertingPayload.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+	}
+
+	return period, nil
+}
+
+func parseStoppage(stoppage string) (int, errs.AppError) {
+	if stoppage == "" {
+		return 0, nil
+	}
+
+	stoppageAsInt, err_ := strconvAtoi(stoppage)
+	if err_ != nil {
+		return 0, errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+	}
+
+	return stoppageAsInt, nil
+}
+
+// the clock runs from the time the API stored the event, not from when it was consumed
+func parseCreated(created string) (time.Time, errs.AppError) {
+	if created == "" {
+		return time.Time{}, nil
+	}
+
+	createdAsTime, err_ := time.Parse(time.RFC3339Nano, created)
+	if err_ != nil {
+		return time.Time{}, errs.ErrConvertingPayload.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+	}
+
+	return createdAsTime, nil
+}
+
+// messages published before events had ids keep the id generated by event.New
+func setEventID(e *event.Event, eventID string) {
+	if eventID == "" {
+		return
+	}
+
+	e.ID = eventID
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func main() {
+	ctx := context.Background()
+
+	tz := flag.String("tz", "UTC", "time zone the legacy match date and time were written in")
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil {
+		_ = errs.ErrGettingEnv.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	loc, err := time.LoadLocation(*tz)
+	if err != nil {
+		log.Fatal(errs.ErrLoadingTimeZone.Throwf(applog.Log, errs.ErrFmt, err))
+	}
+
+	migrated, err_ := match.MigrateKickoffs(ctx, repo.GetMatchRepo(), loc)
+	if err_ != nil {
+		log.Fatal(err_)
+	}
+
+	log.Println("migrated kickoff of", migrated, "matches")
+}
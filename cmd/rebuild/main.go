@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/joho/godotenv"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func main() {
+	ctx := context.Background()
+
+	tournamentID := flag.String("tournament", "", "rebuild only the matches of this tournament")
+	matchID := flag.String("match", "", "rebuild only this match")
+	dryRun := flag.Bool("dry-run", false, "report the discrepancies without updating the matches")
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil {
+		_ = errs.ErrGettingEnv.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	matches, err_ := match.FindMatches(ctx, repo.GetMatchRepo(), *tournamentID, *matchID)
+	if err_ != nil {
+		log.Fatal(err_)
+	}
+
+	rebuilds, err_ := match.RebuildMatches(ctx, repo.GetMatchRepo(), repo.GetEventRepo(), matches, *dryRun)
+	if err_ != nil {
+		log.Fatal(err_)
+	}
+
+	drifted := 0
+	for _, rb := range rebuilds {
+		for _, d := range rb.Discrepancies {
+			log.Printf("match %s: %s stored %v, rebuilt %v", rb.MatchID, d.Field, d.Stored, d.Rebuilt)
+		}
+
+		if len(rb.Skipped) > 0 {
+			log.Printf("match %s: skipped events %v", rb.MatchID, rb.Skipped)
+		}
+
+		if len(rb.Discrepancies) > 0 {
+			drifted++
+		}
+	}
+
+	if *dryRun {
+		log.Println("found", drifted, "of", len(rebuilds), "matches to rebuild")
+		return
+	}
+
+	log.Println("rebuilt", drifted, "of", len(rebuilds), "matches")
+}
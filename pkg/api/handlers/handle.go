@@ -36,3 +36,18 @@ func HandleAdapter(hf http.HandlerFunc) http.HandlerFunc {
 		hf(w, r)
 	}
 }
+
+// HandleAdminAdapter also requires the admin key of the Admin-Key header on
+// top of the API key.
+func HandleAdminAdapter(hf http.HandlerFunc) http.HandlerFunc {
+	return HandleAdapter(func(w http.ResponseWriter, r *http.Request) {
+		err := middleware.IsAdmin(r.Header.Get("Admin-Key"))
+		if err != nil {
+			applog.Log.Warnf("Admin Key is not valid: %s", err.Error())
+			errs.HttpUnauthorized(w)
+			return
+		}
+
+		hf(w, r)
+	})
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandleDeleteCompetition(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	competition, err := repo.GetCompetitionRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if competition == nil {
+		_ = errs.ErrCompetitionIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	seasons, err := repo.GetTournamentRepo().ListByCompetition(ctx, competition.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if len(seasons) > 0 {
+		err = errs.ErrCompetitionHasSeasons.Throwf(applog.Log, errs.ErrFmt, seasons[0].ID)
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	err = repo.GetCompetitionRepo().Delete(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
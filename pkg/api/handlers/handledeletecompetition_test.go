@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockDeleteCompetitionFunc(ctx context.Context, id string) errs.AppError {
+	return nil
+}
+
+func mockDeleteCompetitionThrowFunc(ctx context.Context, id string) errs.AppError {
+	return errs.ErrRepoMockAction
+}
+
+func mockListSeasonsFunc(ctx context.Context) ([]tournament.Tournament, errs.AppError) {
+	season := prototype.PrototypeTournament()
+	season.CompetitionID = "1"
+	season.Season = "2026/27"
+
+	return []tournament.Tournament{season, prototype.PrototypeTournament()}, nil
+}
+
+func mockListCompetitionSeasonsFunc(ctx context.Context, competitionID string) ([]tournament.Tournament, errs.AppError) {
+	season := prototype.PrototypeTournament()
+	season.CompetitionID = competitionID
+	season.Season = "2026/27"
+
+	return []tournament.Tournament{season}, nil
+}
+
+func mockListCompetitionNoSeasonsFunc(ctx context.Context, competitionID string) ([]tournament.Tournament, errs.AppError) {
+	return []tournament.Tournament{}, nil
+}
+
+func mockListCompetitionSeasonsThrowFunc(ctx context.Context, competitionID string) ([]tournament.Tournament, errs.AppError) {
+	return []tournament.Tournament{}, errs.ErrRepoMockAction
+}
+
+func TestHandleDeleteCompetition(t *testing.T) {
+	testCases := []struct {
+		Name                        string
+		ID                          string
+		HandleDeleteCompetitionFunc func(ctx context.Context, id string) errs.AppError
+		HandleGetCompetitionFunc    func(ctx context.Context, id string) (*competition.Competition, errs.AppError)
+		HandleListSeasonsFunc       func(ctx context.Context, competitionID string) ([]tournament.Tournament, errs.AppError)
+		ExpectedStatusCode          int
+	}{
+		{
+			Name:                        "Success handle delete competition",
+			ID:                          "1",
+			HandleDeleteCompetitionFunc: mockDeleteCompetitionFunc,
+			HandleGetCompetitionFunc:    mockGetCompetitionFunc,
+			HandleListSeasonsFunc:       mockListCompetitionNoSeasonsFunc,
+			ExpectedStatusCode:          204,
+		}, {
+			Name:                        "Not Found handle delete competition",
+			ID:                          "",
+			HandleDeleteCompetitionFunc: mockDeleteCompetitionFunc,
+			HandleGetCompetitionFunc:    mockGetCompetitionFunc,
+			HandleListSeasonsFunc:       mockListCompetitionNoSeasonsFunc,
+			ExpectedStatusCode:          404,
+		}, {
+			Name:                        "Throwing error on delete function",
+			ID:                          "1",
+			HandleDeleteCompetitionFunc: mockDeleteCompetitionThrowFunc,
+			HandleGetCompetitionFunc:    mockGetCompetitionFunc,
+			HandleListSeasonsFunc:       mockListCompetitionNoSeasonsFunc,
+			ExpectedStatusCode:          500,
+		}, {
+			Name:                        "Throwing error on get function",
+			ID:                          "1",
+			HandleDeleteCompetitionFunc: mockDeleteCompetitionFunc,
+			HandleGetCompetitionFunc:    mockGetCompetitionThrowFunc,
+			HandleListSeasonsFunc:       mockListCompetitionNoSeasonsFunc,
+			ExpectedStatusCode:          500,
+		}, {
+			Name:                        "Throwing error on get function returning nil",
+			ID:                          "1",
+			HandleDeleteCompetitionFunc: mockDeleteCompetitionFunc,
+			HandleGetCompetitionFunc:    mockGetCompetitionNilFunc,
+			HandleListSeasonsFunc:       mockListCompetitionNoSeasonsFunc,
+			ExpectedStatusCode:          404,
+		}, {
+			Name:                        "Throwing error on list seasons function",
+			ID:                          "1",
+			HandleDeleteCompetitionFunc: mockDeleteCompetitionFunc,
+			HandleGetCompetitionFunc:    mockGetCompetitionFunc,
+			HandleListSeasonsFunc:       mockListCompetitionSeasonsThrowFunc,
+			ExpectedStatusCode:          500,
+		}, {
+			Name:                        "Should return 422 if the competition has seasons",
+			ID:                          "1",
+			HandleDeleteCompetitionFunc: mockDeleteCompetitionFunc,
+			HandleGetCompetitionFunc:    mockGetCompetitionFunc,
+			HandleListSeasonsFunc:       mockListCompetitionSeasonsFunc,
+			ExpectedStatusCode:          422,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetCompetitionRepo(repo.MockCompetitionRepo{
+			DeleteFunc: tc.HandleDeleteCompetitionFunc,
+			GetFunc:    tc.HandleGetCompetitionFunc,
+		})
+		defer repo.SetCompetitionRepo(nil)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			ListByCompetitionFunc: tc.HandleListSeasonsFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		req, err := http.NewRequest(http.MethodDelete, "/competitions/:id", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleDeleteCompetition(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+
+		if res.Code == 204 {
+			assert.NoError(t, err)
+		}
+	}
+}
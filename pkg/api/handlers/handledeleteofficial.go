@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandleDeleteOfficial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	official, err := repo.GetOfficialRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if official == nil {
+		_ = errs.ErrOfficialIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	err = repo.GetOfficialRepo().Delete(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+)
+
+func mockDeleteOfficialFunc(ctx context.Context, id string) errs.AppError {
+	return nil
+}
+
+func mockDeleteOfficialThrowFunc(ctx context.Context, id string) errs.AppError {
+	return errs.ErrRepoMockAction
+}
+
+func TestHandleDeleteOfficial(t *testing.T) {
+	testCases := []struct {
+		Name                     string
+		ID                       string
+		HandleDeleteOfficialFunc func(ctx context.Context, id string) errs.AppError
+		HandleGetOfficialFunc    func(ctx context.Context, id string) (*official.Official, errs.AppError)
+		ExpectedStatusCode       int
+	}{
+		{
+			Name:                     "Success handle delete official",
+			ID:                       "1",
+			HandleDeleteOfficialFunc: mockDeleteOfficialFunc,
+			HandleGetOfficialFunc:    mockGetOfficialFunc,
+			ExpectedStatusCode:       204,
+		}, {
+			Name:                     "Not Found handle delete official",
+			ID:                       "",
+			HandleDeleteOfficialFunc: mockDeleteOfficialFunc,
+			HandleGetOfficialFunc:    mockGetOfficialFunc,
+			ExpectedStatusCode:       404,
+		}, {
+			Name:                     "Throwing error on delete function",
+			ID:                       "1",
+			HandleDeleteOfficialFunc: mockDeleteOfficialThrowFunc,
+			HandleGetOfficialFunc:    mockGetOfficialFunc,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Throwing error on get function",
+			ID:                       "1",
+			HandleDeleteOfficialFunc: mockDeleteOfficialFunc,
+			HandleGetOfficialFunc:    mockGetOfficialThrowFunc,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Throwing error on get function returning nil",
+			ID:                       "1",
+			HandleDeleteOfficialFunc: mockDeleteOfficialFunc,
+			HandleGetOfficialFunc:    mockGetOfficialNilFunc,
+			ExpectedStatusCode:       404,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetOfficialRepo(repo.MockOfficialRepo{
+			DeleteFunc: tc.HandleDeleteOfficialFunc,
+			GetFunc:    tc.HandleGetOfficialFunc,
+		})
+		defer repo.SetOfficialRepo(nil)
+
+		req, err := http.NewRequest(http.MethodDelete, "/officials/:id", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleDeleteOfficial(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+
+		if res.Code == 204 {
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandleDeleteVenue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	venue, err := repo.GetVenueRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if venue == nil {
+		_ = errs.ErrVenueIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	err = repo.GetVenueRepo().Delete(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func mockDeleteVenueFunc(ctx context.Context, id string) errs.AppError {
+	return nil
+}
+
+func mockDeleteVenueThrowFunc(ctx context.Context, id string) errs.AppError {
+	return errs.ErrRepoMockAction
+}
+
+func TestHandleDeleteVenue(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		ID                    string
+		HandleDeleteVenueFunc func(ctx context.Context, id string) errs.AppError
+		HandleGetVenueFunc    func(ctx context.Context, id string) (*venue.Venue, errs.AppError)
+		ExpectedStatusCode    int
+	}{
+		{
+			Name:                  "Success handle delete venue",
+			ID:                    "1",
+			HandleDeleteVenueFunc: mockDeleteVenueFunc,
+			HandleGetVenueFunc:    mockGetVenueFunc,
+			ExpectedStatusCode:    204,
+		}, {
+			Name:                  "Not Found handle delete venue",
+			ID:                    "",
+			HandleDeleteVenueFunc: mockDeleteVenueFunc,
+			HandleGetVenueFunc:    mockGetVenueFunc,
+			ExpectedStatusCode:    404,
+		}, {
+			Name:                  "Throwing error on delete function",
+			ID:                    "1",
+			HandleDeleteVenueFunc: mockDeleteVenueThrowFunc,
+			HandleGetVenueFunc:    mockGetVenueFunc,
+			ExpectedStatusCode:    500,
+		}, {
+			Name:                  "Throwing error on get function",
+			ID:                    "1",
+			HandleDeleteVenueFunc: mockDeleteVenueFunc,
+			HandleGetVenueFunc:    mockGetVenueThrowFunc,
+			ExpectedStatusCode:    500,
+		}, {
+			Name:                  "Throwing error on get function returning nil",
+			ID:                    "1",
+			HandleDeleteVenueFunc: mockDeleteVenueFunc,
+			HandleGetVenueFunc:    mockGetVenueNilFunc,
+			ExpectedStatusCode:    404,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetVenueRepo(repo.MockVenueRepo{
+			DeleteFunc: tc.HandleDeleteVenueFunc,
+			GetFunc:    tc.HandleGetVenueFunc,
+		})
+		defer repo.SetVenueRepo(nil)
+
+		req, err := http.NewRequest(http.MethodDelete, "/venues/:id", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleDeleteVenue(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+
+		if res.Code == 204 {
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+)
+
+func mockGetCompetitionFunc(ctx context.Context, id string) (*competition.Competition, errs.AppError) {
+	competitionMock := prototype.PrototypeCompetition()
+	return &competitionMock, nil
+}
+
+func mockGetCompetitionThrowFunc(ctx context.Context, id string) (*competition.Competition, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func mockGetCompetitionNilFunc(ctx context.Context, id string) (*competition.Competition, errs.AppError) {
+	return nil, nil
+}
+
+func TestHandleGetCompetition(t *testing.T) {
+	testCases := []struct {
+		Name                     string
+		ID                       string
+		HandleGetCompetitionFunc func(ctx context.Context, id string) (*competition.Competition, errs.AppError)
+		MarshalFunc              func(v interface{}) ([]byte, error)
+		WriteFunc                func(http.ResponseWriter, []byte) (int, error)
+		ExpectedStatusCode       int
+	}{
+		{
+			Name:                     "Success handle get competition",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       200,
+		}, {
+			Name:                     "Not Found handle get competition",
+			ID:                       "",
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       404,
+		}, {
+			Name:                     "Getting error on competition repo",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionThrowFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Getting error on marshal function",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			MarshalFunc:              fakeMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Getting error on write function",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                fakeWrite,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Getting error on get func returning nil",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionNilFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       404,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetCompetitionRepo(repo.MockCompetitionRepo{
+			GetFunc: tc.HandleGetCompetitionFunc,
+		})
+		defer repo.SetCompetitionRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/competitions/:id", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleGetCompetition(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			competition := competition.Competition{}
+			err = json.Unmarshal(res.Body.Bytes(), &competition)
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandleGetGroupStandings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matches, err := match.FindMatches(ctx, repo.GetMatchRepo(), tournament.ID, "")
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	data, err_ := jsonMarshal(match.ComputeGroupStandings(*tournament, matches))
+	if err_ != nil {
+		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	_, err_ = write(w, data)
+	if err_ != nil {
+		_ = errs.ErrResponseWriter.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
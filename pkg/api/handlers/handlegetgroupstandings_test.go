@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockGetTournamentWithGroupsFunc(ctx context.Context, id string) (*tournament.Tournament, errs.AppError) {
+	tournamentMock := prototype.PrototypeTournament()
+	tournamentMock.Teams = []team.Team{{ID: "home", Name: "Home"}, {ID: "away", Name: "Away"}, {ID: "t3", Name: "Team 3"}, {ID: "t4", Name: "Team 4"}}
+	tournamentMock.Groups = []tournament.Group{
+		{Name: "A", Teams: tournamentMock.Teams[:2]},
+		{Name: "B", Teams: tournamentMock.Teams[2:]},
+	}
+	tournamentMock.Qualification = &tournament.Qualification{PerGroup: 1}
+	return &tournamentMock, nil
+}
+
+func mockListGroupMatchFunc(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	matches, err := mockListFinishedMatchFunc(ctx, tournamentID)
+
+	groupB := prototype.PrototypeMatch()
+	groupB.HomeTeam = team.Team{ID: "t3", Name: "Team 3"}
+	groupB.AwayTeam = team.Team{ID: "t4", Name: "Team 4"}
+	groupB.Status = model.MatchStatusFinished
+	groupB.Score = match.Score{Home: 2}
+	groupB.Group = "B"
+
+	matches[0].Group = "A"
+	return append(matches, groupB), err
+}
+
+func mockListGroupMatchUnfinishedFunc(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	matches, err := mockListGroupMatchFunc(ctx, tournamentID)
+	matches[1].Status = model.MatchStatusInProgress
+	return matches, err
+}
+
+func TestHandleGetGroupStandings(t *testing.T) {
+	testCases := []struct {
+		Name                    string
+		ID                      string
+		HandleGetTournamentFunc func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleListMatchFunc     func(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError)
+		MarshalFunc             func(v interface{}) ([]byte, error)
+		WriteFunc               func(http.ResponseWriter, []byte) (int, error)
+		ExpectedStatusCode      int
+	}{
+		{
+			Name:                    "Success handle get group standings",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      200,
+		}, {
+			Name:                    "Not Found id param to handle get group standings",
+			ID:                      "",
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      404,
+		}, {
+			Name:                    "Getting error on get tournament function",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentThrowFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Getting error on get tournament function returning nil",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentNilFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      404,
+		}, {
+			Name:                    "Getting error on list match function",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListTournamentMatchThrowFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Getting error on marshal function",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			MarshalFunc:             fakeMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Getting error on write function",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               fakeWrite,
+			ExpectedStatusCode:      500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			ListByTournamentFunc: tc.HandleListMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/tournaments/:id/groups/standings", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleGetGroupStandings(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			groups := []match.GroupStandings{}
+			err = json.Unmarshal(res.Body.Bytes(), &groups)
+			assert.NoError(t, err)
+
+			assert.Len(t, groups, 2)
+			assert.Equal(t, "A", groups[0].Group)
+			assert.Len(t, groups[0].Standings, 2)
+			assert.Equal(t, "away", groups[0].Standings[0].TeamID)
+			assert.Equal(t, "B", groups[1].Group)
+			assert.Equal(t, "t3", groups[1].Standings[0].TeamID)
+			assert.Equal(t, 3, groups[1].Standings[0].Points)
+		}
+	}
+}
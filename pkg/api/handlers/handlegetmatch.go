@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -22,6 +24,12 @@ func HandleGetMatch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	loc, err := decodeTimeZone(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
 	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
 	if err != nil {
 		errs.HttpInternalServerError(w)
@@ -52,6 +60,9 @@ func HandleGetMatch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	match.Clock = match.ClockAt(time.Now())
+	match.InLocation(loc)
+
 	data, err_ := jsonMarshal(match)
 	if err_ != nil {
 		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
@@ -68,3 +79,26 @@ func HandleGetMatch(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusOK)
 }
+
+// venueTimeZone asks for the times of each match in its venue time zone
+const venueTimeZone = "venue"
+
+// decodeTimeZone reads the time zone the client wants the match times in, UTC
+// by default, a nil location stands for the venue time zone.
+func decodeTimeZone(r *http.Request) (*time.Location, errs.AppError) {
+	tz := r.URL.Query().Get("tz")
+
+	switch tz {
+	case "":
+		return time.UTC, nil
+	case venueTimeZone:
+		return nil, nil
+	}
+
+	loc, err_ := time.LoadLocation(tz)
+	if err_ != nil {
+		return nil, errs.ErrLoadingTimeZone.Throwf(applog.Log, errs.ErrFmt, tz)
+	}
+
+	return loc, nil
+}
@@ -6,12 +6,15 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
 	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
@@ -22,6 +25,20 @@ func mockFindMatchForTournamentFunc(ctx context.Context, id, tournamentID string
 	return &matchMock, nil
 }
 
+func mockFindMatchStartedForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Period = model.PeriodFirstHalf
+	matchMock.Status = model.MatchStatusInProgress
+	matchMock.AddEvent(event.New(matchMock.Tournament.ID, matchMock.ID, event.Start{TimeStarted: "16:00"}))
+	return &matchMock, nil
+}
+
+func mockFindMatchWithTimeZoneForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.TimeZone = "Asia/Tokyo"
+	return &matchMock, nil
+}
+
 func mockFindMatchForTournamentThrowFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
 	return nil, errs.ErrRepoMockAction
 }
@@ -39,7 +56,10 @@ func TestHandleGetMatch(t *testing.T) {
 		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
 		MarshalFunc                      func(v interface{}) ([]byte, error)
 		WriteFunc                        func(http.ResponseWriter, []byte) (int, error)
+		TimeZone                         string
 		ExpectedStatusCode               int
+		ExpectedClock                    bool
+		ExpectedKickoff                  string
 	}{
 		{
 			Name:                             "Success handle get match",
@@ -50,6 +70,50 @@ func TestHandleGetMatch(t *testing.T) {
 			MarshalFunc:                      jsonMarshal,
 			WriteFunc:                        write,
 			ExpectedStatusCode:               200,
+			ExpectedKickoff:                  "2022-02-01T16:00:00Z",
+		}, {
+			Name:                             "Success handle get match in the client time zone",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			TimeZone:                         "America/Sao_Paulo",
+			ExpectedStatusCode:               200,
+			ExpectedKickoff:                  "2022-02-01T13:00:00-03:00",
+		}, {
+			Name:                             "Success handle get match in the venue time zone",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchWithTimeZoneForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			TimeZone:                         "venue",
+			ExpectedStatusCode:               200,
+			ExpectedKickoff:                  "2022-02-02T01:00:00+09:00",
+		}, {
+			Name:                             "Unprocessable time zone to handle get match",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			TimeZone:                         "Europe/Nowhere",
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Success handle get match with the live clock",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               200,
+			ExpectedClock:                    true,
+			ExpectedKickoff:                  "2022-02-01T16:00:00Z",
 		}, {
 			Name:                             "Not Found id param to handle get match",
 			ID:                               "",
@@ -144,7 +208,7 @@ func TestHandleGetMatch(t *testing.T) {
 		write = tc.WriteFunc
 		defer restoreWrite(write)
 
-		req, err := http.NewRequest(http.MethodGet, "/tournaments/:id/matches/:match_id", nil)
+		req, err := http.NewRequest(http.MethodGet, "/tournaments/:id/matches/:match_id?tz="+tc.TimeZone, nil)
 		req = mux.SetURLVars(req, map[string]string{"id": tc.ID, "match_id": tc.MatchID})
 		assert.NoError(t, err)
 		res := httptest.NewRecorder()
@@ -158,6 +222,8 @@ func TestHandleGetMatch(t *testing.T) {
 			match := match.Match{}
 			err = json.Unmarshal(res.Body.Bytes(), &match)
 			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedClock, match.Clock != nil)
+			assert.Equal(t, tc.ExpectedKickoff, match.Kickoff.Format(time.RFC3339))
 		}
 	}
 }
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockFindMatchWithStatisticsForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusInProgress
+	matchMock.AddEvent(event.New(matchMock.Tournament.ID, matchMock.ID, event.Shot{
+		Team:     matchMock.HomeTeam,
+		Player:   prototype.PrototypePlayer(),
+		OnTarget: true,
+		Minute:   10,
+	}))
+	matchMock.AddEvent(event.New(matchMock.Tournament.ID, matchMock.ID, event.Possession{Home: 60, Away: 40, Minute: 15}))
+	return &matchMock, nil
+}
+
+func TestHandleGetMatchStatistics(t *testing.T) {
+	testCases := []struct {
+		Name                             string
+		ID                               string
+		MatchID                          string
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		MarshalFunc                      func(v interface{}) ([]byte, error)
+		WriteFunc                        func(http.ResponseWriter, []byte) (int, error)
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Success handle get match statistics",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchWithStatisticsForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               200,
+		}, {
+			Name:                             "Not Found id param to handle get match statistics",
+			ID:                               "",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchWithStatisticsForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Not Found match_id param to handle get match statistics",
+			ID:                               "1",
+			MatchID:                          "",
+			HandleFindMatchForTournamentFunc: mockFindMatchWithStatisticsForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Getting error on get match function",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Getting error on get func returning nil",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Getting error on get tournament function",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchWithStatisticsForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Getting error on get tournament function retuning nil",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchWithStatisticsForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentNilFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Getting error on marshal function",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchWithStatisticsForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      fakeMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Getting error on write function",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchWithStatisticsForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        fakeWrite,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/tournaments/:id/matches/:match_id/statistics", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID, "match_id": tc.MatchID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleGetMatchStatistics(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			statistics := match.Statistics{}
+			err = json.Unmarshal(res.Body.Bytes(), &statistics)
+			assert.NoError(t, err)
+
+			assert.Equal(t, 1, statistics.Home.Shots)
+			assert.Equal(t, 1, statistics.Home.ShotsOnTarget)
+			assert.Equal(t, 60, statistics.Home.Possession)
+			assert.Equal(t, 40, statistics.Away.Possession)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+)
+
+func mockGetOfficialFunc(ctx context.Context, id string) (*official.Official, errs.AppError) {
+	officialMock := prototype.PrototypeOfficial()
+	return &officialMock, nil
+}
+
+func mockGetOfficialThrowFunc(ctx context.Context, id string) (*official.Official, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func mockGetOfficialNilFunc(ctx context.Context, id string) (*official.Official, errs.AppError) {
+	return nil, nil
+}
+
+func TestHandleGetOfficial(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		ID                    string
+		HandleGetOfficialFunc func(ctx context.Context, id string) (*official.Official, errs.AppError)
+		MarshalFunc           func(v interface{}) ([]byte, error)
+		WriteFunc             func(http.ResponseWriter, []byte) (int, error)
+		ExpectedStatusCode    int
+	}{
+		{
+			Name:                  "Success handle get official",
+			ID:                    "1",
+			HandleGetOfficialFunc: mockGetOfficialFunc,
+			MarshalFunc:           jsonMarshal,
+			WriteFunc:             write,
+			ExpectedStatusCode:    200,
+		}, {
+			Name:                  "Not Found handle get official",
+			ID:                    "",
+			HandleGetOfficialFunc: mockGetOfficialFunc,
+			MarshalFunc:           jsonMarshal,
+			WriteFunc:             write,
+			ExpectedStatusCode:    404,
+		}, {
+			Name:                  "Getting error on official repo",
+			ID:                    "1",
+			HandleGetOfficialFunc: mockGetOfficialThrowFunc,
+			MarshalFunc:           jsonMarshal,
+			WriteFunc:             write,
+			ExpectedStatusCode:    500,
+		}, {
+			Name:                  "Getting error on marshal function",
+			ID:                    "1",
+			HandleGetOfficialFunc: mockGetOfficialFunc,
+			MarshalFunc:           fakeMarshal,
+			WriteFunc:             write,
+			ExpectedStatusCode:    500,
+		}, {
+			Name:                  "Getting error on write function",
+			ID:                    "1",
+			HandleGetOfficialFunc: mockGetOfficialFunc,
+			MarshalFunc:           jsonMarshal,
+			WriteFunc:             fakeWrite,
+			ExpectedStatusCode:    500,
+		}, {
+			Name:                  "Getting error on get func returning nil",
+			ID:                    "1",
+			HandleGetOfficialFunc: mockGetOfficialNilFunc,
+			MarshalFunc:           jsonMarshal,
+			WriteFunc:             write,
+			ExpectedStatusCode:    404,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetOfficialRepo(repo.MockOfficialRepo{
+			GetFunc: tc.HandleGetOfficialFunc,
+		})
+		defer repo.SetOfficialRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/officials/:id", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleGetOfficial(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			official := official.Official{}
+			err = json.Unmarshal(res.Body.Bytes(), &official)
+			assert.NoError(t, err)
+		}
+	}
+}
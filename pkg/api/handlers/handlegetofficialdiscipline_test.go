@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+)
+
+func mockListByOfficialFunc(ctx context.Context, officialID string) ([]match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusFinished
+	matchMock.Officials = []match.MatchOfficial{{Official: prototype.PrototypeOfficial(), Role: model.OfficialReferee}}
+	matchMock.AddEvent(event.New(matchMock.Tournament.ID, matchMock.ID, event.Warning{
+		Team:    matchMock.HomeTeam,
+		Player:  player.Player{ID: "1"},
+		Warning: model.WarningYellowCard,
+		Minute:  10,
+	}))
+	return []match.Match{matchMock}, nil
+}
+
+func mockListByOfficialThrowFunc(ctx context.Context, officialID string) ([]match.Match, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func TestHandleGetOfficialDiscipline(t *testing.T) {
+	testCases := []struct {
+		Name                     string
+		ID                       string
+		HandleGetOfficialFunc    func(ctx context.Context, id string) (*official.Official, errs.AppError)
+		HandleListByOfficialFunc func(ctx context.Context, officialID string) ([]match.Match, errs.AppError)
+		MarshalFunc              func(v interface{}) ([]byte, error)
+		WriteFunc                func(http.ResponseWriter, []byte) (int, error)
+		ExpectedStatusCode       int
+	}{
+		{
+			Name:                     "Success handle get official discipline",
+			ID:                       "1",
+			HandleGetOfficialFunc:    mockGetOfficialFunc,
+			HandleListByOfficialFunc: mockListByOfficialFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       200,
+		}, {
+			Name:                     "Not Found handle get official discipline",
+			ID:                       "",
+			HandleGetOfficialFunc:    mockGetOfficialFunc,
+			HandleListByOfficialFunc: mockListByOfficialFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       404,
+		}, {
+			Name:                     "Getting error on get func returning nil",
+			ID:                       "1",
+			HandleGetOfficialFunc:    mockGetOfficialNilFunc,
+			HandleListByOfficialFunc: mockListByOfficialFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       404,
+		}, {
+			Name:                     "Getting error on official repo",
+			ID:                       "1",
+			HandleGetOfficialFunc:    mockGetOfficialThrowFunc,
+			HandleListByOfficialFunc: mockListByOfficialFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Getting error on list by official func",
+			ID:                       "1",
+			HandleGetOfficialFunc:    mockGetOfficialFunc,
+			HandleListByOfficialFunc: mockListByOfficialThrowFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Getting error on marshal function",
+			ID:                       "1",
+			HandleGetOfficialFunc:    mockGetOfficialFunc,
+			HandleListByOfficialFunc: mockListByOfficialFunc,
+			MarshalFunc:              fakeMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Getting error on write function",
+			ID:                       "1",
+			HandleGetOfficialFunc:    mockGetOfficialFunc,
+			HandleListByOfficialFunc: mockListByOfficialFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                fakeWrite,
+			ExpectedStatusCode:       500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetOfficialRepo(repo.MockOfficialRepo{
+			GetFunc: tc.HandleGetOfficialFunc,
+		})
+		defer repo.SetOfficialRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			ListByOfficialFunc: tc.HandleListByOfficialFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/officials/:id/discipline", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleGetOfficialDiscipline(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			discipline := match.OfficialDiscipline{}
+			err = json.Unmarshal(res.Body.Bytes(), &discipline)
+			assert.NoError(t, err)
+
+			assert.Equal(t, 1, discipline.Matches)
+			assert.Equal(t, 1, discipline.YellowCards)
+		}
+	}
+}
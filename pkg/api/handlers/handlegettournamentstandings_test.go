@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockGetTournamentWithTeamsFunc(ctx context.Context, id string) (*tournament.Tournament, errs.AppError) {
+	tournamentMock := prototype.PrototypeTournament()
+	tournamentMock.Teams = []team.Team{{ID: "home", Name: "Home"}, {ID: "away", Name: "Away"}}
+	return &tournamentMock, nil
+}
+
+func mockListFinishedMatchFunc(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.HomeTeam = team.Team{ID: "home", Name: "Home"}
+	matchMock.AwayTeam = team.Team{ID: "away", Name: "Away"}
+	matchMock.Status = model.MatchStatusFinished
+	matchMock.Score = match.Score{Home: 1, Away: 2}
+	return []match.Match{matchMock}, nil
+}
+
+func mockListTournamentMatchThrowFunc(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func TestHandleGetTournamentStandings(t *testing.T) {
+	testCases := []struct {
+		Name                    string
+		ID                      string
+		HandleGetTournamentFunc func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleListMatchFunc     func(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError)
+		MarshalFunc             func(v interface{}) ([]byte, error)
+		WriteFunc               func(http.ResponseWriter, []byte) (int, error)
+		ExpectedStatusCode      int
+	}{
+		{
+			Name:                    "Success handle get tournament standings",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandleListMatchFunc:     mockListFinishedMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      200,
+		}, {
+			Name:                    "Not Found id param to handle get tournament standings",
+			ID:                      "",
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandleListMatchFunc:     mockListFinishedMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      404,
+		}, {
+			Name:                    "Getting error on get tournament function",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentThrowFunc,
+			HandleListMatchFunc:     mockListFinishedMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Getting error on get tournament function returning nil",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentNilFunc,
+			HandleListMatchFunc:     mockListFinishedMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      404,
+		}, {
+			Name:                    "Getting error on list match function",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandleListMatchFunc:     mockListTournamentMatchThrowFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Getting error on marshal function",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandleListMatchFunc:     mockListFinishedMatchFunc,
+			MarshalFunc:             fakeMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Getting error on write function",
+			ID:                      "1",
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandleListMatchFunc:     mockListFinishedMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               fakeWrite,
+			ExpectedStatusCode:      500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			ListByTournamentFunc: tc.HandleListMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/tournaments/:id/standings", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleGetTournamentStandings(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			standings := []match.Standing{}
+			err = json.Unmarshal(res.Body.Bytes(), &standings)
+			assert.NoError(t, err)
+
+			assert.Len(t, standings, 2)
+			assert.Equal(t, "away", standings[0].TeamID)
+			assert.Equal(t, 3, standings[0].Points)
+			assert.Equal(t, "home", standings[1].TeamID)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func mockGetVenueFunc(ctx context.Context, id string) (*venue.Venue, errs.AppError) {
+	venueMock := prototype.PrototypeVenue()
+	return &venueMock, nil
+}
+
+func mockGetVenueThrowFunc(ctx context.Context, id string) (*venue.Venue, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func mockGetVenueNilFunc(ctx context.Context, id string) (*venue.Venue, errs.AppError) {
+	return nil, nil
+}
+
+func TestHandleGetVenue(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		ID                 string
+		HandleGetVenueFunc func(ctx context.Context, id string) (*venue.Venue, errs.AppError)
+		MarshalFunc        func(v interface{}) ([]byte, error)
+		WriteFunc          func(http.ResponseWriter, []byte) (int, error)
+		ExpectedStatusCode int
+	}{
+		{
+			Name:               "Success handle get venue",
+			ID:                 "1",
+			HandleGetVenueFunc: mockGetVenueFunc,
+			MarshalFunc:        jsonMarshal,
+			WriteFunc:          write,
+			ExpectedStatusCode: 200,
+		}, {
+			Name:               "Not Found handle get venue",
+			ID:                 "",
+			HandleGetVenueFunc: mockGetVenueFunc,
+			MarshalFunc:        jsonMarshal,
+			WriteFunc:          write,
+			ExpectedStatusCode: 404,
+		}, {
+			Name:               "Getting error on venue repo",
+			ID:                 "1",
+			HandleGetVenueFunc: mockGetVenueThrowFunc,
+			MarshalFunc:        jsonMarshal,
+			WriteFunc:          write,
+			ExpectedStatusCode: 500,
+		}, {
+			Name:               "Getting error on marshal function",
+			ID:                 "1",
+			HandleGetVenueFunc: mockGetVenueFunc,
+			MarshalFunc:        fakeMarshal,
+			WriteFunc:          write,
+			ExpectedStatusCode: 500,
+		}, {
+			Name:               "Getting error on write function",
+			ID:                 "1",
+			HandleGetVenueFunc: mockGetVenueFunc,
+			MarshalFunc:        jsonMarshal,
+			WriteFunc:          fakeWrite,
+			ExpectedStatusCode: 500,
+		}, {
+			Name:               "Getting error on get func returning nil",
+			ID:                 "1",
+			HandleGetVenueFunc: mockGetVenueNilFunc,
+			MarshalFunc:        jsonMarshal,
+			WriteFunc:          write,
+			ExpectedStatusCode: 404,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetVenueRepo(repo.MockVenueRepo{
+			GetFunc: tc.HandleGetVenueFunc,
+		})
+		defer repo.SetVenueRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/venues/:id", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleGetVenue(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			venue := venue.Venue{}
+			err = json.Unmarshal(res.Body.Bytes(), &venue)
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/cache"
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+)
+
+func mockListCompetitionFunc(ctx context.Context) ([]competition.Competition, errs.AppError) {
+	competitionMock := prototype.PrototypeCompetition()
+
+	competitionMock2 := prototype.PrototypeCompetition()
+	competitionMock2.Name = "La Liga"
+	competitionMock2.Country = "Spain"
+
+	competitionMockList := []competition.Competition{competitionMock, competitionMock2}
+
+	return competitionMockList, nil
+}
+
+func mockListCompetitionThrowFunc(ctx context.Context) ([]competition.Competition, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func TestHandleListCompetition(t *testing.T) {
+	testCases := []struct {
+		Name                      string
+		HandleListCompetitionFunc func(ctx context.Context) ([]competition.Competition, errs.AppError)
+		MarshalFunc               func(v interface{}) ([]byte, error)
+		WriteFunc                 func(http.ResponseWriter, []byte) (int, error)
+		CacheSetFunc              func(ctx context.Context, key string, value []byte) error
+		ExpectedStatusCode        int
+	}{
+		{
+			Name:                      "Success handle list competitions",
+			HandleListCompetitionFunc: mockListCompetitionFunc,
+			MarshalFunc:               jsonMarshal,
+			WriteFunc:                 write,
+			CacheSetFunc:              mockCacheSetFunc,
+			ExpectedStatusCode:        200,
+		}, {
+			Name:                      "Throwing handle list competitions",
+			HandleListCompetitionFunc: mockListCompetitionThrowFunc,
+			MarshalFunc:               jsonMarshal,
+			WriteFunc:                 write,
+			CacheSetFunc:              mockCacheSetFunc,
+			ExpectedStatusCode:        500,
+		}, {
+			Name:                      "Throwing error on marshal function",
+			HandleListCompetitionFunc: mockListCompetitionFunc,
+			MarshalFunc:               fakeMarshal,
+			WriteFunc:                 write,
+			CacheSetFunc:              mockCacheSetFunc,
+			ExpectedStatusCode:        500,
+		}, {
+			Name:                      "Throwing error on write function",
+			HandleListCompetitionFunc: mockListCompetitionFunc,
+			MarshalFunc:               jsonMarshal,
+			WriteFunc:                 fakeWrite,
+			CacheSetFunc:              mockCacheSetFunc,
+			ExpectedStatusCode:        500,
+		}, {
+			Name:                      "Logging error on cache set function",
+			HandleListCompetitionFunc: mockListCompetitionFunc,
+			MarshalFunc:               jsonMarshal,
+			WriteFunc:                 write,
+			CacheSetFunc:              mockCacheSetThrowFunc,
+			ExpectedStatusCode:        200,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetCompetitionRepo(repo.MockCompetitionRepo{
+			ListFunc: tc.HandleListCompetitionFunc,
+		})
+		defer repo.SetCompetitionRepo(nil)
+
+		defer cache.SetStore(cache.GetStore())
+		cache.SetStore(cache.MockCacheStore{
+			SetFunc: tc.CacheSetFunc,
+		})
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/competitions", nil)
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleListCompetition(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			competition := []competition.Competition{}
+			err = json.Unmarshal(res.Body.Bytes(), &competition)
+			assert.NoError(t, err)
+
+			assert.Equal(t, 2, len(competition))
+		}
+	}
+}
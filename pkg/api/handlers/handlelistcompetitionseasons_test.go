@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func TestHandleListCompetitionSeasons(t *testing.T) {
+	testCases := []struct {
+		Name                     string
+		ID                       string
+		HandleGetCompetitionFunc func(ctx context.Context, id string) (*competition.Competition, errs.AppError)
+		HandleListSeasonsFunc    func(ctx context.Context, competitionID string) ([]tournament.Tournament, errs.AppError)
+		MarshalFunc              func(v interface{}) ([]byte, error)
+		WriteFunc                func(http.ResponseWriter, []byte) (int, error)
+		ExpectedStatusCode       int
+		ExpectedSeasons          int
+	}{
+		{
+			Name:                     "Success handle list competition seasons",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			HandleListSeasonsFunc:    mockListCompetitionSeasonsFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       200,
+			ExpectedSeasons:          1,
+		}, {
+			Name:                     "Success handle list competition without seasons",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			HandleListSeasonsFunc:    mockListCompetitionNoSeasonsFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       200,
+			ExpectedSeasons:          0,
+		}, {
+			Name:                     "Not Found handle list competition seasons",
+			ID:                       "",
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			HandleListSeasonsFunc:    mockListCompetitionSeasonsFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       404,
+		}, {
+			Name:                     "Getting error on competition repo",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionThrowFunc,
+			HandleListSeasonsFunc:    mockListCompetitionSeasonsFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Getting error on get func returning nil",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionNilFunc,
+			HandleListSeasonsFunc:    mockListCompetitionSeasonsFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       404,
+		}, {
+			Name:                     "Getting error on tournament repo",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			HandleListSeasonsFunc:    mockListCompetitionSeasonsThrowFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Getting error on marshal function",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			HandleListSeasonsFunc:    mockListCompetitionSeasonsFunc,
+			MarshalFunc:              fakeMarshal,
+			WriteFunc:                write,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Getting error on write function",
+			ID:                       "1",
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			HandleListSeasonsFunc:    mockListCompetitionSeasonsFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                fakeWrite,
+			ExpectedStatusCode:       500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetCompetitionRepo(repo.MockCompetitionRepo{
+			GetFunc: tc.HandleGetCompetitionFunc,
+		})
+		defer repo.SetCompetitionRepo(nil)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			ListByCompetitionFunc: tc.HandleListSeasonsFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/competitions/:id/seasons", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleListCompetitionSeasons(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+
+		if res.Code == http.StatusOK {
+			seasons := []tournament.Tournament{}
+			err = json.Unmarshal(res.Body.Bytes(), &seasons)
+			assert.NoError(t, err)
+			assert.Len(t, seasons, tc.ExpectedSeasons)
+		}
+	}
+}
@@ -3,23 +3,48 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/cache"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 )
 
 func HandleListMatch(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	match, err := repo.GetMatchRepo().List(ctx)
+	loc, err := decodeTimeZone(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	from, to, err := decodeKickoffRange(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	matches, err := repo.GetMatchRepo().ListByKickoff(ctx, from, to)
 	if err != nil {
 		errs.HttpInternalServerError(w)
 		return
 	}
 
-	data, err_ := jsonMarshal(match)
+	query := r.URL.Query()
+	competitionID, season := query.Get("competition"), query.Get("season")
+
+	result := []match.Match{}
+	for _, mt := range matches {
+		if mt.Tournament.InSeason(competitionID, season) {
+			mt.InLocation(loc)
+			result = append(result, mt)
+		}
+	}
+
+	data, err_ := jsonMarshal(result)
 	if err_ != nil {
 		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
 		errs.HttpInternalServerError(w)
@@ -38,3 +63,28 @@ func HandleListMatch(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusOK)
 }
+
+// decodeKickoffRange reads the from (inclusive) and to (exclusive) kickoff
+// bounds, a missing bound is left open.
+func decodeKickoffRange(r *http.Request) (time.Time, time.Time, errs.AppError) {
+	var from, to time.Time
+	query := r.URL.Query()
+
+	if v := query.Get("from"); v != "" {
+		t, err_ := timeParse(time.RFC3339, v)
+		if err_ != nil {
+			return from, to, errs.ErrParsingTime.Throwf(applog.Log, errs.ErrFmt, v)
+		}
+		from = t.UTC()
+	}
+
+	if v := query.Get("to"); v != "" {
+		t, err_ := timeParse(time.RFC3339, v)
+		if err_ != nil {
+			return from, to, errs.ErrParsingTime.Throwf(applog.Log, errs.ErrFmt, v)
+		}
+		to = t.UTC()
+	}
+
+	return from, to, nil
+}
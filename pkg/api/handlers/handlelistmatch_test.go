@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
@@ -16,7 +17,7 @@ import (
 	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
 )
 
-func mockListMatchFunc(ctx context.Context) ([]match.Match, errs.AppError) {
+func mockListMatchFunc(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError) {
 	matchMock := prototype.PrototypeMatch()
 	matchMock2 := prototype.PrototypeMatch()
 
@@ -25,17 +26,35 @@ func mockListMatchFunc(ctx context.Context) ([]match.Match, errs.AppError) {
 	return matchMockList, nil
 }
 
-func mockListMatchThrowFunc(ctx context.Context) ([]match.Match, errs.AppError) {
+func mockListMatchThrowFunc(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError) {
 	return nil, errs.ErrRepoMockAction
 }
 
+func mockListMatchInRangeFunc(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError) {
+	if !from.Equal(time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2022, 2, 2, 3, 0, 0, 0, time.UTC)) {
+		return nil, errs.ErrRepoMockAction
+	}
+	return mockListMatchFunc(ctx, from, to)
+}
+
+func mockListSeasonMatchFunc(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError) {
+	matches, _ := mockListMatchFunc(ctx, from, to)
+	matches[0].Tournament.CompetitionID = "1"
+	matches[0].Tournament.Season = "2026/27"
+
+	return matches, nil
+}
+
 func TestHandleListMatch(t *testing.T) {
 	testCases := []struct {
 		Name                string
-		HandleListMatchFunc func(ctx context.Context) ([]match.Match, errs.AppError)
+		HandleListMatchFunc func(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError)
 		MarshalFunc         func(v interface{}) ([]byte, error)
 		WriteFunc           func(http.ResponseWriter, []byte) (int, error)
+		Query               string
 		ExpectedStatusCode  int
+		ExpectedMatches     int
+		ExpectedKickoff     string
 	}{
 		{
 			Name:                "Success handle list matches",
@@ -43,6 +62,47 @@ func TestHandleListMatch(t *testing.T) {
 			MarshalFunc:         jsonMarshal,
 			WriteFunc:           write,
 			ExpectedStatusCode:  200,
+			ExpectedMatches:     2,
+			ExpectedKickoff:     "2022-02-01T16:00:00Z",
+		}, {
+			Name:                "Success handle list matches in a kickoff range and time zone",
+			HandleListMatchFunc: mockListMatchInRangeFunc,
+			MarshalFunc:         jsonMarshal,
+			WriteFunc:           write,
+			Query:               "?from=2022-02-01T00:00:00Z&to=2022-02-02T00:00:00-03:00&tz=America/Sao_Paulo",
+			ExpectedStatusCode:  200,
+			ExpectedMatches:     2,
+			ExpectedKickoff:     "2022-02-01T13:00:00-03:00",
+		}, {
+			Name:                "Success handle list matches of a season",
+			HandleListMatchFunc: mockListSeasonMatchFunc,
+			MarshalFunc:         jsonMarshal,
+			WriteFunc:           write,
+			Query:               "?competition=1&season=2026/27",
+			ExpectedStatusCode:  200,
+			ExpectedMatches:     1,
+			ExpectedKickoff:     "2022-02-01T16:00:00Z",
+		}, {
+			Name:                "Unprocessable from param",
+			HandleListMatchFunc: mockListMatchFunc,
+			MarshalFunc:         jsonMarshal,
+			WriteFunc:           write,
+			Query:               "?from=2022-02-01",
+			ExpectedStatusCode:  422,
+		}, {
+			Name:                "Unprocessable to param",
+			HandleListMatchFunc: mockListMatchFunc,
+			MarshalFunc:         jsonMarshal,
+			WriteFunc:           write,
+			Query:               "?to=tomorrow",
+			ExpectedStatusCode:  422,
+		}, {
+			Name:                "Unprocessable tz param",
+			HandleListMatchFunc: mockListMatchFunc,
+			MarshalFunc:         jsonMarshal,
+			WriteFunc:           write,
+			Query:               "?tz=Mars/Olympus",
+			ExpectedStatusCode:  422,
 		}, {
 			Name:                "Throwing handle list matches",
 			HandleListMatchFunc: mockListMatchThrowFunc,
@@ -68,7 +128,7 @@ func TestHandleListMatch(t *testing.T) {
 		t.Log(tc.Name)
 
 		repo.SetMatchRepo(repo.MockMatchRepo{
-			ListFunc: tc.HandleListMatchFunc,
+			ListByKickoffFunc: tc.HandleListMatchFunc,
 		})
 		defer repo.SetMatchRepo(nil)
 
@@ -78,7 +138,7 @@ func TestHandleListMatch(t *testing.T) {
 		write = tc.WriteFunc
 		defer restoreWrite(write)
 
-		req, err := http.NewRequest(http.MethodGet, "/tournaments/{id}/matches", nil)
+		req, err := http.NewRequest(http.MethodGet, "/tournaments/{id}/matches"+tc.Query, nil)
 		req = mux.SetURLVars(req, map[string]string{"id": "any"})
 		assert.NoError(t, err)
 		res := httptest.NewRecorder()
@@ -93,7 +153,8 @@ func TestHandleListMatch(t *testing.T) {
 			err = json.Unmarshal(res.Body.Bytes(), &matches)
 			assert.NoError(t, err)
 
-			assert.Equal(t, 2, len(matches))
+			assert.Equal(t, tc.ExpectedMatches, len(matches))
+			assert.Equal(t, tc.ExpectedKickoff, matches[0].Kickoff.Format(time.RFC3339))
 		}
 	}
 }
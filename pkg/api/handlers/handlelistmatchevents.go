@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandleListMatchEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+	matchID := vars["match_id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	if matchID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if match == nil {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	filter, err := decodeMatchEventsFilter(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	events, err := repo.GetEventRepo().ListEventsFromMatch(ctx, match.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	timeline, err := filter.Apply(events)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	data, err_ := jsonMarshal(timeline)
+	if err_ != nil {
+		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	_, err_ = write(w, data)
+	if err_ != nil {
+		_ = errs.ErrResponseWriter.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func decodeMatchEventsFilter(r *http.Request) (event.Filter, errs.AppError) {
+	query := r.URL.Query()
+
+	filter := event.Filter{
+		TeamID:   query.Get("team"),
+		PlayerID: query.Get("player"),
+		Since:    query.Get("since"),
+	}
+
+	for _, t := range query["type"] {
+		var eventType model.EventsMatchType
+		err_ := eventType.UnmarshalText([]byte(t))
+		if err_ != nil {
+			return filter, errs.ErrUnknownEventType.Throwf(applog.Log, errs.ErrFmt, t)
+		}
+		filter.Types = append(filter.Types, eventType)
+	}
+
+	if v := query.Get("minute_from"); v != "" {
+		minute, err_ := strconv.Atoi(v)
+		if err_ != nil {
+			return filter, errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, v)
+		}
+		filter.MinuteFrom = &minute
+	}
+
+	if v := query.Get("minute_to"); v != "" {
+		minute, err_ := strconv.Atoi(v)
+		if err_ != nil {
+			return filter, errs.ErrParsingAtoi.Throwf(applog.Log, errs.ErrFmt, v)
+		}
+		filter.MinuteTo = &minute
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,280 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockListEventsFromMatchFunc(ctx context.Context, matchID string) ([]event.Event, errs.AppError) {
+	start := event.New("1", matchID, event.Start{TimeStarted: "16:00"})
+	start.ID = "1"
+
+	goal := event.New("1", matchID, event.Goal{
+		Team:   prototype.PrototypeTeam(),
+		Player: prototype.PrototypePlayer(),
+		Minute: 10,
+	})
+	goal.ID = "2"
+
+	warning := event.New("1", matchID, event.Warning{
+		Team:    prototype.PrototypeTeam(),
+		Player:  prototype.PrototypePlayer(),
+		Warning: model.WarningYellowCard,
+		Minute:  30,
+	})
+	warning.ID = "3"
+
+	return []event.Event{start, goal, warning}, nil
+}
+
+func mockListEventsFromMatchThrowFunc(ctx context.Context, matchID string) ([]event.Event, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func TestHandleListMatchEvents(t *testing.T) {
+	testCases := []struct {
+		Name                             string
+		ID                               string
+		MatchID                          string
+		Query                            string
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleListEventsFromMatchFunc    func(ctx context.Context, matchID string) ([]event.Event, errs.AppError)
+		MarshalFunc                      func(v interface{}) ([]byte, error)
+		WriteFunc                        func(http.ResponseWriter, []byte) (int, error)
+		ExpectedStatusCode               int
+		ExpectedEvents                   []string
+	}{
+		{
+			Name:                             "Success handle list match events",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               200,
+			ExpectedEvents:                   []string{"1", "2", "3"},
+		}, {
+			Name:                             "Success handle list match events filtered by type",
+			ID:                               "1",
+			MatchID:                          "1",
+			Query:                            "?type=Goal&type=Warning",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               200,
+			ExpectedEvents:                   []string{"2", "3"},
+		}, {
+			Name:                             "Success handle list match events filtered by team, player and minute",
+			ID:                               "1",
+			MatchID:                          "1",
+			Query:                            "?team=1&player=1&minute_from=20&minute_to=90",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               200,
+			ExpectedEvents:                   []string{"3"},
+		}, {
+			Name:                             "Success handle list match events since an event",
+			ID:                               "1",
+			MatchID:                          "1",
+			Query:                            "?since=1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               200,
+			ExpectedEvents:                   []string{"2", "3"},
+		}, {
+			Name:                             "Unprocessable since event not found",
+			ID:                               "1",
+			MatchID:                          "1",
+			Query:                            "?since=any",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Unprocessable invalid event type",
+			ID:                               "1",
+			MatchID:                          "1",
+			Query:                            "?type=any",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Unprocessable invalid minute",
+			ID:                               "1",
+			MatchID:                          "1",
+			Query:                            "?minute_from=any",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Not Found id param to handle list match events",
+			ID:                               "",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Not Found match_id param to handle list match events",
+			ID:                               "1",
+			MatchID:                          "",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Getting error on get tournament function",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Getting error on get tournament function retuning nil",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentNilFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Getting error on get match function",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Getting error on get match function returning nil",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Getting error on list events function",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchThrowFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Getting error on marshal function",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      fakeMarshal,
+			WriteFunc:                        write,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Getting error on write function",
+			ID:                               "1",
+			MatchID:                          "1",
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			MarshalFunc:                      jsonMarshal,
+			WriteFunc:                        fakeWrite,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			ListEventsFromMatchFunc: tc.HandleListEventsFromMatchFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/tournaments/:id/matches/:match_id/events"+tc.Query, nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID, "match_id": tc.MatchID})
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleListMatchEvents(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+
+		if res.Code == http.StatusOK {
+			events := []event.Event{}
+			err = json.Unmarshal(res.Body.Bytes(), &events)
+			assert.NoError(t, err)
+
+			ids := []string{}
+			for _, e := range events {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, tc.ExpectedEvents, ids)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/cache"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+)
+
+func mockListOfficialFunc(ctx context.Context) ([]official.Official, errs.AppError) {
+	officialMock := prototype.PrototypeOfficial()
+
+	officialMock2 := prototype.PrototypeOfficial()
+	officialMock2.Name = "Jesús Gil Manzano"
+
+	officialMockList := []official.Official{officialMock, officialMock2}
+
+	return officialMockList, nil
+}
+
+func mockListOfficialThrowFunc(ctx context.Context) ([]official.Official, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func TestHandleListOfficial(t *testing.T) {
+	testCases := []struct {
+		Name                   string
+		HandleListOfficialFunc func(ctx context.Context) ([]official.Official, errs.AppError)
+		MarshalFunc            func(v interface{}) ([]byte, error)
+		WriteFunc              func(http.ResponseWriter, []byte) (int, error)
+		CacheSetFunc           func(ctx context.Context, key string, value []byte) error
+		ExpectedStatusCode     int
+	}{
+		{
+			Name:                   "Success handle list officials",
+			HandleListOfficialFunc: mockListOfficialFunc,
+			MarshalFunc:            jsonMarshal,
+			WriteFunc:              write,
+			CacheSetFunc:           mockCacheSetFunc,
+			ExpectedStatusCode:     200,
+		}, {
+			Name:                   "Throwing handle list officials",
+			HandleListOfficialFunc: mockListOfficialThrowFunc,
+			MarshalFunc:            jsonMarshal,
+			WriteFunc:              write,
+			CacheSetFunc:           mockCacheSetFunc,
+			ExpectedStatusCode:     500,
+		}, {
+			Name:                   "Throwing error on marshal function",
+			HandleListOfficialFunc: mockListOfficialFunc,
+			MarshalFunc:            fakeMarshal,
+			WriteFunc:              write,
+			CacheSetFunc:           mockCacheSetFunc,
+			ExpectedStatusCode:     500,
+		}, {
+			Name:                   "Throwing error on write function",
+			HandleListOfficialFunc: mockListOfficialFunc,
+			MarshalFunc:            jsonMarshal,
+			WriteFunc:              fakeWrite,
+			CacheSetFunc:           mockCacheSetFunc,
+			ExpectedStatusCode:     500,
+		}, {
+			Name:                   "Logging error on cache set function",
+			HandleListOfficialFunc: mockListOfficialFunc,
+			MarshalFunc:            jsonMarshal,
+			WriteFunc:              write,
+			CacheSetFunc:           mockCacheSetThrowFunc,
+			ExpectedStatusCode:     200,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetOfficialRepo(repo.MockOfficialRepo{
+			ListFunc: tc.HandleListOfficialFunc,
+		})
+		defer repo.SetOfficialRepo(nil)
+
+		defer cache.SetStore(cache.GetStore())
+		cache.SetStore(cache.MockCacheStore{
+			SetFunc: tc.CacheSetFunc,
+		})
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/officials", nil)
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleListOfficial(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			official := []official.Official{}
+			err = json.Unmarshal(res.Body.Bytes(), &official)
+			assert.NoError(t, err)
+
+			assert.Equal(t, 2, len(official))
+		}
+	}
+}
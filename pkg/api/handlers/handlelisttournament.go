@@ -6,18 +6,29 @@ import (
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
 )
 
 func HandleListTournament(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	tournament, err := repo.GetTournamentRepo().List(ctx)
+	tournaments, err := repo.GetTournamentRepo().List(ctx)
 	if err != nil {
 		errs.HttpInternalServerError(w)
 		return
 	}
 
-	data, err_ := jsonMarshal(tournament)
+	query := r.URL.Query()
+	competitionID, season := query.Get("competition"), query.Get("season")
+
+	result := []tournament.Tournament{}
+	for _, t := range tournaments {
+		if t.InSeason(competitionID, season) {
+			result = append(result, t)
+		}
+	}
+
+	data, err_ := jsonMarshal(result)
 	if err_ != nil {
 		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
 		errs.HttpInternalServerError(w)
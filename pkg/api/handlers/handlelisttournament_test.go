@@ -34,7 +34,9 @@ func TestHandleListTournament(t *testing.T) {
 		HandleListTournamentFunc func(ctx context.Context) ([]tournament.Tournament, errs.AppError)
 		MarshalFunc              func(v interface{}) ([]byte, error)
 		WriteFunc                func(http.ResponseWriter, []byte) (int, error)
+		Query                    string
 		ExpectedStatusCode       int
+		ExpectedTournaments      int
 	}{
 		{
 			Name:                     "Success handle list tournaments",
@@ -42,6 +44,23 @@ func TestHandleListTournament(t *testing.T) {
 			MarshalFunc:              jsonMarshal,
 			WriteFunc:                write,
 			ExpectedStatusCode:       200,
+			ExpectedTournaments:      2,
+		}, {
+			Name:                     "Success handle list tournaments of a competition",
+			HandleListTournamentFunc: mockListSeasonsFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			Query:                    "?competition=1",
+			ExpectedStatusCode:       200,
+			ExpectedTournaments:      1,
+		}, {
+			Name:                     "Success handle list tournaments of a season",
+			HandleListTournamentFunc: mockListSeasonsFunc,
+			MarshalFunc:              jsonMarshal,
+			WriteFunc:                write,
+			Query:                    "?competition=1&season=2025/26",
+			ExpectedStatusCode:       200,
+			ExpectedTournaments:      0,
 		}, {
 			Name:                     "Throwing handle list tournaments",
 			HandleListTournamentFunc: mockListTournamentThrowFunc,
@@ -77,7 +96,7 @@ func TestHandleListTournament(t *testing.T) {
 		write = tc.WriteFunc
 		defer restoreWrite(write)
 
-		req, err := http.NewRequest(http.MethodGet, "/tournaments", nil)
+		req, err := http.NewRequest(http.MethodGet, "/tournaments"+tc.Query, nil)
 		assert.NoError(t, err)
 		res := httptest.NewRecorder()
 
@@ -91,7 +110,7 @@ func TestHandleListTournament(t *testing.T) {
 			err = json.Unmarshal(res.Body.Bytes(), &tournament)
 			assert.NoError(t, err)
 
-			assert.Equal(t, 2, len(tournament))
+			assert.Equal(t, tc.ExpectedTournaments, len(tournament))
 		}
 	}
 }
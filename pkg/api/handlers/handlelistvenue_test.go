@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/cache"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func mockListVenueFunc(ctx context.Context) ([]venue.Venue, errs.AppError) {
+	venueMock := prototype.PrototypeVenue()
+
+	venueMock2 := prototype.PrototypeVenue()
+	venueMock2.Name = "Estadio Metropolitano"
+
+	venueMockList := []venue.Venue{venueMock, venueMock2}
+
+	return venueMockList, nil
+}
+
+func mockListVenueThrowFunc(ctx context.Context) ([]venue.Venue, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func TestHandleListVenue(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		HandleListVenueFunc func(ctx context.Context) ([]venue.Venue, errs.AppError)
+		MarshalFunc         func(v interface{}) ([]byte, error)
+		WriteFunc           func(http.ResponseWriter, []byte) (int, error)
+		CacheSetFunc        func(ctx context.Context, key string, value []byte) error
+		ExpectedStatusCode  int
+	}{
+		{
+			Name:                "Success handle list venues",
+			HandleListVenueFunc: mockListVenueFunc,
+			MarshalFunc:         jsonMarshal,
+			WriteFunc:           write,
+			CacheSetFunc:        mockCacheSetFunc,
+			ExpectedStatusCode:  200,
+		}, {
+			Name:                "Throwing handle list venues",
+			HandleListVenueFunc: mockListVenueThrowFunc,
+			MarshalFunc:         jsonMarshal,
+			WriteFunc:           write,
+			CacheSetFunc:        mockCacheSetFunc,
+			ExpectedStatusCode:  500,
+		}, {
+			Name:                "Throwing error on marshal function",
+			HandleListVenueFunc: mockListVenueFunc,
+			MarshalFunc:         fakeMarshal,
+			WriteFunc:           write,
+			CacheSetFunc:        mockCacheSetFunc,
+			ExpectedStatusCode:  500,
+		}, {
+			Name:                "Throwing error on write function",
+			HandleListVenueFunc: mockListVenueFunc,
+			MarshalFunc:         jsonMarshal,
+			WriteFunc:           fakeWrite,
+			CacheSetFunc:        mockCacheSetFunc,
+			ExpectedStatusCode:  500,
+		}, {
+			Name:                "Logging error on cache set function",
+			HandleListVenueFunc: mockListVenueFunc,
+			MarshalFunc:         jsonMarshal,
+			WriteFunc:           write,
+			CacheSetFunc:        mockCacheSetThrowFunc,
+			ExpectedStatusCode:  200,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetVenueRepo(repo.MockVenueRepo{
+			ListFunc: tc.HandleListVenueFunc,
+		})
+		defer repo.SetVenueRepo(nil)
+
+		defer cache.SetStore(cache.GetStore())
+		cache.SetStore(cache.MockCacheStore{
+			SetFunc: tc.CacheSetFunc,
+		})
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodGet, "/venues", nil)
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandleListVenue(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			venue := []venue.Venue{}
+			err = json.Unmarshal(res.Body.Bytes(), &venue)
+			assert.NoError(t, err)
+
+			assert.Equal(t, 2, len(venue))
+		}
+	}
+}
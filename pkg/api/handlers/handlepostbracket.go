@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostBracket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	bracketPayload, err := decodeBracketRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	opts, err := convertPayloadToBracketOptions(bracketPayload)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	matches, err := match.GenerateBracket(*tournament, opts)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if !bracketPayload.Preview {
+		for _, mt := range matches {
+			err = repo.GetMatchRepo().Insert(ctx, mt)
+			if err != nil {
+				errs.HttpInternalServerError(w)
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	data, err_ := jsonMarshal(matches)
+	if err_ != nil {
+		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	_, err_ = write(w, data)
+	if err_ != nil {
+		_ = errs.ErrResponseWriter.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func decodeBracketRequest(r *http.Request) (BracketPayload, errs.AppError) {
+	payload := BracketPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
+
+func convertPayloadToBracketOptions(b BracketPayload) (match.BracketOptions, errs.AppError) {
+	schedule, err := convertPayloadToSchedule(b.SchedulePayload)
+	if err != nil {
+		return match.BracketOptions{}, err
+	}
+
+	return match.BracketOptions{Schedule: schedule, Seeds: b.Seeds, ThirdPlace: b.ThirdPlace}, nil
+}
@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func TestHandlePostBracket(t *testing.T) {
+	payload := BracketPayload{
+		SchedulePayload: SchedulePayload{
+			StartDate:         "2024-08-10",
+			DaysBetweenRounds: 7,
+			KickoffSlots:      []string{"15:00", "17:30"},
+			TimeZone:          "Europe/London",
+		},
+		Seeds: []string{"away"},
+	}
+
+	preview := payload
+	preview.Preview = true
+
+	badDate := payload
+	badDate.StartDate = "10/08/2024"
+
+	badSlot := payload
+	badSlot.KickoffSlots = []string{"3pm"}
+
+	noDays := payload
+	noDays.DaysBetweenRounds = 0
+
+	badSeed := payload
+	badSeed.Seeds = []string{"other"}
+
+	testCases := []struct {
+		Name                    string
+		ID                      string
+		Payload                 interface{}
+		HandleGetTournamentFunc func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandlePostMatchFunc     func(ctx context.Context, mt match.Match) errs.AppError
+		MarshalFunc             func(v interface{}) ([]byte, error)
+		WriteFunc               func(http.ResponseWriter, []byte) (int, error)
+		ExpectedInserted        int
+		ExpectedStatusCode      int
+	}{
+		{
+			Name:                    "Should return 201 and store the bracket",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedInserted:        1,
+			ExpectedStatusCode:      201,
+		}, {
+			Name:                    "Should return 200 and not store the bracket on preview",
+			ID:                      "1",
+			Payload:                 preview,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedInserted:        0,
+			ExpectedStatusCode:      200,
+		}, {
+			Name:                    "Should return 404 on missing id param",
+			ID:                      "",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      404,
+		}, {
+			Name:                    "Should return 500 on get tournament error",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentThrowFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Should return 404 on tournament not found",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentNilFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      404,
+		}, {
+			Name:                    "Should return 422 on invalid body",
+			ID:                      "1",
+			Payload:                 "bracket",
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 422 on invalid start date",
+			ID:                      "1",
+			Payload:                 badDate,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 422 on invalid kickoff slot",
+			ID:                      "1",
+			Payload:                 badSlot,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 422 without days between rounds",
+			ID:                      "1",
+			Payload:                 noDays,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 422 on a seed out of the tournament",
+			ID:                      "1",
+			Payload:                 badSeed,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 500 on insert match error",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchThrowFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Should return 500 on marshal error",
+			ID:                      "1",
+			Payload:                 preview,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             fakeMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Should return 500 on write error",
+			ID:                      "1",
+			Payload:                 preview,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandlePostMatchFunc:     mockPostMatchFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               fakeWrite,
+			ExpectedStatusCode:      500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		inserted := 0
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			InsertFunc: func(ctx context.Context, mt match.Match) errs.AppError {
+				err := tc.HandlePostMatchFunc(ctx, mt)
+				if err == nil {
+					inserted++
+				}
+				return err
+			},
+		})
+		defer repo.SetMatchRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		body, err := json.Marshal(tc.Payload)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/tournaments/:id/bracket/generate", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		res := httptest.NewRecorder()
+
+		HandlePostBracket(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		assert.Equal(t, tc.ExpectedInserted, inserted)
+
+		if res.Code == http.StatusOK {
+			matches := []match.Match{}
+			err = json.Unmarshal(res.Body.Bytes(), &matches)
+			assert.NoError(t, err)
+
+			assert.Len(t, matches, 1)
+			assert.Equal(t, "away", matches[0].HomeTeam.ID)
+			assert.Equal(t, "home", matches[0].AwayTeam.ID)
+			assert.NotNil(t, matches[0].Bracket)
+			assert.Equal(t, "2024-08-10T14:00:00Z", matches[0].Kickoff.UTC().Format("2006-01-02T15:04:05Z"))
+		}
+	}
+}
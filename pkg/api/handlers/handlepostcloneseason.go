@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostCloneSeason(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	t, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if t == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	clonePayload, err := decodeCloneSeasonRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if clonePayload.Season == "" {
+		err = errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "season is required")
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	next := t.CloneSeason(clonePayload.Name, clonePayload.Season)
+
+	err = checkSeasonIsNew(ctx, next)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	err = repo.GetTournamentRepo().Insert(ctx, next)
+	if err != nil {
+		if errs.ErrMongoDuplicateKey.Is(err) {
+			err = errs.ErrSeasonAlreadyExists.Throwf(applog.Log, errs.ErrFmt, next.Season)
+			errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+			return
+		}
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func decodeCloneSeasonRequest(r *http.Request) (CloneSeasonPayload, errs.AppError) {
+	payload := CloneSeasonPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockGetSeasonFunc(ctx context.Context, id string) (*tournament.Tournament, errs.AppError) {
+	season := prototype.PrototypeTournament()
+	season.CompetitionID = "1"
+	season.Season = "2026/27"
+	return &season, nil
+}
+
+func TestHandlePostCloneSeason(t *testing.T) {
+	newRequest := func(id string, payload interface{}) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournaments/:id/clone", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		if payload != nil {
+			body, err := json.Marshal(payload)
+			assert.NoError(t, err)
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		return req
+	}
+
+	nextSeason := CloneSeasonPayload{Season: "2027/28"}
+
+	testCases := []struct {
+		Name                     string
+		Request                  *http.Request
+		HandleGetTournamentFunc  func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandlePostTournamentFunc func(ctx context.Context, t tournament.Tournament) errs.AppError
+		ExpectedStatusCode       int
+		ExpectedSeason           string
+	}{
+		{
+			Name:                     "Should return 201 cloning the next season",
+			Request:                  newRequest("1", nextSeason),
+			HandleGetTournamentFunc:  mockGetSeasonFunc,
+			HandlePostTournamentFunc: mockPostTournamentFunc,
+			ExpectedStatusCode:       201,
+			ExpectedSeason:           "2027/28",
+		}, {
+			Name:                     "Should return 404 if missing param",
+			Request:                  newRequest("", nextSeason),
+			HandleGetTournamentFunc:  mockGetSeasonFunc,
+			HandlePostTournamentFunc: mockPostTournamentFunc,
+			ExpectedStatusCode:       404,
+		}, {
+			Name:                     "Should return 500 throwing error on get tournament",
+			Request:                  newRequest("1", nextSeason),
+			HandleGetTournamentFunc:  mockGetTournamentThrowFunc,
+			HandlePostTournamentFunc: mockPostTournamentFunc,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Should return 404 if the tournament is not found",
+			Request:                  newRequest("1", nextSeason),
+			HandleGetTournamentFunc:  mockGetTournamentNilFunc,
+			HandlePostTournamentFunc: mockPostTournamentFunc,
+			ExpectedStatusCode:       404,
+		}, {
+			Name:                     "Should return 422 bad request",
+			Request:                  newRequest("1", nil),
+			HandleGetTournamentFunc:  mockGetSeasonFunc,
+			HandlePostTournamentFunc: mockPostTournamentFunc,
+			ExpectedStatusCode:       422,
+		}, {
+			Name:                     "Should return 422 without a season",
+			Request:                  newRequest("1", CloneSeasonPayload{Name: "Premier League"}),
+			HandleGetTournamentFunc:  mockGetSeasonFunc,
+			HandlePostTournamentFunc: mockPostTournamentFunc,
+			ExpectedStatusCode:       422,
+		}, {
+			Name:                     "Should return 422 if the season already exists",
+			Request:                  newRequest("1", CloneSeasonPayload{Season: "2026/27"}),
+			HandleGetTournamentFunc:  mockGetSeasonFunc,
+			HandlePostTournamentFunc: mockPostTournamentFunc,
+			ExpectedStatusCode:       422,
+		}, {
+			Name:                     "Should return 500 throwing error on insert",
+			Request:                  newRequest("1", nextSeason),
+			HandleGetTournamentFunc:  mockGetSeasonFunc,
+			HandlePostTournamentFunc: mockPostTournamentThrowFunc,
+			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Should return 422 if the season is created concurrently",
+			Request:                  newRequest("1", nextSeason),
+			HandleGetTournamentFunc:  mockGetSeasonFunc,
+			HandlePostTournamentFunc: mockPostTournamentDuplicateFunc,
+			ExpectedStatusCode:       422,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		var inserted tournament.Tournament
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc:               tc.HandleGetTournamentFunc,
+			ListByCompetitionFunc: mockListCompetitionSeasonsFunc,
+			InsertFunc: func(ctx context.Context, t tournament.Tournament) errs.AppError {
+				inserted = t
+				return tc.HandlePostTournamentFunc(ctx, t)
+			},
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		HandlePostCloneSeason(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+
+		if w.Code == http.StatusCreated {
+			assert.Equal(t, "1", inserted.CompetitionID)
+			assert.Equal(t, tc.ExpectedSeason, inserted.Season)
+			assert.Len(t, inserted.Teams, 3)
+		}
+	}
+}
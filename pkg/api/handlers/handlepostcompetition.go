@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostCompetition(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	competitionPayload, err := decodeCompetitionRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	competition, err := convertPayloadToCompetitionFunc(competitionPayload)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	err = repo.GetCompetitionRepo().Insert(ctx, competition)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func decodeCompetitionRequest(r *http.Request) (CompetitionEntityPayload, errs.AppError) {
+	payload := CompetitionEntityPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
+
+func convertPayloadToCompetition(c CompetitionEntityPayload) (competition.Competition, errs.AppError) {
+	if c.Name == "" {
+		return competition.Competition{}, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "name is required")
+	}
+
+	result := competition.Competition{
+		Name:    c.Name,
+		Country: c.Country,
+	}
+
+	return result, nil
+}
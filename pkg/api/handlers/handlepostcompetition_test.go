@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+)
+
+func mockPostCompetitionFunc(ctx context.Context, t competition.Competition) errs.AppError {
+	return nil
+}
+
+func mockPostCompetitionThrowFunc(ctx context.Context, t competition.Competition) errs.AppError {
+	return errs.ErrRepoMockAction
+}
+
+func TestHandlePostCompetition(t *testing.T) {
+	body, err := json.Marshal(CompetitionEntityPayload{
+		Name:    "Premier League",
+		Country: "England",
+	})
+	assert.Equal(t, nil, err)
+
+	goodReq := httptest.NewRequest(http.MethodPost, "/competitions", nil)
+	goodReq = mux.SetURLVars(goodReq, map[string]string{})
+	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	noBodyReq := httptest.NewRequest(http.MethodPost, "/competitions", nil)
+	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{})
+
+	throwReq := httptest.NewRequest(http.MethodPost, "/competitions", nil)
+	throwReq = mux.SetURLVars(throwReq, map[string]string{})
+
+	throwReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	goodReq2 := httptest.NewRequest(http.MethodPost, "/competitions", nil)
+	goodReq2 = mux.SetURLVars(goodReq2, map[string]string{})
+	goodReq2.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	testCases := []struct {
+		Name                  string
+		Request               *http.Request
+		HandlePostFunc        func(ctx context.Context, t competition.Competition) errs.AppError
+		ConvertingPayloadFunc func(t CompetitionEntityPayload) (competition.Competition, errs.AppError)
+		ExpectedStatusCode    int
+	}{
+		{
+			Name:                  "Should return 201 if successful",
+			Request:               goodReq,
+			HandlePostFunc:        mockPostCompetitionFunc,
+			ConvertingPayloadFunc: convertPayloadToCompetitionFunc,
+			ExpectedStatusCode:    201,
+		}, {
+			Name:                  "Should return 422 bad request",
+			Request:               noBodyReq,
+			HandlePostFunc:        mockPostCompetitionFunc,
+			ConvertingPayloadFunc: convertPayloadToCompetitionFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 422 throwing error on converting payload func",
+			Request:               goodReq2,
+			HandlePostFunc:        mockPostCompetitionFunc,
+			ConvertingPayloadFunc: fakeConvertPayloadToCompetitionFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 500 throwing error on function",
+			Request:               throwReq,
+			HandlePostFunc:        mockPostCompetitionThrowFunc,
+			ConvertingPayloadFunc: convertPayloadToCompetitionFunc,
+			ExpectedStatusCode:    500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetCompetitionRepo(repo.MockCompetitionRepo{
+			InsertFunc: tc.HandlePostFunc,
+		})
+		defer repo.SetCompetitionRepo(nil)
+
+		convertPayloadToCompetitionFunc = tc.ConvertingPayloadFunc
+		defer restoreConvertPayloadToCompetitionFunc(convertPayloadToCompetitionFunc)
+
+		w := httptest.NewRecorder()
+
+		HandlePostCompetition(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
+
+func TestConvertPayloadToCompetition(t *testing.T) {
+	inPayload := CompetitionEntityPayload{
+		Name:    "Premier League",
+		Country: "England",
+	}
+
+	expectedCompetition := prototype.PrototypeCompetition()
+	expectedCompetition.ID = ""
+
+	testCases := []struct {
+		Name                string
+		Payload             CompetitionEntityPayload
+		ExpectedCompetition competition.Competition
+		ExpectError         bool
+		ExpectedError       string
+	}{
+		{
+			Name:                "Test Case: 1 - correct body, no error",
+			Payload:             inPayload,
+			ExpectedCompetition: expectedCompetition,
+			ExpectError:         false,
+		}, {
+			Name:          "Test Case: 2 - no name, error found",
+			Payload:       CompetitionEntityPayload{Country: "England"},
+			ExpectError:   true,
+			ExpectedError: "name is required",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		competition, err := convertPayloadToCompetition(tc.Payload)
+		if tc.ExpectError {
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.ExpectedError)
+		} else {
+			assert.Equal(t, tc.ExpectedCompetition, competition)
+		}
+	}
+}
+
+func TestDecodeCompetitionRequest(t *testing.T) {
+	body, err := json.Marshal(CompetitionEntityPayload{
+		Name:    "Premier League",
+		Country: "England",
+	})
+	assert.Equal(t, nil, err)
+
+	goodReq := httptest.NewRequest(http.MethodPost, "/competitions", nil)
+	goodReq = mux.SetURLVars(goodReq, map[string]string{})
+
+	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	noBodyReq := httptest.NewRequest(http.MethodPost, "/competitions", nil)
+	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{})
+
+	testCases := []struct {
+		Name          string
+		Request       *http.Request
+		Payload       *CompetitionEntityPayload
+		ExpectedError bool
+	}{
+		{
+			Name:    "Test Case: 1 - correct body, no error",
+			Request: goodReq, Payload: &CompetitionEntityPayload{
+				Name:    "Premier League",
+				Country: "England",
+			}, ExpectedError: false,
+		},
+		{Name: "Test Case: 2 - no body, error found", Request: noBodyReq, Payload: nil, ExpectedError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		decodedPayload, err := decodeCompetitionRequest(tc.Request)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.Equal(t, *tc.Payload, decodedPayload)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+// a draw without a seed is random, the seed used is kept on the tournament
+var newDrawSeed = func() int64 {
+	return time.Now().UnixNano()
+}
+
+func HandlePostDraw(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	t, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if t == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matches, err := repo.GetMatchRepo().ListByTournament(ctx, t.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	err = match.CheckRedraw(*t, matches)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	drawPayload, err := decodeDrawRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	opts := tournament.DrawOptions{
+		Groups: drawPayload.Groups,
+		Pots:   drawPayload.Pots,
+	}
+	if drawPayload.Seed != nil {
+		opts.Seed = *drawPayload.Seed
+	} else {
+		opts.Seed = newDrawSeed()
+	}
+
+	groups, err := tournament.Draw(*t, opts)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	t.Groups = groups
+	t.DrawSeed = opts.Seed
+	t.Qualification = &tournament.Qualification{
+		PerGroup:   drawPayload.PerGroup,
+		BestThirds: drawPayload.BestThirds,
+	}
+
+	err = t.CheckQualification(*t.Qualification)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	t, err = repo.GetTournamentRepo().Update(ctx, *t)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	data, err_ := jsonMarshal(t)
+	if err_ != nil {
+		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	_, err_ = write(w, data)
+	if err_ != nil {
+		_ = errs.ErrResponseWriter.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func decodeDrawRequest(r *http.Request) (DrawPayload, errs.AppError) {
+	payload := DrawPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockGetTournamentWithFourTeamsFunc(ctx context.Context, id string) (*tournament.Tournament, errs.AppError) {
+	tournamentMock := prototype.PrototypeTournament()
+	tournamentMock.Teams = []team.Team{{ID: "t1"}, {ID: "t2"}, {ID: "t3"}, {ID: "t4"}}
+	return &tournamentMock, nil
+}
+
+func mockListNoMatchFunc(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	return []match.Match{}, nil
+}
+
+func TestHandlePostDraw(t *testing.T) {
+	seed := int64(42)
+
+	payload := DrawPayload{
+		Groups:   2,
+		Pots:     [][]string{{"t1", "t2"}, {"t3", "t4"}},
+		Seed:     &seed,
+		PerGroup: 1,
+	}
+
+	random := payload
+	random.Pots = nil
+	random.Seed = nil
+
+	tooManyGroups := payload
+	tooManyGroups.Groups = 3
+
+	badPots := payload
+	badPots.Pots = [][]string{{"t1", "t2"}, {"t3", "t5"}}
+
+	badQualification := payload
+	badQualification.PerGroup = 3
+
+	testCases := []struct {
+		Name                       string
+		ID                         string
+		Payload                    interface{}
+		HandleGetTournamentFunc    func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleListMatchFunc        func(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError)
+		HandleUpdateTournamentFunc func(ctx context.Context, t tournament.Tournament) (*tournament.Tournament, errs.AppError)
+		MarshalFunc                func(v interface{}) ([]byte, error)
+		WriteFunc                  func(http.ResponseWriter, []byte) (int, error)
+		ExpectedSeed               int64
+		ExpectedStatusCode         int
+	}{
+		{
+			Name:                       "Should return 200 and draw the groups by pots",
+			ID:                         "1",
+			Payload:                    payload,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedSeed:               42,
+			ExpectedStatusCode:         200,
+		}, {
+			Name:                       "Should return 200 and keep the seed of a random draw",
+			ID:                         "1",
+			Payload:                    random,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedSeed:               7,
+			ExpectedStatusCode:         200,
+		}, {
+			Name:                       "Should return 404 on missing id param",
+			ID:                         "",
+			Payload:                    payload,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         404,
+		}, {
+			Name:                       "Should return 500 on get tournament error",
+			ID:                         "1",
+			Payload:                    payload,
+			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         500,
+		}, {
+			Name:                       "Should return 404 on tournament not found",
+			ID:                         "1",
+			Payload:                    payload,
+			HandleGetTournamentFunc:    mockGetTournamentNilFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         404,
+		}, {
+			Name:                       "Should return 500 on list matches error",
+			ID:                         "1",
+			Payload:                    payload,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListTournamentMatchThrowFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         500,
+		}, {
+			Name:                       "Should return 422 once the group matches are generated",
+			ID:                         "1",
+			Payload:                    payload,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListGroupMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         422,
+		}, {
+			Name:                       "Should return 422 on invalid body",
+			ID:                         "1",
+			Payload:                    "draw",
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         422,
+		}, {
+			Name:                       "Should return 422 on more groups than the teams allow",
+			ID:                         "1",
+			Payload:                    tooManyGroups,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         422,
+		}, {
+			Name:                       "Should return 422 on a pot team out of the tournament",
+			ID:                         "1",
+			Payload:                    badPots,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         422,
+		}, {
+			Name:                       "Should return 422 on qualification rules over the group size",
+			ID:                         "1",
+			Payload:                    badQualification,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         422,
+		}, {
+			Name:                       "Should return 500 on update tournament error",
+			ID:                         "1",
+			Payload:                    payload,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentThrowFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         500,
+		}, {
+			Name:                       "Should return 500 on marshal error",
+			ID:                         "1",
+			Payload:                    payload,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                fakeMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         500,
+		}, {
+			Name:                       "Should return 500 on write error",
+			ID:                         "1",
+			Payload:                    payload,
+			HandleGetTournamentFunc:    mockGetTournamentWithFourTeamsFunc,
+			HandleListMatchFunc:        mockListNoMatchFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  fakeWrite,
+			ExpectedStatusCode:         500,
+		},
+	}
+
+	defer func(replace func() int64) { newDrawSeed = replace }(newDrawSeed)
+	newDrawSeed = func() int64 {
+		return 7
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		var updated *tournament.Tournament
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+			UpdateFunc: func(ctx context.Context, t tournament.Tournament) (*tournament.Tournament, errs.AppError) {
+				updated = &t
+				return tc.HandleUpdateTournamentFunc(ctx, t)
+			},
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			ListByTournamentFunc: tc.HandleListMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		body, err := json.Marshal(tc.Payload)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/tournaments/:id/draw", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		res := httptest.NewRecorder()
+
+		HandlePostDraw(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+
+		if res.Code == http.StatusOK {
+			assert.NotNil(t, updated)
+			assert.Equal(t, tc.ExpectedSeed, updated.DrawSeed)
+			assert.Len(t, updated.Groups, 2)
+			assert.Equal(t, &tournament.Qualification{PerGroup: 1}, updated.Qualification)
+
+			for _, g := range updated.Groups {
+				assert.Len(t, g.Teams, 2)
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+const (
+	fixturesDateLayout = "2006-01-02"
+	fixturesSlotLayout = "15:04"
+)
+
+func HandlePostFixtures(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	fixturesPayload, err := decodeFixturesRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	opts, err := convertPayloadToFixtureOptions(fixturesPayload)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	tournamentMatches, err := repo.GetMatchRepo().ListByTournament(ctx, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	err = match.CheckFixtures(*tournament, tournamentMatches)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	matches, err := match.GenerateFixtures(*tournament, opts)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if !fixturesPayload.Preview {
+		err = repo.GetMatchRepo().InsertMany(ctx, matches)
+		if err != nil {
+			errs.HttpInternalServerError(w)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	data, err_ := jsonMarshal(matches)
+	if err_ != nil {
+		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	_, err_ = write(w, data)
+	if err_ != nil {
+		_ = errs.ErrResponseWriter.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func decodeFixturesRequest(r *http.Request) (FixturesPayload, errs.AppError) {
+	payload := FixturesPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
+
+func convertPayloadToFixtureOptions(f FixturesPayload) (match.FixtureOptions, errs.AppError) {
+	schedule, err := convertPayloadToSchedule(f.SchedulePayload)
+	if err != nil {
+		return match.FixtureOptions{}, err
+	}
+
+	return match.FixtureOptions{Schedule: schedule, Double: f.Double}, nil
+}
+
+func convertPayloadToSchedule(s SchedulePayload) (match.Schedule, errs.AppError) {
+	schedule := match.Schedule{
+		DaysBetweenRounds: s.DaysBetweenRounds,
+		TimeZone:          s.TimeZone,
+	}
+
+	startDate, err_ := timeParse(fixturesDateLayout, s.StartDate)
+	if err_ != nil {
+		return schedule, errs.ErrParsingTime.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+	}
+	schedule.StartDate = startDate
+
+	for _, ks := range s.KickoffSlots {
+		slot, err_ := timeParse(fixturesSlotLayout, ks)
+		if err_ != nil {
+			return schedule, errs.ErrParsingTime.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+		}
+		schedule.KickoffSlots = append(schedule.KickoffSlots, time.Duration(slot.Hour())*time.Hour+time.Duration(slot.Minute())*time.Minute)
+	}
+
+	return schedule, nil
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostKnockout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	knockoutPayload, err := decodeKnockoutRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	opts, err := convertPayloadToKnockoutOptions(knockoutPayload)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	tournamentMatches, err := match.FindMatches(ctx, repo.GetMatchRepo(), tournament.ID, "")
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	matches, err := match.GenerateKnockout(*tournament, tournamentMatches, opts)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if !knockoutPayload.Preview {
+		err = repo.GetMatchRepo().InsertMany(ctx, matches)
+		if err != nil {
+			errs.HttpInternalServerError(w)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	data, err_ := jsonMarshal(matches)
+	if err_ != nil {
+		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	_, err_ = write(w, data)
+	if err_ != nil {
+		_ = errs.ErrResponseWriter.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func decodeKnockoutRequest(r *http.Request) (KnockoutPayload, errs.AppError) {
+	payload := KnockoutPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
+
+func convertPayloadToKnockoutOptions(k KnockoutPayload) (match.BracketOptions, errs.AppError) {
+	schedule, err := convertPayloadToSchedule(k.SchedulePayload)
+	if err != nil {
+		return match.BracketOptions{}, err
+	}
+
+	return match.BracketOptions{Schedule: schedule, ThirdPlace: k.ThirdPlace}, nil
+}
@@ -0,0 +1,273 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockInsertMatchesFunc(ctx context.Context, matches []match.Match) errs.AppError {
+	return nil
+}
+
+func mockInsertMatchesThrowFunc(ctx context.Context, matches []match.Match) errs.AppError {
+	return errs.ErrRepoMockAction
+}
+
+func TestHandlePostKnockout(t *testing.T) {
+	payload := KnockoutPayload{
+		SchedulePayload: SchedulePayload{
+			StartDate:         "2024-08-10",
+			DaysBetweenRounds: 7,
+			KickoffSlots:      []string{"15:00", "17:30"},
+			TimeZone:          "Europe/London",
+		},
+		ThirdPlace: true,
+	}
+
+	preview := payload
+	preview.Preview = true
+
+	badDate := payload
+	badDate.StartDate = "10/08/2024"
+
+	badSlot := payload
+	badSlot.KickoffSlots = []string{"3pm"}
+
+	noDays := payload
+	noDays.DaysBetweenRounds = 0
+
+	testCases := []struct {
+		Name                    string
+		ID                      string
+		Payload                 interface{}
+		HandleGetTournamentFunc func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleListMatchFunc     func(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError)
+		HandleInsertMatchesFunc func(ctx context.Context, matches []match.Match) errs.AppError
+		MarshalFunc             func(v interface{}) ([]byte, error)
+		WriteFunc               func(http.ResponseWriter, []byte) (int, error)
+		ExpectedInserted        int
+		ExpectedStatusCode      int
+	}{
+		{
+			Name:                    "Should return 201 and store the knockout",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedInserted:        1,
+			ExpectedStatusCode:      201,
+		}, {
+			Name:                    "Should return 200 and not store the knockout on preview",
+			ID:                      "1",
+			Payload:                 preview,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedInserted:        0,
+			ExpectedStatusCode:      200,
+		}, {
+			Name:                    "Should return 404 on missing id param",
+			ID:                      "",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      404,
+		}, {
+			Name:                    "Should return 500 on get tournament error",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentThrowFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Should return 404 on tournament not found",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentNilFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      404,
+		}, {
+			Name:                    "Should return 422 on invalid body",
+			ID:                      "1",
+			Payload:                 "knockout",
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 422 on invalid start date",
+			ID:                      "1",
+			Payload:                 badDate,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 422 on invalid kickoff slot",
+			ID:                      "1",
+			Payload:                 badSlot,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 422 without days between rounds",
+			ID:                      "1",
+			Payload:                 noDays,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 500 on list matches error",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListTournamentMatchThrowFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Should return 422 on a group stage not finished",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchUnfinishedFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 422 on a tournament without groups",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentWithTeamsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      422,
+		}, {
+			Name:                    "Should return 500 on insert match error",
+			ID:                      "1",
+			Payload:                 payload,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesThrowFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Should return 500 on marshal error",
+			ID:                      "1",
+			Payload:                 preview,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             fakeMarshal,
+			WriteFunc:               write,
+			ExpectedStatusCode:      500,
+		}, {
+			Name:                    "Should return 500 on write error",
+			ID:                      "1",
+			Payload:                 preview,
+			HandleGetTournamentFunc: mockGetTournamentWithGroupsFunc,
+			HandleListMatchFunc:     mockListGroupMatchFunc,
+			HandleInsertMatchesFunc: mockInsertMatchesFunc,
+			MarshalFunc:             jsonMarshal,
+			WriteFunc:               fakeWrite,
+			ExpectedStatusCode:      500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		inserted := 0
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			ListByTournamentFunc: tc.HandleListMatchFunc,
+			InsertManyFunc: func(ctx context.Context, matches []match.Match) errs.AppError {
+				err := tc.HandleInsertMatchesFunc(ctx, matches)
+				if err == nil {
+					inserted += len(matches)
+				}
+				return err
+			},
+		})
+		defer repo.SetMatchRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		body, err := json.Marshal(tc.Payload)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/tournaments/:id/knockout/generate", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.ID})
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		res := httptest.NewRecorder()
+
+		HandlePostKnockout(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		assert.Equal(t, tc.ExpectedInserted, inserted)
+
+		if res.Code == http.StatusOK {
+			matches := []match.Match{}
+			err = json.Unmarshal(res.Body.Bytes(), &matches)
+			assert.NoError(t, err)
+
+			assert.Len(t, matches, 1)
+			assert.Equal(t, "away", matches[0].HomeTeam.ID)
+			assert.Equal(t, "t3", matches[0].AwayTeam.ID)
+			assert.NotNil(t, matches[0].Bracket)
+			assert.Len(t, matches[0].Tournament.Teams, 4)
+			assert.Equal(t, "2024-08-10T14:00:00Z", matches[0].Kickoff.UTC().Format("2006-01-02T15:04:05Z"))
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
@@ -52,6 +53,7 @@ func HandlePostMatch(w http.ResponseWriter, r *http.Request) {
 
 	match.Tournament = *tournament
 	match.Status = model.MatchStatusNotStart
+	match.Score = match.ComputeScore()
 
 	err = repo.GetMatchRepo().Insert(ctx, *match)
 	if err != nil {
@@ -89,20 +91,36 @@ func convertAndValidatePayloadToMatch(ctx context.Context, mt MatchEntityPayload
 		return nil, errs.ErrValidation.Throwf(applog.Log, "The same teams cannot do a match")
 	}
 
-	_, err_ := timeParse("2006-01-02", mt.DateOfMatch)
+	kickoff, err_ := timeParse(time.RFC3339, mt.Kickoff)
 	if err_ != nil {
 		return nil, errs.ErrParsingTime.Throwf(applog.Log, errs.ErrFmt, err_.Error())
 	}
 
-	_, err_ = timeParse("15:04", mt.TimeOfMatch)
-	if err_ != nil {
-		return nil, errs.ErrParsingTime.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+	venue := homeTeam.Venue
+	if mt.Venue != "" {
+		venue, err = repo.GetVenueRepo().Get(ctx, mt.Venue)
+		if err != nil || venue == nil {
+			return nil, errs.ErrVenueIsNotFound.Throwf(applog.Log, errs.ErrFmt, mt.Venue)
+		}
+	}
+
+	timeZone := mt.TimeZone
+	if timeZone == "" && venue != nil {
+		timeZone = venue.TimeZone
+	}
+
+	if timeZone != "" {
+		_, err_ = time.LoadLocation(timeZone)
+		if err_ != nil {
+			return nil, errs.ErrLoadingTimeZone.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+		}
 	}
 
 	return &match.Match{
-		HomeTeam:    *homeTeam,
-		AwayTeam:    *awayTeam,
-		DateOfMatch: mt.DateOfMatch,
-		TimeOfMatch: mt.TimeOfMatch,
+		HomeTeam: *homeTeam,
+		AwayTeam: *awayTeam,
+		Kickoff:  kickoff.UTC(),
+		TimeZone: timeZone,
+		Venue:    venue,
 	}, nil
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
 	"github.com/rafaelsanzio/go-flashscore/pkg/team"
 	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
 )
 
 func mockPostMatchFunc(ctx context.Context, mt match.Match) errs.AppError {
@@ -45,19 +46,28 @@ func mockGetTeamThrownFuncForMatch(ctx context.Context, id string) (*team.Team,
 	return &teamMock, nil
 }
 
-func fakeTimeParseForTimeMatch(layout string, value string) (time.Time, error) {
-	if layout == "15:04" {
-		return time.Time{}, errs.ErrParsingTime
+func mockGetTeamWithVenueFuncForMatch(ctx context.Context, id string) (*team.Team, errs.AppError) {
+	teamMock, err := mockGetTeamFuncForMatch(ctx, id)
+	homeVenue := prototype.PrototypeVenue()
+	teamMock.Venue = &homeVenue
+	return teamMock, err
+}
+
+func mockGetVenueFuncForMatch(ctx context.Context, id string) (*venue.Venue, errs.AppError) {
+	if id != "1" {
+		return nil, nil
 	}
-	return time.Time{}, nil
+	venueMock := prototype.PrototypeVenue()
+	venueMock.TimeZone = ""
+	return &venueMock, nil
 }
 
 func TestHandlePostMatch(t *testing.T) {
 	body, err := json.Marshal(MatchEntityPayload{
-		HomeTeam:    "any_home_team_id",
-		AwayTeam:    "any_away_team_id",
-		DateOfMatch: "2022-01-01",
-		TimeOfMatch: "16:00",
+		HomeTeam: "any_home_team_id",
+		AwayTeam: "any_away_team_id",
+		Kickoff:  "2022-01-01T16:00:00Z",
+		TimeZone: "Europe/London",
 	})
 	assert.Equal(t, nil, err)
 
@@ -175,23 +185,49 @@ func TestHandlePostMatch(t *testing.T) {
 
 func TestConvertAndValidatePayloadToMatch(t *testing.T) {
 	inPayload := MatchEntityPayload{
-		HomeTeam:    "any_home_team_id",
-		AwayTeam:    "any_away_team_id",
-		DateOfMatch: "2022-01-01",
-		TimeOfMatch: "16:00",
+		HomeTeam: "any_home_team_id",
+		AwayTeam: "any_away_team_id",
+		Kickoff:  "2022-01-01T16:00:00Z",
+		TimeZone: "Europe/London",
 	}
 
+	offsetPayload := inPayload
+	offsetPayload.Kickoff = "2022-01-01T13:00:00-03:00"
+
+	invalidTimeZonePayload := inPayload
+	invalidTimeZonePayload.TimeZone = "Europe/Nowhere"
+
+	homeVenuePayload := inPayload
+	homeVenuePayload.TimeZone = ""
+
+	venuePayload := inPayload
+	venuePayload.Venue = "1"
+
+	unknownVenuePayload := inPayload
+	unknownVenuePayload.Venue = "unknown"
+
 	expectedAwayTeam := prototype.PrototypeTeam()
 	expectedAwayTeam.ID = "2"
 
 	expectedMatch := match.Match{
-		ID:          "",
-		HomeTeam:    prototype.PrototypeTeam(),
-		AwayTeam:    expectedAwayTeam,
-		DateOfMatch: "2022-01-01",
-		TimeOfMatch: "16:00",
+		ID:       "",
+		HomeTeam: prototype.PrototypeTeam(),
+		AwayTeam: expectedAwayTeam,
+		Kickoff:  time.Date(2022, 1, 1, 16, 0, 0, 0, time.UTC),
+		TimeZone: "Europe/London",
 	}
 
+	homeVenue := prototype.PrototypeVenue()
+	expectedHomeVenueMatch := expectedMatch
+	expectedHomeVenueMatch.HomeTeam.Venue = &homeVenue
+	expectedHomeVenueMatch.AwayTeam.Venue = &homeVenue
+	expectedHomeVenueMatch.Venue = &homeVenue
+	expectedHomeVenueMatch.TimeZone = "Europe/Madrid"
+
+	givenVenue, _ := mockGetVenueFuncForMatch(context.Background(), "1")
+	expectedVenueMatch := expectedMatch
+	expectedVenueMatch.Venue = givenVenue
+
 	testCases := []struct {
 		Name              string
 		Payload           MatchEntityPayload
@@ -237,10 +273,10 @@ func TestConvertAndValidatePayloadToMatch(t *testing.T) {
 			ExpectedMatch:     expectedMatch,
 			ExpectError:       true,
 		}, {
-			Name:              "Test Case: 6 - throwing error parsing time function",
-			Payload:           inPayload,
+			Name:              "Test Case: 6 - throwing error loading the time zone",
+			Payload:           invalidTimeZonePayload,
 			HandleGetTeamFunc: mockGetTeamFuncForMatch,
-			ParsingTimeFunc:   fakeTimeParseForTimeMatch,
+			ParsingTimeFunc:   timeParse,
 			ExpectedMatch:     expectedMatch,
 			ExpectError:       true,
 		}, {
@@ -250,9 +286,42 @@ func TestConvertAndValidatePayloadToMatch(t *testing.T) {
 			ParsingTimeFunc:   timeParse,
 			ExpectedMatch:     expectedMatch,
 			ExpectError:       true,
+		}, {
+			Name:              "Test Case: 8 - kickoff with an offset is stored in UTC",
+			Payload:           offsetPayload,
+			HandleGetTeamFunc: mockGetTeamFuncForMatch,
+			ParsingTimeFunc:   timeParse,
+			ExpectedMatch:     expectedMatch,
+			ExpectError:       false,
+		}, {
+			Name:              "Test Case: 9 - venue and time zone default to the home team venue",
+			Payload:           homeVenuePayload,
+			HandleGetTeamFunc: mockGetTeamWithVenueFuncForMatch,
+			ParsingTimeFunc:   timeParse,
+			ExpectedMatch:     expectedHomeVenueMatch,
+			ExpectError:       false,
+		}, {
+			Name:              "Test Case: 10 - venue given in the body",
+			Payload:           venuePayload,
+			HandleGetTeamFunc: mockGetTeamFuncForMatch,
+			ParsingTimeFunc:   timeParse,
+			ExpectedMatch:     expectedVenueMatch,
+			ExpectError:       false,
+		}, {
+			Name:              "Test Case: 11 - throwing error on venue not found",
+			Payload:           unknownVenuePayload,
+			HandleGetTeamFunc: mockGetTeamFuncForMatch,
+			ParsingTimeFunc:   timeParse,
+			ExpectedMatch:     expectedMatch,
+			ExpectError:       true,
 		},
 	}
 
+	repo.SetVenueRepo(repo.MockVenueRepo{
+		GetFunc: mockGetVenueFuncForMatch,
+	})
+	defer repo.SetVenueRepo(nil)
+
 	for _, tc := range testCases {
 		t.Log(tc.Name)
 
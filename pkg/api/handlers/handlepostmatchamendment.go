@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/kafka"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostMatchAmendment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matchID := vars["match_id"]
+
+	if matchID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if match == nil {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	correctionID, ok := handleIdempotencyKey(w, r, match.ID, model.EventAmendment)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventAmendment)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	eventID := vars["event_id"]
+
+	if eventID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, eventID)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	original, err := repo.GetEventRepo().Get(ctx, eventID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if original == nil || original.MatchID != match.ID {
+		_ = errs.ErrEventIsNotFound.Throwf(applog.Log, errs.ErrFmt, eventID)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	events, err := repo.GetEventRepo().ListEventsFromMatch(ctx, match.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	err = validateCorrectableEvent(*original, events)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	amendmentPayload, body, err := decodeMatchAmendmentRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	value, err := convertAndValidatePayloadToAmendedValue(ctx, *match, *original, body)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	event := event.New(tournament.ID, match.ID, event.Amendment{
+		EventID: original.ID,
+		Reason:  amendmentPayload.Reason,
+		Type:    original.Type,
+		Value:   value,
+	})
+	event.ID = correctionID
+
+	if !insertEvent(w, r, event) {
+		return
+	}
+
+	data := map[string]string{
+		"matchEventType": string(model.EventAmendment),
+		"tournamentID":   tournament.ID,
+		"matchID":        match.ID,
+		"eventID":        event.ID,
+	}
+
+	go kafka.Notify(ctx, data, model.ActionGameEvents, "Game Events - Amendment", model.KafkaTopicMatchEvents)
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func decodeMatchAmendmentRequest(r *http.Request) (MatchCorrectionPayload, []byte, errs.AppError) {
+	body, err_ := io.ReadAll(r.Body)
+	if err_ != nil {
+		return MatchCorrectionPayload{}, nil, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	payload, err := decodeMatchCorrectionRequest(r)
+	if err != nil {
+		return payload, nil, err
+	}
+
+	return payload, body, nil
+}
+
+// the amended minute is checked against the period of the original event
+func convertAndValidatePayloadToAmendedValue(ctx context.Context, mt match.Match, original event.Event, body []byte) (event.Value, errs.AppError) {
+	switch original.Type {
+	case model.EventGoal:
+		payload := MatchGoalEntityPayload{}
+		err_ := json.Unmarshal(body, &payload)
+		if err_ != nil {
+			return nil, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		}
+
+		if !mt.FindTeamInMatch(payload.TeamScore) {
+			return nil, errs.ErrValidation.Throwf(applog.Log, "this team is not in this match: [%v]", payload.TeamScore)
+		}
+
+		goal, err := convertAndValidatePayloadToMatchGoal(ctx, mt, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		goal.Period, err = mt.CheckMinuteInPeriod(original.Period(), goal.Minute, goal.Stoppage)
+		if err != nil {
+			return nil, err
+		}
+
+		return goal, nil
+	case model.EventWarning:
+		payload := MatchWarningPayload{}
+		err_ := json.Unmarshal(body, &payload)
+		if err_ != nil {
+			return nil, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		}
+
+		if !mt.FindTeamInMatch(payload.Team) {
+			return nil, errs.ErrValidation.Throwf(applog.Log, "this team is not in this match: [%v]", payload.Team)
+		}
+
+		teamWarn, playerWarn, err := convertAndValidatePayloadToMatchWarning(ctx, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		period, err := mt.CheckMinuteInPeriod(original.Period(), payload.Minute, payload.Stoppage)
+		if err != nil {
+			return nil, err
+		}
+
+		return event.Warning{
+			Team:     *teamWarn,
+			Player:   *playerWarn,
+			Warning:  payload.Warning,
+			Period:   period,
+			Minute:   payload.Minute,
+			Stoppage: payload.Stoppage,
+		}, nil
+	case model.EventSubstitution:
+		payload := MatchSubstitutionPayload{}
+		err_ := json.Unmarshal(body, &payload)
+		if err_ != nil {
+			return nil, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		}
+
+		if !mt.FindTeamInMatch(payload.Team) {
+			return nil, errs.ErrValidation.Throwf(applog.Log, "this team is not in this match: [%v]", payload.Team)
+		}
+
+		teamSub, playerOut, playerIn, err := convertAndValidatePayloadToMatchSubstitution(ctx, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		period, err := mt.CheckMinuteInPeriod(original.Period(), payload.Minute, payload.Stoppage)
+		if err != nil {
+			return nil, err
+		}
+
+		return event.Substitution{
+			Team:       *teamSub,
+			PlayerOut:  *playerOut,
+			PlayerIn:   *playerIn,
+			Period:     period,
+			Minute:     payload.Minute,
+			Stoppage:   payload.Stoppage,
+			Concussion: payload.Concussion,
+		}, nil
+	}
+
+	return nil, errs.ErrEventNotCorrectable.Throwf(applog.Log, errs.ErrFmt, original.Type)
+}
@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockGetWarningEventFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	e := event.New("1", prototype.PrototypeMatch().ID, event.Warning{
+		Team:   prototype.PrototypeTeam(),
+		Player: prototype.PrototypePlayer(),
+		Period: model.PeriodFirstHalf,
+		Minute: 30,
+	})
+	e.ID = id
+	return &e, nil
+}
+
+func TestHandlePostMatchAmendment(t *testing.T) {
+	goalBody := []byte(`{"reason":"Wrong scorer","team_score":"1","player":"1","minute":12}`)
+	warningBody := []byte(`{"reason":"Wrong card","team":"1","player":"1","warning":"RedCard","minute":31}`)
+	outOfPeriodBody := []byte(`{"reason":"Wrong minute","team":"1","player":"1","warning":"RedCard","minute":61}`)
+
+	newRequest := func(vars map[string]string, body []byte) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/{event_id}/amend", nil)
+		req = mux.SetURLVars(req, vars)
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		return req
+	}
+
+	goodVars := map[string]string{"id": "any", "match_id": "any", "event_id": "2"}
+
+	testCases := []struct {
+		Name                             string
+		Request                          *http.Request
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleGetEventFunc               func(ctx context.Context, id string) (*event.Event, errs.AppError)
+		HandleListEventsFromMatchFunc    func(ctx context.Context, matchID string) ([]event.Event, errs.AppError)
+		HandlePostEventFunc              func(ctx context.Context, e event.Event) errs.AppError
+		HandleGetTeamFunc                func(ctx context.Context, id string) (*team.Team, errs.AppError)
+		HandleGetTeamPlayerFunc          func(ctx context.Context, id, teamID string) (*player.Player, errs.AppError)
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Should return 201 if successful amending a goal",
+			Request:                          newRequest(goodVars, goalBody),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 if successful amending a warning",
+			Request:                          newRequest(goodVars, warningBody),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetWarningEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 422 if the minute is out of the event period",
+			Request:                          newRequest(goodVars, outOfPeriodBody),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetWarningEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if no body request",
+			Request:                          newRequest(goodVars, nil),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 404 missing event id param",
+			Request:                          newRequest(map[string]string{"id": "any", "match_id": "any", "event_id": ""}, goalBody),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 422 if match is not started",
+			Request:                          newRequest(goodVars, goalBody),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 404 if event is not found",
+			Request:                          newRequest(goodVars, goalBody),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetEventNilFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 422 if event is not correctable",
+			Request:                          newRequest(goodVars, goalBody),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetStartEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if event is already retracted",
+			Request:                          newRequest(goodVars, goalBody),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsWithRetractionFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 throwing error get team player function",
+			Request:                          newRequest(goodVars, goalBody),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 500 throwing error post event function",
+			Request:                          newRequest(goodVars, goalBody),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventThrowFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: tc.HandleGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: tc.HandleGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			GetFunc:                 tc.HandleGetEventFunc,
+			ListEventsFromMatchFunc: tc.HandleListEventsFromMatchFunc,
+			InsertFunc:              tc.HandlePostEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		HandlePostMatchAmendment(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
@@ -60,37 +59,34 @@ func HandlePostMatchExtratime(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if match.Status != model.MatchStatusInProgress {
-		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", "Match cannot have extra time, because it is not in progress"))
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, model.EventExtratime)
+	if !ok {
 		return
 	}
 
-	extratime, err := decodeExtraTimeRequest(r)
+	err = match.CanApply(model.EventExtratime)
 	if err != nil {
 		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
 		return
 	}
 
-	value := struct {
-		Extratime int
-		Created   time.Time
-	}{
-		Extratime: extratime,
-		Created:   time.Now(),
+	extratime, err := decodeExtraTimeRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
 	}
 
-	event := event.Event{
-		TournamentID: tournament.ID,
-		MatchID:      match.ID,
-		Type:         model.EventExtratime,
-		Value:        value,
-	}
+	period := match.CurrentPeriod()
+
+	event := event.New(tournament.ID, match.ID, event.Extratime{
+		Period:    period,
+		Extratime: extratime,
+	})
+	event.ID = eventID
 
 	extratimeAsString := strconv.Itoa(extratime)
 
-	err = repo.GetEventRepo().Insert(ctx, event)
-	if err != nil {
-		errs.HttpInternalServerError(w)
+	if !insertEvent(w, r, event) {
 		return
 	}
 
@@ -98,6 +94,8 @@ func HandlePostMatchExtratime(w http.ResponseWriter, r *http.Request) {
 		"matchEventType": string(model.EventExtratime),
 		"tournamentID":   tournament.ID,
 		"matchID":        match.ID,
+		"eventID":        event.ID,
+		"period":         string(period),
 		"extratime":      extratimeAsString,
 	}
 
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/kafka"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostMatchExtraTimeFirstHalf(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchPeriod(w, r, model.EventExtraTimeFirstHalf)
+}
+
+func HandlePostMatchExtraTimeSecondHalf(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchPeriod(w, r, model.EventExtraTimeSecondHalf)
+}
+
+func handlePostMatchPeriod(w http.ResponseWriter, r *http.Request, t model.EventsMatchType) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matchID := vars["match_id"]
+
+	if matchID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if match == nil {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, t)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(t)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	timeStarted := time.Now().Format("15:04")
+
+	event := event.New(tournament.ID, match.ID, newPeriodValue(t, timeStarted))
+	event.ID = eventID
+
+	if !insertEvent(w, r, event) {
+		return
+	}
+
+	data := map[string]string{
+		"matchEventType": string(t),
+		"tournamentID":   tournament.ID,
+		"matchID":        match.ID,
+		"eventID":        event.ID,
+		"timeStarted":    timeStarted,
+		"created":        event.Created.Format(time.RFC3339Nano),
+	}
+
+	go kafka.Notify(ctx, data, model.ActionGameEvents, fmt.Sprintf("Game Events - %s", t), model.KafkaTopicMatchEvents)
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func newPeriodValue(t model.EventsMatchType, timeStarted string) event.Value {
+	switch t {
+	case model.EventExtraTimeFirstHalf:
+		return event.ExtraTimeFirstHalf{TimeStarted: timeStarted}
+	case model.EventExtraTimeSecondHalf:
+		return event.ExtraTimeSecondHalf{TimeStarted: timeStarted}
+	}
+	return event.PenaltyShootout{TimeStarted: timeStarted}
+}
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockFindMatchAfterSecondHalfForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusHalftime
+	matchMock.Period = model.PeriodSecondHalf
+	return &matchMock, nil
+}
+
+func mockFindMatchAfterSecondHalfNotLevelForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusHalftime
+	matchMock.Period = model.PeriodSecondHalf
+	matchMock.Score.Home = 1
+	return &matchMock, nil
+}
+
+func mockFindMatchAfterExtraTimeFirstHalfForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusHalftime
+	matchMock.Period = model.PeriodExtraTimeFirstHalf
+	return &matchMock, nil
+}
+
+func TestHandlePostMatchExtraTimePeriod(t *testing.T) {
+	newRequest := func(vars map[string]string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/extratime", nil)
+		return mux.SetURLVars(req, vars)
+	}
+
+	goodVars := map[string]string{"id": "any", "match_id": "any"}
+
+	testCases := []struct {
+		Name                             string
+		Handler                          http.HandlerFunc
+		Request                          *http.Request
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandlePostEventFunc              func(ctx context.Context, e event.Event) errs.AppError
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Should return 201 starting the first half of extra time",
+			Handler:                          HandlePostMatchExtraTimeFirstHalf,
+			Request:                          newRequest(goodVars),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 starting the second half of extra time",
+			Handler:                          HandlePostMatchExtraTimeSecondHalf,
+			Request:                          newRequest(goodVars),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterExtraTimeFirstHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 404 missing id param",
+			Handler:                          HandlePostMatchExtraTimeFirstHalf,
+			Request:                          newRequest(map[string]string{"id": "", "match_id": "any"}),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error get tournament function",
+			Handler:                          HandlePostMatchExtraTimeFirstHalf,
+			Request:                          newRequest(goodVars),
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if tournament is not found",
+			Handler:                          HandlePostMatchExtraTimeFirstHalf,
+			Request:                          newRequest(goodVars),
+			HandleGetTournamentFunc:          mockGetTournamentNilFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 404 missing match id param",
+			Handler:                          HandlePostMatchExtraTimeFirstHalf,
+			Request:                          newRequest(map[string]string{"id": "any", "match_id": ""}),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error find match to tournament function",
+			Handler:                          HandlePostMatchExtraTimeFirstHalf,
+			Request:                          newRequest(goodVars),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if match is not found",
+			Handler:                          HandlePostMatchExtraTimeFirstHalf,
+			Request:                          newRequest(goodVars),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 422 if first half is over but not the second",
+			Handler:                          HandlePostMatchExtraTimeFirstHalf,
+			Request:                          newRequest(goodVars),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusHalftimeForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the score is not level",
+			Handler:                          HandlePostMatchExtraTimeFirstHalf,
+			Request:                          newRequest(goodVars),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfNotLevelForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if extra time first half did not happen",
+			Handler:                          HandlePostMatchExtraTimeSecondHalf,
+			Request:                          newRequest(goodVars),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 500 throwing error post event function",
+			Handler:                          HandlePostMatchExtraTimeFirstHalf,
+			Request:                          newRequest(goodVars),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventThrowFunc,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			InsertFunc: tc.HandlePostEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		tc.Handler(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
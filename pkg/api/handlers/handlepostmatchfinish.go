@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -58,31 +61,41 @@ func HandlePostMatchFinish(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if match.Status != model.MatchStatusInProgress {
-		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", "Match is not in progress to be finished"))
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, model.EventFinish)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventFinish)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	matchFinishPayload, err := decodeMatchFinishRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
 		return
 	}
 
 	timeFinished := time.Now().Format("15:04")
 
-	value := struct {
-		TimeFinished string
-		Created      time.Time
-	}{
+	decidedBy := match.Decision()
+
+	event := event.New(tournament.ID, match.ID, event.Finish{
 		TimeFinished: timeFinished,
-		Created:      time.Now(),
-	}
+		DecidedBy:    decidedBy,
+		Attendance:   matchFinishPayload.Attendance,
+	})
+	event.ID = eventID
 
-	event := event.Event{
-		TournamentID: tournament.ID,
-		MatchID:      match.ID,
-		Type:         model.EventFinish,
-		Value:        value,
+	err = match.CheckAttendance(event)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
 	}
 
-	err = repo.GetEventRepo().Insert(ctx, event)
-	if err != nil {
-		errs.HttpInternalServerError(w)
+	if !insertEvent(w, r, event) {
 		return
 	}
 
@@ -90,10 +103,30 @@ func HandlePostMatchFinish(w http.ResponseWriter, r *http.Request) {
 		"matchEventType": string(model.EventFinish),
 		"tournamentID":   tournament.ID,
 		"matchID":        match.ID,
+		"eventID":        event.ID,
 		"timeFinished":   timeFinished,
+		"decidedBy":      string(decidedBy),
+	}
+
+	if matchFinishPayload.Attendance > 0 {
+		data["attendance"] = strconv.Itoa(matchFinishPayload.Attendance)
 	}
 
 	go kafka.Notify(ctx, data, model.ActionGameEvents, "Game Events - Finish", model.KafkaTopicMatchEvents)
 
 	w.WriteHeader(http.StatusCreated)
 }
+
+// decodeMatchFinishRequest reads the optional attendance, finishing a match
+// without a body is still allowed.
+func decodeMatchFinishRequest(r *http.Request) (MatchFinishPayload, errs.AppError) {
+	payload := MatchFinishPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil && err != io.EOF {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
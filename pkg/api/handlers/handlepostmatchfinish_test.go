@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -12,11 +13,25 @@ import (
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
 	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
 	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
 )
 
+func mockFindMatchInProgressWithVenueForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusInProgress
+	venueMock := prototype.PrototypeVenue()
+	matchMock.Venue = &venueMock
+	return &matchMock, nil
+}
+
 func TestHandlePostMatchFinish(t *testing.T) {
+	newAttendanceRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/finish", strings.NewReader(body))
+		return mux.SetURLVars(req, map[string]string{"id": "any", "match_id": "any"})
+	}
 
 	goodReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/finish", nil)
 	goodReq = mux.SetURLVars(goodReq, map[string]string{"id": "any", "match_id": "any"})
@@ -104,6 +119,34 @@ func TestHandlePostMatchFinish(t *testing.T) {
 			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
 			HandlePostEventFunc:              mockPostEventThrowFunc,
 			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 422 if the penalty shootout is not decided",
+			Request:                          throwReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusPenaltiesForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 201 recording the attendance",
+			Request:                          newAttendanceRequest(`{"attendance": 81044}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressWithVenueForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 422 if the attendance is over the venue capacity",
+			Request:                          newAttendanceRequest(`{"attendance": 81045}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressWithVenueForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the body is invalid",
+			Request:                          newAttendanceRequest(`{"attendance": "full"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchInProgressWithVenueForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
 		},
 	}
 
@@ -13,10 +13,9 @@ import (
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
 	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/kafka"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
-	"github.com/rafaelsanzio/go-flashscore/pkg/player"
-	"github.com/rafaelsanzio/go-flashscore/pkg/team"
 )
 
 func HandlePostMatchGoal(w http.ResponseWriter, r *http.Request) {
@@ -63,8 +62,14 @@ func HandlePostMatchGoal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if match.Status != model.MatchStatusInProgress {
-		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", "Match cannot have a goal because it is not in progress"))
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, model.EventGoal)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventGoal)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
 		return
 	}
 
@@ -80,46 +85,50 @@ func HandlePostMatchGoal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	teamScore, playerScore, goalMinute, err := convertAndValidatePayloadToMatchGoal(ctx, matchGoalPayload)
+	goal, err := convertAndValidatePayloadToMatchGoal(ctx, *match, matchGoalPayload)
 	if err != nil {
 		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
 		return
 	}
 
-	value := struct {
-		TeamScore  team.Team
-		Player     player.Player
-		GoalMinute int
-		Created    time.Time
-	}{
-		TeamScore:  *teamScore,
-		Player:     *playerScore,
-		GoalMinute: goalMinute,
-		Created:    time.Now(),
-	}
+	goal.Minute, goal.Stoppage = match.EventMinute(goal.Minute, goal.Stoppage, time.Now())
 
-	event := event.Event{
-		TournamentID: tournament.ID,
-		MatchID:      match.ID,
-		Type:         model.EventGoal,
-		Value:        value,
+	goal.Period, err = match.CheckMinute(goal.Minute, goal.Stoppage)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
 	}
 
-	err = repo.GetEventRepo().Insert(ctx, event)
+	event := event.New(tournament.ID, match.ID, goal)
+	event.ID = eventID
+
+	err = match.CheckSentOff(event)
 	if err != nil {
-		errs.HttpInternalServerError(w)
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if !insertEvent(w, r, event) {
 		return
 	}
 
-	goalMinuteAsString := strconv.Itoa(goalMinute)
+	goalMinuteAsString := strconv.Itoa(goal.Minute)
 
 	data := map[string]string{
 		"matchEventType": string(model.EventGoal),
 		"tournamentID":   tournament.ID,
 		"matchID":        match.ID,
-		"teamScore":      teamScore.ID,
-		"player":         playerScore.ID,
+		"eventID":        event.ID,
+		"teamScore":      goal.Team.ID,
+		"player":         goal.Player.ID,
+		"period":         string(goal.Period),
 		"goalMinute":     goalMinuteAsString,
+		"stoppage":       strconv.Itoa(goal.Stoppage),
+		"goalType":       string(goal.Type),
+	}
+
+	if goal.Assist != nil {
+		data["assist"] = goal.Assist.ID
 	}
 
 	go kafka.Notify(ctx, data, model.ActionGameEvents, "Game Events - Goal", model.KafkaTopicMatchEvents)
@@ -139,20 +148,53 @@ func decodeMatchGoalRequest(r *http.Request) (MatchGoalEntityPayload, errs.AppEr
 	return payload, nil
 }
 
-func convertAndValidatePayloadToMatchGoal(ctx context.Context, mt MatchGoalEntityPayload) (*team.Team, *player.Player, int, errs.AppError) {
-	teamScore, err := repo.GetTeamRepo().Get(ctx, mt.TeamScore)
+func convertAndValidatePayloadToMatchGoal(ctx context.Context, mt match.Match, payload MatchGoalEntityPayload) (event.Goal, errs.AppError) {
+	teamScore, err := repo.GetTeamRepo().Get(ctx, payload.TeamScore)
 	if err != nil || teamScore == nil {
-		return nil, nil, 0, errs.ErrTeamIsNotFound.Throwf(applog.Log, errs.ErrFmt, mt.TeamScore)
+		return event.Goal{}, errs.ErrTeamIsNotFound.Throwf(applog.Log, errs.ErrFmt, payload.TeamScore)
+	}
+
+	goalType := payload.Type
+	if goalType == "" {
+		goalType = model.GoalOpenPlay
 	}
 
-	player, err := repo.GetPlayerRepo().GetTeamPlayer(ctx, mt.Player, teamScore.ID)
+	// An own goal counts for the scoring team but is scored by a player of the opposing team.
+	playerTeamID := teamScore.ID
+	if goalType == model.GoalOwnGoal {
+		playerTeamID = mt.OpposingTeam(teamScore.ID).ID
+	}
+
+	player, err := repo.GetPlayerRepo().GetTeamPlayer(ctx, payload.Player, playerTeamID)
 	if err != nil || player == nil {
-		return nil, nil, 0, errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, teamScore.ID, mt.Player)
+		return event.Goal{}, errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, playerTeamID, payload.Player)
+	}
+
+	goal := event.Goal{
+		Team:     *teamScore,
+		Player:   *player,
+		Minute:   payload.Minute,
+		Stoppage: payload.Stoppage,
+		Type:     goalType,
+	}
+
+	if payload.Assist == "" {
+		return goal, nil
+	}
+
+	if goalType == model.GoalOwnGoal || goalType == model.GoalPenalty {
+		return event.Goal{}, errs.ErrGoalAssistNotAllowed.Throwf(applog.Log, errs.ErrFmt, goalType)
+	}
+
+	if payload.Assist == payload.Player {
+		return event.Goal{}, errs.ErrGoalAssistSamePlayer.Throwf(applog.Log, errs.ErrFmt, payload.Assist)
 	}
 
-	if mt.Minute > 90 {
-		return nil, nil, 0, errs.ErrGoalMinuteUpperToLimit.Throwf(applog.Log, errs.ErrFmt, mt.Minute)
+	assist, err := repo.GetPlayerRepo().GetTeamPlayer(ctx, payload.Assist, teamScore.ID)
+	if err != nil || assist == nil {
+		return event.Goal{}, errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, teamScore.ID, payload.Assist)
 	}
 
-	return teamScore, player, mt.Minute, nil
+	goal.Assist = assist
+	return goal, nil
 }
@@ -38,10 +38,42 @@ func mockFindMatchStatusNotStartedForTournamentFunc(ctx context.Context, id, tou
 	return &matchMock, nil
 }
 
+func mockFindMatchStoppageTimeForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusInProgress
+	matchMock.AddEvent(event.New(matchMock.Tournament.ID, matchMock.ID, event.Extratime{
+		Period:    model.PeriodFirstHalf,
+		Extratime: 3,
+	}))
+	return &matchMock, nil
+}
+
 func TestHandlePostMatchGoal(t *testing.T) {
 	body, err := json.Marshal(MatchGoalEntityPayload{
 		TeamScore: "1",
 		Player:    "1",
+		Minute:    10,
+	})
+	assert.Equal(t, nil, err)
+
+	clockBody, err := json.Marshal(MatchGoalEntityPayload{
+		TeamScore: "1",
+		Player:    "1",
+	})
+	assert.Equal(t, nil, err)
+
+	outOfPeriodBody, err := json.Marshal(MatchGoalEntityPayload{
+		TeamScore: "1",
+		Player:    "1",
+		Minute:    100,
+	})
+	assert.Equal(t, nil, err)
+
+	stoppageBody, err := json.Marshal(MatchGoalEntityPayload{
+		TeamScore: "1",
+		Player:    "1",
+		Minute:    45,
+		Stoppage:  2,
 	})
 	assert.Equal(t, nil, err)
 
@@ -49,6 +81,26 @@ func TestHandlePostMatchGoal(t *testing.T) {
 	goodReq = mux.SetURLVars(goodReq, map[string]string{"id": "any", "match_id": "any"})
 	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
 
+	clockReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/goal", nil)
+	clockReq = mux.SetURLVars(clockReq, map[string]string{"id": "any", "match_id": "any"})
+	clockReq.Body = ioutil.NopCloser(bytes.NewReader(clockBody))
+
+	stoppageReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/goal", nil)
+	stoppageReq = mux.SetURLVars(stoppageReq, map[string]string{"id": "any", "match_id": "any"})
+	stoppageReq.Body = ioutil.NopCloser(bytes.NewReader(stoppageBody))
+
+	stoppageOverReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/goal", nil)
+	stoppageOverReq = mux.SetURLVars(stoppageOverReq, map[string]string{"id": "any", "match_id": "any"})
+	stoppageOverReq.Body = ioutil.NopCloser(bytes.NewReader(stoppageBody))
+
+	outOfPeriodReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/goal", nil)
+	outOfPeriodReq = mux.SetURLVars(outOfPeriodReq, map[string]string{"id": "any", "match_id": "any"})
+	outOfPeriodReq.Body = ioutil.NopCloser(bytes.NewReader(outOfPeriodBody))
+
+	sentOffReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/goal", nil)
+	sentOffReq = mux.SetURLVars(sentOffReq, map[string]string{"id": "any", "match_id": "any"})
+	sentOffReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
 	noBodyReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/goal", nil)
 	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{"id": "any", "match_id": "any"})
 
@@ -97,6 +149,51 @@ func TestHandlePostMatchGoal(t *testing.T) {
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
 			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 taking the minute from the clock if omitted",
+			Request:                          clockReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 if the goal is in the announced stoppage time",
+			Request:                          stoppageReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStoppageTimeForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 422 if the stoppage time was not announced",
+			Request:                          stoppageOverReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the minute is out of the current period",
+			Request:                          outOfPeriodReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the player is sent off",
+			Request:                          sentOffReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchPlayerSentOffForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
 		}, {
 			Name:                             "Should return 422 if no body request",
 			Request:                          noBodyReq,
@@ -234,37 +331,70 @@ func TestHandlePostMatchGoal(t *testing.T) {
 	}
 }
 
-type payloadReturn struct {
-	team   team.Team
-	player player.Player
-	minute int
+func mockGetAwayTeamPlayerFunc(ctx context.Context, id, teamID string) (*player.Player, errs.AppError) {
+	if teamID != "2" {
+		return nil, nil
+	}
+	playerMock := prototype.PrototypePlayer()
+	playerMock.ID = id
+	return &playerMock, nil
+}
+
+func mockGetHomeTeamPlayerFunc(ctx context.Context, id, teamID string) (*player.Player, errs.AppError) {
+	if teamID != "1" {
+		return nil, nil
+	}
+	playerMock := prototype.PrototypePlayer()
+	playerMock.ID = id
+	return &playerMock, nil
 }
 
 func TestConvertAndValidatePayloadToMatchGoal(t *testing.T) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.AwayTeam.ID = "2"
+
 	inPayload := MatchGoalEntityPayload{
 		TeamScore: "1",
 		Player:    "1",
 		Minute:    10,
 	}
 
-	anotherInPayload := MatchGoalEntityPayload{
+	ownGoalPayload := MatchGoalEntityPayload{
 		TeamScore: "1",
-		Player:    "1",
-		Minute:    100,
+		Player:    "5",
+		Minute:    10,
+		Type:      model.GoalOwnGoal,
 	}
 
-	expectPayload := payloadReturn{
-		team:   prototype.PrototypeTeam(),
-		player: prototype.PrototypePlayer(),
-		minute: 10,
+	assistPayload := MatchGoalEntityPayload{
+		TeamScore: "1",
+		Player:    "1",
+		Minute:    10,
+		Type:      model.GoalHeader,
+		Assist:    "7",
 	}
 
+	assistPenaltyPayload := assistPayload
+	assistPenaltyPayload.Type = model.GoalPenalty
+
+	assistOwnGoalPayload := ownGoalPayload
+	assistOwnGoalPayload.Assist = "7"
+
+	assistSamePlayerPayload := assistPayload
+	assistSamePlayerPayload.Assist = "1"
+
+	playerMock := prototype.PrototypePlayer()
+	ownGoalPlayerMock := prototype.PrototypePlayer()
+	ownGoalPlayerMock.ID = "5"
+	assistMock := prototype.PrototypePlayer()
+	assistMock.ID = "7"
+
 	testCases := []struct {
 		Name                    string
 		Payload                 MatchGoalEntityPayload
 		HandleGetTeamFunc       func(ctx context.Context, id string) (*team.Team, errs.AppError)
 		HandleGetTeamPlayerFunc func(ctx context.Context, id, teamID string) (*player.Player, errs.AppError)
-		ExpectedReturn          payloadReturn
+		ExpectedReturn          event.Goal
 		ExpectError             bool
 	}{
 		{
@@ -272,28 +402,57 @@ func TestConvertAndValidatePayloadToMatchGoal(t *testing.T) {
 			Payload:                 inPayload,
 			HandleGetTeamFunc:       mockGetTeamFuncForMatch,
 			HandleGetTeamPlayerFunc: mockGetTeamPlayerFunc,
-			ExpectedReturn:          expectPayload,
+			ExpectedReturn:          event.Goal{Team: prototype.PrototypeTeam(), Player: playerMock, Minute: 10, Type: model.GoalOpenPlay},
 			ExpectError:             false,
 		}, {
 			Name:                    "Test Case: 2 - throwing error on get team function",
 			Payload:                 inPayload,
 			HandleGetTeamFunc:       mockGetTeamThrowFunc,
 			HandleGetTeamPlayerFunc: mockGetTeamPlayerFunc,
-			ExpectedReturn:          expectPayload,
 			ExpectError:             true,
 		}, {
 			Name:                    "Test Case: 3 - throwing error get player function",
 			Payload:                 inPayload,
 			HandleGetTeamFunc:       mockGetTeamFuncForMatch,
 			HandleGetTeamPlayerFunc: mockGetTeamPlayerThrowFunc,
-			ExpectedReturn:          expectPayload,
 			ExpectError:             true,
 		}, {
-			Name:                    "Test Case: 4 - throwing error on goal minute",
-			Payload:                 anotherInPayload,
+			Name:                    "Test Case: 4 - own goal scored by a player of the opposing team",
+			Payload:                 ownGoalPayload,
 			HandleGetTeamFunc:       mockGetTeamFuncForMatch,
-			HandleGetTeamPlayerFunc: mockGetTeamPlayerFunc,
-			ExpectedReturn:          expectPayload,
+			HandleGetTeamPlayerFunc: mockGetAwayTeamPlayerFunc,
+			ExpectedReturn:          event.Goal{Team: prototype.PrototypeTeam(), Player: ownGoalPlayerMock, Minute: 10, Type: model.GoalOwnGoal},
+			ExpectError:             false,
+		}, {
+			Name:                    "Test Case: 5 - own goal scored by a player of the scoring team",
+			Payload:                 ownGoalPayload,
+			HandleGetTeamFunc:       mockGetTeamFuncForMatch,
+			HandleGetTeamPlayerFunc: mockGetHomeTeamPlayerFunc,
+			ExpectError:             true,
+		}, {
+			Name:                    "Test Case: 6 - header with assist",
+			Payload:                 assistPayload,
+			HandleGetTeamFunc:       mockGetTeamFuncForMatch,
+			HandleGetTeamPlayerFunc: mockGetHomeTeamPlayerFunc,
+			ExpectedReturn:          event.Goal{Team: prototype.PrototypeTeam(), Player: playerMock, Minute: 10, Type: model.GoalHeader, Assist: &assistMock},
+			ExpectError:             false,
+		}, {
+			Name:                    "Test Case: 7 - assist is not allowed on a penalty",
+			Payload:                 assistPenaltyPayload,
+			HandleGetTeamFunc:       mockGetTeamFuncForMatch,
+			HandleGetTeamPlayerFunc: mockGetHomeTeamPlayerFunc,
+			ExpectError:             true,
+		}, {
+			Name:                    "Test Case: 8 - assist is not allowed on an own goal",
+			Payload:                 assistOwnGoalPayload,
+			HandleGetTeamFunc:       mockGetTeamFuncForMatch,
+			HandleGetTeamPlayerFunc: mockGetAwayTeamPlayerFunc,
+			ExpectError:             true,
+		}, {
+			Name:                    "Test Case: 9 - assist by the scorer",
+			Payload:                 assistSamePlayerPayload,
+			HandleGetTeamFunc:       mockGetTeamFuncForMatch,
+			HandleGetTeamPlayerFunc: mockGetHomeTeamPlayerFunc,
 			ExpectError:             true,
 		},
 	}
@@ -311,13 +470,90 @@ func TestConvertAndValidatePayloadToMatchGoal(t *testing.T) {
 		})
 		defer repo.SetPlayerRepo(nil)
 
-		team, player, minute, err := convertAndValidatePayloadToMatchGoal(context.Background(), tc.Payload)
+		goal, err := convertAndValidatePayloadToMatchGoal(context.Background(), matchMock, tc.Payload)
 		if tc.ExpectError {
 			assert.NotNil(t, err)
 		} else {
-			assert.Equal(t, tc.ExpectedReturn.team, *team)
-			assert.Equal(t, tc.ExpectedReturn.player, *player)
-			assert.Equal(t, tc.ExpectedReturn.minute, minute)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.ExpectedReturn, goal)
+		}
+	}
+}
+
+func TestHandlePostMatchGoalRetry(t *testing.T) {
+	body, err := json.Marshal(MatchGoalEntityPayload{
+		TeamScore: "1",
+		Player:    "1",
+		Minute:    10,
+	})
+	assert.Equal(t, nil, err)
+
+	testCases := []struct {
+		Name               string
+		HandleGetEventFunc func(ctx context.Context, id string) (*event.Event, errs.AppError)
+		ExpectedInserted   bool
+		ExpectedStatusCode int
+	}{
+		{
+			Name:               "Should store the goal with the key as the event id",
+			HandleGetEventFunc: mockGetEventNilFunc,
+			ExpectedInserted:   true,
+			ExpectedStatusCode: 201,
+		}, {
+			Name:               "Should not store the goal again on a retry",
+			HandleGetEventFunc: mockGetEventStoredFunc,
+			ExpectedInserted:   false,
+			ExpectedStatusCode: 201,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		var inserted *event.Event
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: mockGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: mockGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: mockGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			GetFunc: tc.HandleGetEventFunc,
+			InsertFunc: func(ctx context.Context, e event.Event) errs.AppError {
+				inserted = &e
+				return nil
+			},
+		})
+		defer repo.SetEventRepo(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/goal", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "any", "match_id": "any"})
+		req.Header.Set(IdempotencyKeyHeader, "goal-1")
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		w := httptest.NewRecorder()
+
+		HandlePostMatchGoal(w, req)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+		assert.Equal(t, tc.ExpectedInserted, inserted != nil)
+
+		if inserted != nil {
+			assert.Equal(t, "goal-1", inserted.ID)
 		}
 	}
 }
@@ -58,31 +58,25 @@ func HandlePostMatchHalftime(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if match.Status != model.MatchStatusInProgress {
-		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", "Match is not in progress to get halftime"))
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, model.EventHalftime)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventHalftime)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
 		return
 	}
 
 	halftime := time.Now().Format("15:04")
 
-	value := struct {
-		Halftime string
-		Created  time.Time
-	}{
+	event := event.New(tournament.ID, match.ID, event.Halftime{
 		Halftime: halftime,
-		Created:  time.Now(),
-	}
+	})
+	event.ID = eventID
 
-	event := event.Event{
-		TournamentID: tournament.ID,
-		MatchID:      match.ID,
-		Type:         model.EventHalftime,
-		Value:        value,
-	}
-
-	err = repo.GetEventRepo().Insert(ctx, event)
-	if err != nil {
-		errs.HttpInternalServerError(w)
+	if !insertEvent(w, r, event) {
 		return
 	}
 
@@ -90,6 +84,7 @@ func HandlePostMatchHalftime(w http.ResponseWriter, r *http.Request) {
 		"matchEventType": string(model.EventHalftime),
 		"tournamentID":   tournament.ID,
 		"matchID":        match.ID,
+		"eventID":        event.ID,
 		"halftime":       halftime,
 	}
 
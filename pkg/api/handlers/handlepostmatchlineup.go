@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/kafka"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+)
+
+const startingXISize = 11
+
+func HandlePostMatchLineup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matchID := vars["match_id"]
+
+	if matchID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if match == nil {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, model.EventLineup)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventLineup)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	matchLineupPayload, err := decodeMatchLineupRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	teamInMatch := match.FindTeamInMatch(matchLineupPayload.Team)
+	if !teamInMatch {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("this team is not in this match: [%v]", matchLineupPayload.Team))
+		return
+	}
+
+	lineup, err := convertAndValidatePayloadToMatchLineup(ctx, matchLineupPayload)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	// a team that registered its season squad can only field players of it
+	squad := tournament.Squad(lineup.Team.ID)
+	if squad != nil {
+		for _, playerID := range append(append([]string{}, matchLineupPayload.StartingXI...), matchLineupPayload.Bench...) {
+			if !squad.HasPlayer(playerID) {
+				err = errs.ErrLineupPlayerNotInSquad.Throwf(applog.Log, errs.ErrFmtMore, lineup.Team.ID, playerID)
+				errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+				return
+			}
+		}
+	}
+
+	event := event.New(tournament.ID, match.ID, lineup)
+	event.ID = eventID
+
+	if !insertEvent(w, r, event) {
+		return
+	}
+
+	data := map[string]string{
+		"matchEventType": string(model.EventLineup),
+		"tournamentID":   tournament.ID,
+		"matchID":        match.ID,
+		"eventID":        event.ID,
+		"teamID":         lineup.Team.ID,
+		"startingXI":     strings.Join(matchLineupPayload.StartingXI, ","),
+		"bench":          strings.Join(matchLineupPayload.Bench, ","),
+		"captainID":      lineup.Captain.ID,
+	}
+
+	go kafka.Notify(ctx, data, model.ActionGameEvents, "Game Events - Lineup", model.KafkaTopicMatchEvents)
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func decodeMatchLineupRequest(r *http.Request) (MatchLineupPayload, errs.AppError) {
+	payload := MatchLineupPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
+
+func convertAndValidatePayloadToMatchLineup(ctx context.Context, mt MatchLineupPayload) (event.Lineup, errs.AppError) {
+	team, err := repo.GetTeamRepo().Get(ctx, mt.Team)
+	if err != nil || team == nil {
+		return event.Lineup{}, errs.ErrTeamIsNotFound.Throwf(applog.Log, errs.ErrFmt, mt.Team)
+	}
+
+	if len(mt.StartingXI) != startingXISize {
+		return event.Lineup{}, errs.ErrLineupStartingXI.Throwf(applog.Log, errs.ErrFmt, len(mt.StartingXI))
+	}
+
+	seen := map[string]bool{}
+	for _, playerID := range append(append([]string{}, mt.StartingXI...), mt.Bench...) {
+		if seen[playerID] {
+			return event.Lineup{}, errs.ErrLineupDuplicatedPlayer.Throwf(applog.Log, errs.ErrFmt, playerID)
+		}
+		seen[playerID] = true
+	}
+
+	captainStarting := false
+	for _, playerID := range mt.StartingXI {
+		if playerID == mt.Captain {
+			captainStarting = true
+		}
+	}
+
+	if !captainStarting {
+		return event.Lineup{}, errs.ErrLineupCaptainNotStarting.Throwf(applog.Log, errs.ErrFmt, mt.Captain)
+	}
+
+	startingXI, err := getTeamPlayers(ctx, team.ID, mt.StartingXI)
+	if err != nil {
+		return event.Lineup{}, err
+	}
+
+	bench, err := getTeamPlayers(ctx, team.ID, mt.Bench)
+	if err != nil {
+		return event.Lineup{}, err
+	}
+
+	lineup := event.Lineup{
+		Team:       *team,
+		StartingXI: startingXI,
+		Bench:      bench,
+	}
+
+	for _, p := range startingXI {
+		if p.ID == mt.Captain {
+			lineup.Captain = p
+		}
+	}
+
+	return lineup, nil
+}
+
+func getTeamPlayers(ctx context.Context, teamID string, ids []string) ([]player.Player, errs.AppError) {
+	players := []player.Player{}
+	for _, playerID := range ids {
+		p, err := repo.GetPlayerRepo().GetTeamPlayer(ctx, playerID, teamID)
+		if err != nil || p == nil {
+			return nil, errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, teamID, playerID)
+		}
+		players = append(players, *p)
+	}
+	return players, nil
+}
@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockGetSeasonWithSquadFunc(ctx context.Context, id string) (*tournament.Tournament, errs.AppError) {
+	season, _ := mockGetSeasonFunc(ctx, id)
+	season.SetSquad(tournament.Squad{TeamID: "1", Players: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13"}})
+	return season, nil
+}
+
+func mockGetSeasonWithShortSquadFunc(ctx context.Context, id string) (*tournament.Tournament, errs.AppError) {
+	season, _ := mockGetSeasonFunc(ctx, id)
+	season.SetSquad(tournament.Squad{TeamID: "1", Players: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}})
+	return season, nil
+}
+
+func TestHandlePostMatchLineup(t *testing.T) {
+	startingXI := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}
+
+	newBody := func(teamID string, startingXI, bench []string, captain string) []byte {
+		body, err := json.Marshal(MatchLineupPayload{
+			Team:       teamID,
+			StartingXI: startingXI,
+			Bench:      bench,
+			Captain:    captain,
+		})
+		assert.Equal(t, nil, err)
+		return body
+	}
+
+	body := newBody("1", startingXI, []string{"12", "13"}, "1")
+
+	newRequest := func(vars map[string]string, body []byte) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/lineups", nil)
+		req = mux.SetURLVars(req, vars)
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		return req
+	}
+
+	goodVars := map[string]string{"id": "any", "match_id": "any"}
+
+	testCases := []struct {
+		Name                             string
+		Request                          *http.Request
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandlePostEventFunc              func(ctx context.Context, e event.Event) errs.AppError
+		HandleGetTeamFunc                func(ctx context.Context, id string) (*team.Team, errs.AppError)
+		HandleGetTeamPlayerFunc          func(ctx context.Context, id, teamID string) (*player.Player, errs.AppError)
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Should return 201 if successful",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 422 if no body request",
+			Request:                          newRequest(goodVars, nil),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 404 missing id param",
+			Request:                          newRequest(map[string]string{"id": "", "match_id": "any"}, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error get tournament function",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if match is not found",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 422 if match is already started",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the team is not in the match",
+			Request:                          newRequest(goodVars, newBody("2", startingXI, nil, "1")),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the starting lineup has not 11 players",
+			Request:                          newRequest(goodVars, newBody("1", startingXI[:10], nil, "1")),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if a player is starting and on the bench",
+			Request:                          newRequest(goodVars, newBody("1", startingXI, []string{"11"}, "1")),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the captain is not starting",
+			Request:                          newRequest(goodVars, newBody("1", startingXI, []string{"12"}, "12")),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if a player is not in the team",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 500 throwing error post event function",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventThrowFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 201 with the players of the season squad",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetSeasonWithSquadFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 422 with a player out of the season squad",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetSeasonWithShortSquadFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetHomeTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: tc.HandleGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: tc.HandleGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			InsertFunc: tc.HandlePostEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		HandlePostMatchLineup(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostMatchOfficials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matchID := vars["match_id"]
+
+	if matchID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if match == nil {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matchOfficialsPayload, err := decodeMatchOfficialsRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	officials, err := convertPayloadToMatchOfficials(ctx, matchOfficialsPayload)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	err = match.AssignOfficials(officials)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	err = checkOfficialConflicts(ctx, *match)
+	if err != nil {
+		if errs.ErrOfficialConflict.Is(err) {
+			errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+			return
+		}
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	err = repo.GetMatchRepo().UpdateOfficials(ctx, match.ID, match.Officials)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func checkOfficialConflicts(ctx context.Context, mt match.Match) errs.AppError {
+	return match.CheckOfficialConflicts(ctx, repo.GetMatchRepo(), mt)
+}
+
+func decodeMatchOfficialsRequest(r *http.Request) (MatchOfficialsPayload, errs.AppError) {
+	payload := MatchOfficialsPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
+
+// convertPayloadToMatchOfficials looks up every official, the role defaults to
+// the one the official is registered with.
+func convertPayloadToMatchOfficials(ctx context.Context, payload MatchOfficialsPayload) ([]match.MatchOfficial, errs.AppError) {
+	officials := []match.MatchOfficial{}
+	for _, o := range payload.Officials {
+		official, err := repo.GetOfficialRepo().Get(ctx, o.Official)
+		if err != nil || official == nil {
+			return nil, errs.ErrOfficialIsNotFound.Throwf(applog.Log, errs.ErrFmt, o.Official)
+		}
+
+		role := o.Role
+		if role == "" {
+			role = official.Role
+		}
+
+		officials = append(officials, match.MatchOfficial{
+			Official: *official,
+			Role:     role,
+		})
+	}
+
+	return officials, nil
+}
@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockListByKickoffNoMatchFunc(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError) {
+	return []match.Match{}, nil
+}
+
+func mockListByKickoffOverlappingFunc(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.ID = "2"
+	matchMock.Kickoff = matchMock.Kickoff.Add(time.Hour)
+	matchMock.Officials = []match.MatchOfficial{{Official: prototype.PrototypeOfficial(), Role: model.OfficialReferee}}
+	return []match.Match{matchMock}, nil
+}
+
+func mockListByKickoffThrowFunc(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func mockUpdateMatchOfficialsFunc(ctx context.Context, id string, officials []match.MatchOfficial) errs.AppError {
+	return nil
+}
+
+func mockUpdateMatchOfficialsThrowFunc(ctx context.Context, id string, officials []match.MatchOfficial) errs.AppError {
+	return errs.ErrRepoMockAction
+}
+
+func TestHandlePostMatchOfficials(t *testing.T) {
+	newBody := func(officials ...MatchOfficialPayload) []byte {
+		body, err := json.Marshal(MatchOfficialsPayload{Officials: officials})
+		assert.Equal(t, nil, err)
+		return body
+	}
+
+	body := newBody(MatchOfficialPayload{Official: "1", Role: model.OfficialReferee})
+
+	newRequest := func(vars map[string]string, body []byte) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/officials", nil)
+		req = mux.SetURLVars(req, vars)
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		return req
+	}
+
+	goodVars := map[string]string{"id": "any", "match_id": "any"}
+
+	testCases := []struct {
+		Name                             string
+		Request                          *http.Request
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleGetOfficialFunc            func(ctx context.Context, id string) (*official.Official, errs.AppError)
+		HandleListByKickoffFunc          func(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError)
+		HandleUpdateMatchFunc            func(ctx context.Context, id string, officials []match.MatchOfficial) errs.AppError
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Should return 200 if successful",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               200,
+		}, {
+			Name:                             "Should return 200 clearing the officials",
+			Request:                          newRequest(goodVars, newBody()),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffThrowFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               200,
+		}, {
+			Name:                             "Should return 422 if no body request",
+			Request:                          newRequest(goodVars, nil),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the official is not found",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialNilFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the official is assigned twice",
+			Request:                          newRequest(goodVars, newBody(MatchOfficialPayload{Official: "1"}, MatchOfficialPayload{Official: "1", Role: model.OfficialFourthOfficial})),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the official is on an overlapping match",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffOverlappingFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 500 on list by kickoff func",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffThrowFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 500 on update match func",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsThrowFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 missing id param",
+			Request:                          newRequest(map[string]string{"id": "", "match_id": "any"}, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 404 missing match_id param",
+			Request:                          newRequest(map[string]string{"id": "any", "match_id": ""}, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 404 if tournament is not found",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentNilFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 404 if match is not found",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 on get tournament func",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 500 on find match func",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandleGetOfficialFunc:            mockGetOfficialFunc,
+			HandleListByKickoffFunc:          mockListByKickoffNoMatchFunc,
+			HandleUpdateMatchFunc:            mockUpdateMatchOfficialsFunc,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+			ListByKickoffFunc:          tc.HandleListByKickoffFunc,
+			UpdateOfficialsFunc:        tc.HandleUpdateMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetOfficialRepo(repo.MockOfficialRepo{
+			GetFunc: tc.HandleGetOfficialFunc,
+		})
+		defer repo.SetOfficialRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		HandlePostMatchOfficials(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
+
+func TestConvertPayloadToMatchOfficials(t *testing.T) {
+	repo.SetOfficialRepo(repo.MockOfficialRepo{
+		GetFunc: mockGetOfficialFunc,
+	})
+	defer repo.SetOfficialRepo(nil)
+
+	officials, err := convertPayloadToMatchOfficials(context.Background(), MatchOfficialsPayload{
+		Officials: []MatchOfficialPayload{
+			{Official: "1"},
+			{Official: "1", Role: model.OfficialVideoAssistant},
+		},
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, []match.MatchOfficial{
+		{Official: prototype.PrototypeOfficial(), Role: model.OfficialReferee},
+		{Official: prototype.PrototypeOfficial(), Role: model.OfficialVideoAssistant},
+	}, officials)
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/kafka"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+func HandlePostMatchPenaltyKick(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matchID := vars["match_id"]
+
+	if matchID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if match == nil {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, model.EventPenaltyKick)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventPenaltyKick)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	matchPenaltyKickPayload, err := decodeMatchPenaltyKickRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	teamInMatch := match.FindTeamInMatch(matchPenaltyKickPayload.Team)
+	if !teamInMatch {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("this team is not in this match: [%v]", matchPenaltyKickPayload.Team))
+		return
+	}
+
+	teamKick, playerKick, err := convertAndValidatePayloadToMatchPenaltyKick(ctx, matchPenaltyKickPayload)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	event := event.New(tournament.ID, match.ID, event.PenaltyKick{
+		Team:   *teamKick,
+		Player: *playerKick,
+		Scored: matchPenaltyKickPayload.Scored,
+	})
+	event.ID = eventID
+
+	if !insertEvent(w, r, event) {
+		return
+	}
+
+	data := map[string]string{
+		"matchEventType": string(model.EventPenaltyKick),
+		"tournamentID":   tournament.ID,
+		"matchID":        match.ID,
+		"eventID":        event.ID,
+		"teamID":         teamKick.ID,
+		"playerID":       playerKick.ID,
+		"scored":         strconv.FormatBool(matchPenaltyKickPayload.Scored),
+	}
+
+	go kafka.Notify(ctx, data, model.ActionGameEvents, "Game Events - Penalty Kick", model.KafkaTopicMatchEvents)
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func decodeMatchPenaltyKickRequest(r *http.Request) (MatchPenaltyKickPayload, errs.AppError) {
+	payload := MatchPenaltyKickPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
+
+func convertAndValidatePayloadToMatchPenaltyKick(ctx context.Context, mt MatchPenaltyKickPayload) (*team.Team, *player.Player, errs.AppError) {
+	team, err := repo.GetTeamRepo().Get(ctx, mt.Team)
+	if err != nil || team == nil {
+		return nil, nil, errs.ErrTeamIsNotFound.Throwf(applog.Log, errs.ErrFmt, mt.Team)
+	}
+
+	player, err := repo.GetPlayerRepo().GetTeamPlayer(ctx, mt.Player, team.ID)
+	if err != nil || player == nil {
+		return nil, nil, errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, team.ID, mt.Player)
+	}
+
+	return team, player, nil
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockFindMatchStatusPenaltiesForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusPenalties
+	matchMock.Period = model.PeriodPenalties
+	return &matchMock, nil
+}
+
+func TestHandlePostMatchPenaltyKick(t *testing.T) {
+	body, err := json.Marshal(MatchPenaltyKickPayload{
+		Team:   "1",
+		Player: "1",
+		Scored: true,
+	})
+	assert.Equal(t, nil, err)
+
+	bodyTeamNotInMatch, err := json.Marshal(MatchPenaltyKickPayload{
+		Team:   "2",
+		Player: "1",
+		Scored: true,
+	})
+	assert.Equal(t, nil, err)
+
+	newRequest := func(vars map[string]string, body []byte) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/penaltykick", nil)
+		req = mux.SetURLVars(req, vars)
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		return req
+	}
+
+	goodVars := map[string]string{"id": "any", "match_id": "any"}
+
+	testCases := []struct {
+		Name                             string
+		Request                          *http.Request
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandlePostEventFunc              func(ctx context.Context, e event.Event) errs.AppError
+		HandleGetTeamFunc                func(ctx context.Context, id string) (*team.Team, errs.AppError)
+		HandleGetTeamPlayerFunc          func(ctx context.Context, id, teamID string) (*player.Player, errs.AppError)
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Should return 201 if successful",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusPenaltiesForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 422 if no body request",
+			Request:                          newRequest(goodVars, nil),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusPenaltiesForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 404 missing id param",
+			Request:                          newRequest(map[string]string{"id": "", "match_id": "any"}, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusPenaltiesForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error get tournament function",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusPenaltiesForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if match is not found",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 422 if match is not in a penalty shootout",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the team is not in the match",
+			Request:                          newRequest(goodVars, bodyTeamNotInMatch),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusPenaltiesForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 throwing error get team player function",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusPenaltiesForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 500 throwing error post event function",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusPenaltiesForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventThrowFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: tc.HandleGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: tc.HandleGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			InsertFunc: tc.HandlePostEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		HandlePostMatchPenaltyKick(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
@@ -0,0 +1,11 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+func HandlePostMatchPenaltyShootout(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchPeriod(w, r, model.EventPenaltyShootout)
+}
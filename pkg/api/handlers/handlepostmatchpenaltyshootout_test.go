@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func TestHandlePostMatchPenaltyShootout(t *testing.T) {
+	goodReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/penaltyshootout", nil)
+	goodReq = mux.SetURLVars(goodReq, map[string]string{"id": "any", "match_id": "any"})
+
+	testCases := []struct {
+		Name                             string
+		Request                          *http.Request
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandlePostEventFunc              func(ctx context.Context, e event.Event) errs.AppError
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Should return 201 if successful after the second half",
+			Request:                          goodReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 422 if the score is not level",
+			Request:                          goodReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfNotLevelForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 after the first half of extra time",
+			Request:                          goodReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterExtraTimeFirstHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if match is in progress",
+			Request:                          goodReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 500 throwing error post event function",
+			Request:                          goodReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventThrowFunc,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			InsertFunc: tc.HandlePostEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		HandlePostMatchPenaltyShootout(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/kafka"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostMatchPossession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matchID := vars["match_id"]
+
+	if matchID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if match == nil {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, model.EventPossession)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventPossession)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	matchPossessionPayload, err := decodeMatchPossessionRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	minute, stoppage := match.EventMinute(matchPossessionPayload.Minute, matchPossessionPayload.Stoppage, time.Now())
+
+	period, err := match.CheckMinute(minute, stoppage)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	event := event.New(tournament.ID, match.ID, event.Possession{
+		Home:     matchPossessionPayload.Home,
+		Away:     matchPossessionPayload.Away,
+		Period:   period,
+		Minute:   minute,
+		Stoppage: stoppage,
+	})
+	event.ID = eventID
+
+	err = checkPossession(event)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if !insertEvent(w, r, event) {
+		return
+	}
+
+	data := map[string]string{
+		"matchEventType": string(model.EventPossession),
+		"tournamentID":   tournament.ID,
+		"matchID":        match.ID,
+		"eventID":        event.ID,
+		"home":           strconv.Itoa(matchPossessionPayload.Home),
+		"away":           strconv.Itoa(matchPossessionPayload.Away),
+		"period":         string(period),
+		"minute":         strconv.Itoa(minute),
+		"stoppage":       strconv.Itoa(stoppage),
+	}
+
+	go kafka.Notify(ctx, data, model.ActionGameEvents, "Game Events - Possession", model.KafkaTopicMatchEvents)
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func checkPossession(e event.Event) errs.AppError {
+	return match.CheckPossession(e)
+}
+
+func decodeMatchPossessionRequest(r *http.Request) (MatchPossessionPayload, errs.AppError) {
+	payload := MatchPossessionPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
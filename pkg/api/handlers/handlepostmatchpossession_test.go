@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func TestHandlePostMatchPossession(t *testing.T) {
+	newRequest := func(vars map[string]string, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/possession", nil)
+		req.Body = ioutil.NopCloser(bytes.NewReader([]byte(body)))
+		return mux.SetURLVars(req, vars)
+	}
+
+	goodVars := map[string]string{"id": "any", "match_id": "any"}
+
+	testCases := []struct {
+		Name                             string
+		Request                          *http.Request
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandlePostEventFunc              func(ctx context.Context, e event.Event) errs.AppError
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Should return 201 with a possession snapshot",
+			Request:                          newRequest(goodVars, `{"home": 58, "away": 42, "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 404 missing id param",
+			Request:                          newRequest(map[string]string{"id": "", "match_id": "any"}, `{"home": 58, "away": 42, "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error get tournament function",
+			Request:                          newRequest(goodVars, `{"home": 58, "away": 42, "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if tournament is not found",
+			Request:                          newRequest(goodVars, `{"home": 58, "away": 42, "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentNilFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 404 missing match id param",
+			Request:                          newRequest(map[string]string{"id": "any", "match_id": ""}, `{"home": 58, "away": 42, "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error find match to tournament function",
+			Request:                          newRequest(goodVars, `{"home": 58, "away": 42, "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if match is not found",
+			Request:                          newRequest(goodVars, `{"home": 58, "away": 42, "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 422 on a match not in progress",
+			Request:                          newRequest(goodVars, `{"home": 58, "away": 42, "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 without a body",
+			Request:                          newRequest(goodVars, ""),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 with a possession not adding up to 100",
+			Request:                          newRequest(goodVars, `{"home": 58, "away": 40, "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 with a minute out of the period",
+			Request:                          newRequest(goodVars, `{"home": 58, "away": 42, "minute": 75}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 500 throwing error post event function",
+			Request:                          newRequest(goodVars, `{"home": 58, "away": 42, "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventThrowFunc,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			InsertFunc: tc.HandlePostEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		HandlePostMatchPossession(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/kafka"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostMatchRetraction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matchID := vars["match_id"]
+
+	if matchID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if match == nil {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	correctionID, ok := handleIdempotencyKey(w, r, match.ID, model.EventRetraction)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventRetraction)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	eventID := vars["event_id"]
+
+	if eventID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, eventID)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	original, err := repo.GetEventRepo().Get(ctx, eventID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if original == nil || original.MatchID != match.ID {
+		_ = errs.ErrEventIsNotFound.Throwf(applog.Log, errs.ErrFmt, eventID)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	events, err := repo.GetEventRepo().ListEventsFromMatch(ctx, match.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	err = validateCorrectableEvent(*original, events)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	retractionPayload, err := decodeMatchCorrectionRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	event := event.New(tournament.ID, match.ID, event.Retraction{
+		EventID: original.ID,
+		Reason:  retractionPayload.Reason,
+	})
+	event.ID = correctionID
+
+	if !insertEvent(w, r, event) {
+		return
+	}
+
+	data := map[string]string{
+		"matchEventType": string(model.EventRetraction),
+		"tournamentID":   tournament.ID,
+		"matchID":        match.ID,
+		"eventID":        event.ID,
+	}
+
+	go kafka.Notify(ctx, data, model.ActionGameEvents, "Game Events - Retraction", model.KafkaTopicMatchEvents)
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func decodeMatchCorrectionRequest(r *http.Request) (MatchCorrectionPayload, errs.AppError) {
+	payload := MatchCorrectionPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	if payload.Reason == "" {
+		return payload, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "reason is required")
+	}
+
+	return payload, nil
+}
+
+func validateCorrectableEvent(e event.Event, events []event.Event) errs.AppError {
+	if !event.IsCorrectable(e.Type) {
+		return errs.ErrEventNotCorrectable.Throwf(applog.Log, errs.ErrFmtMore, e.ID, e.Type)
+	}
+
+	if event.IsRetracted(events, e.ID) {
+		return errs.ErrEventAlreadyRetracted.Throwf(applog.Log, errs.ErrFmt, e.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockGetGoalEventFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	e := event.New("1", prototype.PrototypeMatch().ID, event.Goal{
+		Team:   prototype.PrototypeTeam(),
+		Player: prototype.PrototypePlayer(),
+		Minute: 10,
+	})
+	e.ID = id
+	return &e, nil
+}
+
+func mockGetStartEventFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	e := event.New("1", prototype.PrototypeMatch().ID, event.Start{TimeStarted: "16:00"})
+	e.ID = id
+	return &e, nil
+}
+
+func mockGetEventFromAnotherMatchFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	e := event.New("1", "another-match", event.Goal{Minute: 10})
+	e.ID = id
+	return &e, nil
+}
+
+func mockGetEventThrowFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func mockGetEventNilFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	return nil, nil
+}
+
+func mockListEventsWithRetractionFunc(ctx context.Context, matchID string) ([]event.Event, errs.AppError) {
+	retraction := event.New("1", matchID, event.Retraction{EventID: "2", Reason: "Offside"})
+	return []event.Event{retraction}, nil
+}
+
+func TestHandlePostMatchRetraction(t *testing.T) {
+	body, err := json.Marshal(MatchCorrectionPayload{
+		Reason: "Offside",
+	})
+	assert.Equal(t, nil, err)
+
+	newRequest := func(vars map[string]string, body []byte) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/{event_id}/retract", nil)
+		req = mux.SetURLVars(req, vars)
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		return req
+	}
+
+	goodVars := map[string]string{"id": "any", "match_id": "any", "event_id": "2"}
+
+	testCases := []struct {
+		Name                             string
+		Request                          *http.Request
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleGetEventFunc               func(ctx context.Context, id string) (*event.Event, errs.AppError)
+		HandleListEventsFromMatchFunc    func(ctx context.Context, matchID string) ([]event.Event, errs.AppError)
+		HandlePostEventFunc              func(ctx context.Context, e event.Event) errs.AppError
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Should return 201 if successful",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 422 if no body request",
+			Request:                          newRequest(goodVars, nil),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if reason is missing",
+			Request:                          newRequest(goodVars, []byte(`{}`)),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 404 missing id param",
+			Request:                          newRequest(map[string]string{"id": "", "match_id": "any", "event_id": "2"}, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error get tournament function",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if tournament is not found",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentNilFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 404 missing match id param",
+			Request:                          newRequest(map[string]string{"id": "any", "match_id": "", "event_id": "2"}, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error find match to tournament function",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if match is not found",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 422 if match is not started",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusNotStartedForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 404 missing event id param",
+			Request:                          newRequest(map[string]string{"id": "any", "match_id": "any", "event_id": ""}, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error get event function",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetEventThrowFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if event is not found",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetEventNilFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 404 if event belongs to another match",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetEventFromAnotherMatchFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error list events function",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchThrowFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 422 if event is not correctable",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetStartEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if event is already retracted",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsWithRetractionFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 500 throwing error post event function",
+			Request:                          newRequest(goodVars, body),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandleGetEventFunc:               mockGetGoalEventFunc,
+			HandleListEventsFromMatchFunc:    mockListEventsFromMatchFunc,
+			HandlePostEventFunc:              mockPostEventThrowFunc,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			GetFunc:                 tc.HandleGetEventFunc,
+			ListEventsFromMatchFunc: tc.HandleListEventsFromMatchFunc,
+			InsertFunc:              tc.HandlePostEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		HandlePostMatchRetraction(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
@@ -58,31 +58,25 @@ func HandlePostMatchStart(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if match.Status != model.MatchStatusNotStart && match.Status != model.MatchStatusHalftime {
-		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", "Match cannot be started since it is already started"))
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, model.EventStart)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventStart)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
 		return
 	}
 
 	timeStarted := time.Now().Format("15:04")
 
-	value := struct {
-		TimeStarted string
-		Created     time.Time
-	}{
+	event := event.New(tournament.ID, match.ID, event.Start{
 		TimeStarted: timeStarted,
-		Created:     time.Now(),
-	}
+	})
+	event.ID = eventID
 
-	event := event.Event{
-		TournamentID: tournament.ID,
-		MatchID:      match.ID,
-		Type:         model.EventStart,
-		Value:        value,
-	}
-
-	err = repo.GetEventRepo().Insert(ctx, event)
-	if err != nil {
-		errs.HttpInternalServerError(w)
+	if !insertEvent(w, r, event) {
 		return
 	}
 
@@ -90,7 +84,9 @@ func HandlePostMatchStart(w http.ResponseWriter, r *http.Request) {
 		"matchEventType": string(model.EventStart),
 		"tournamentID":   tournament.ID,
 		"matchID":        match.ID,
+		"eventID":        event.ID,
 		"timeStarted":    timeStarted,
+		"created":        event.Created.Format(time.RFC3339Nano),
 	}
 
 	go kafka.Notify(ctx, data, model.ActionGameEvents, "Game Events - Start", model.KafkaTopicMatchEvents)
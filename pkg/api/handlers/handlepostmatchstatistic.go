@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/kafka"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+func HandlePostMatchShot(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchStatistic(w, r, model.EventShot)
+}
+
+func HandlePostMatchCorner(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchStatistic(w, r, model.EventCorner)
+}
+
+func HandlePostMatchFoul(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchStatistic(w, r, model.EventFoul)
+}
+
+func HandlePostMatchOffside(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchStatistic(w, r, model.EventOffside)
+}
+
+func HandlePostMatchSave(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchStatistic(w, r, model.EventSave)
+}
+
+func handlePostMatchStatistic(w http.ResponseWriter, r *http.Request, t model.EventsMatchType) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matchID := vars["match_id"]
+
+	if matchID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if match == nil {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, t)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(t)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	matchStatisticPayload, err := decodeMatchStatisticRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	teamInMatch := match.FindTeamInMatch(matchStatisticPayload.Team)
+	if !teamInMatch {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("this team is not in this match: [%v]", matchStatisticPayload.Team))
+		return
+	}
+
+	teamStat, playerStat, err := convertAndValidatePayloadToMatchStatistic(ctx, t, matchStatisticPayload)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	minute, stoppage := match.EventMinute(matchStatisticPayload.Minute, matchStatisticPayload.Stoppage, time.Now())
+
+	period, err := match.CheckMinute(minute, stoppage)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	event := event.New(tournament.ID, match.ID, newStatisticValue(t, *teamStat, playerStat, matchStatisticPayload.OnTarget, period, minute, stoppage))
+	event.ID = eventID
+
+	err = match.CheckSentOff(event)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if !insertEvent(w, r, event) {
+		return
+	}
+
+	data := map[string]string{
+		"matchEventType": string(t),
+		"tournamentID":   tournament.ID,
+		"matchID":        match.ID,
+		"eventID":        event.ID,
+		"teamID":         teamStat.ID,
+		"period":         string(period),
+		"minute":         strconv.Itoa(minute),
+		"stoppage":       strconv.Itoa(stoppage),
+	}
+
+	if playerStat != nil {
+		data["playerID"] = playerStat.ID
+	}
+
+	if t == model.EventShot {
+		data["onTarget"] = strconv.FormatBool(matchStatisticPayload.OnTarget)
+	}
+
+	go kafka.Notify(ctx, data, model.ActionGameEvents, fmt.Sprintf("Game Events - %s", t), model.KafkaTopicMatchEvents)
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func decodeMatchStatisticRequest(r *http.Request) (MatchStatisticPayload, errs.AppError) {
+	payload := MatchStatisticPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
+
+// convertAndValidatePayloadToMatchStatistic looks up the team and, for every
+// statistic but a corner, the player of that team.
+func convertAndValidatePayloadToMatchStatistic(ctx context.Context, t model.EventsMatchType, mt MatchStatisticPayload) (*team.Team, *player.Player, errs.AppError) {
+	team, err := repo.GetTeamRepo().Get(ctx, mt.Team)
+	if err != nil || team == nil {
+		return nil, nil, errs.ErrTeamIsNotFound.Throwf(applog.Log, errs.ErrFmt, mt.Team)
+	}
+
+	if t == model.EventCorner {
+		return team, nil, nil
+	}
+
+	player, err := repo.GetPlayerRepo().GetTeamPlayer(ctx, mt.Player, team.ID)
+	if err != nil || player == nil {
+		return nil, nil, errs.ErrPlayerIsNotFoundInThisTeam.Throwf(applog.Log, errs.ErrFmtMore, team.ID, mt.Player)
+	}
+
+	return team, player, nil
+}
+
+func newStatisticValue(t model.EventsMatchType, tm team.Team, p *player.Player, onTarget bool, period model.MatchPeriod, minute, stoppage int) event.Value {
+	switch t {
+	case model.EventShot:
+		return event.Shot{Team: tm, Player: *p, OnTarget: onTarget, Period: period, Minute: minute, Stoppage: stoppage}
+	case model.EventFoul:
+		return event.Foul{Team: tm, Player: *p, Period: period, Minute: minute, Stoppage: stoppage}
+	case model.EventOffside:
+		return event.Offside{Team: tm, Player: *p, Period: period, Minute: minute, Stoppage: stoppage}
+	case model.EventSave:
+		return event.Save{Team: tm, Player: *p, Period: period, Minute: minute, Stoppage: stoppage}
+	}
+	return event.Corner{Team: tm, Period: period, Minute: minute, Stoppage: stoppage}
+}
@@ -0,0 +1,316 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func TestHandlePostMatchStatistic(t *testing.T) {
+	newRequest := func(vars map[string]string, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/shot", nil)
+		req.Body = ioutil.NopCloser(bytes.NewReader([]byte(body)))
+		return mux.SetURLVars(req, vars)
+	}
+
+	goodVars := map[string]string{"id": "any", "match_id": "any"}
+
+	testCases := []struct {
+		Name                             string
+		Handler                          http.HandlerFunc
+		Request                          *http.Request
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandleGetTeamFunc                func(ctx context.Context, id string) (*team.Team, errs.AppError)
+		HandleGetTeamPlayerFunc          func(ctx context.Context, id, teamID string) (*player.Player, errs.AppError)
+		HandlePostEventFunc              func(ctx context.Context, e event.Event) errs.AppError
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Should return 201 with a shot on target",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "on_target": true, "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 with a corner without a player",
+			Handler:                          HandlePostMatchCorner,
+			Request:                          newRequest(goodVars, `{"team": "1", "minute": 20}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 with a foul",
+			Handler:                          HandlePostMatchFoul,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 25}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 with an offside",
+			Handler:                          HandlePostMatchOffside,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 40}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 with a save",
+			Handler:                          HandlePostMatchSave,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 30}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 404 missing id param",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(map[string]string{"id": "", "match_id": "any"}, `{"team": "1", "player": "1", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error get tournament function",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if tournament is not found",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentNilFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 404 missing match id param",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(map[string]string{"id": "any", "match_id": ""}, `{"team": "1", "player": "1", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error find match to tournament function",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if match is not found",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 422 on a match not in progress",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 without a body",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, ""),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 with a team not in the match",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, `{"team": "2", "player": "1", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if team is not found",
+			Handler:                          HandlePostMatchCorner,
+			Request:                          newRequest(goodVars, `{"team": "1", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamNilFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if player is not found in the team",
+			Handler:                          HandlePostMatchFoul,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "2", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerThrowFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 with a minute out of the period",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 75}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 with a player sent off",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 35}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchPlayerSentOffForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 500 throwing error post event function",
+			Handler:                          HandlePostMatchShot,
+			Request:                          newRequest(goodVars, `{"team": "1", "player": "1", "minute": 12}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			HandlePostEventFunc:              mockPostEventThrowFunc,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: tc.HandleGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: tc.HandleGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			InsertFunc: tc.HandlePostEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		tc.Handler(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
+
+func TestNewStatisticValue(t *testing.T) {
+	teamMock := prototype.PrototypeTeam()
+	playerMock := prototype.PrototypePlayer()
+
+	testCases := []struct {
+		Name           string
+		Type           model.EventsMatchType
+		Player         *player.Player
+		ExpectedReturn event.Value
+	}{
+		{
+			Name:           "Shot value",
+			Type:           model.EventShot,
+			Player:         &playerMock,
+			ExpectedReturn: event.Shot{Team: teamMock, Player: playerMock, OnTarget: true, Period: model.PeriodFirstHalf, Minute: 10},
+		}, {
+			Name:           "Corner value",
+			Type:           model.EventCorner,
+			ExpectedReturn: event.Corner{Team: teamMock, Period: model.PeriodFirstHalf, Minute: 10},
+		}, {
+			Name:           "Foul value",
+			Type:           model.EventFoul,
+			Player:         &playerMock,
+			ExpectedReturn: event.Foul{Team: teamMock, Player: playerMock, Period: model.PeriodFirstHalf, Minute: 10},
+		}, {
+			Name:           "Offside value",
+			Type:           model.EventOffside,
+			Player:         &playerMock,
+			ExpectedReturn: event.Offside{Team: teamMock, Player: playerMock, Period: model.PeriodFirstHalf, Minute: 10},
+		}, {
+			Name:           "Save value",
+			Type:           model.EventSave,
+			Player:         &playerMock,
+			ExpectedReturn: event.Save{Team: teamMock, Player: playerMock, Period: model.PeriodFirstHalf, Minute: 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		value := newStatisticValue(tc.Type, teamMock, tc.Player, true, model.PeriodFirstHalf, 10, 0)
+		assert.Equal(t, tc.ExpectedReturn, value)
+	}
+}
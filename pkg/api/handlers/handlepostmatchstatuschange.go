@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/kafka"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostMatchPostpone(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchStatusChange(w, r, model.EventPostpone)
+}
+
+func HandlePostMatchSuspend(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchStatusChange(w, r, model.EventSuspend)
+}
+
+func HandlePostMatchResume(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchStatusChange(w, r, model.EventResume)
+}
+
+func HandlePostMatchAbandon(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchStatusChange(w, r, model.EventAbandon)
+}
+
+func HandlePostMatchCancel(w http.ResponseWriter, r *http.Request) {
+	handlePostMatchStatusChange(w, r, model.EventCancel)
+}
+
+func handlePostMatchStatusChange(w http.ResponseWriter, r *http.Request, t model.EventsMatchType) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	tournament, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if tournament == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	matchID := vars["match_id"]
+
+	if matchID == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	match, err := repo.GetMatchRepo().FindMatchForTournament(ctx, matchID, tournament.ID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if match == nil {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, t)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(t)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	payload := MatchStatusChangePayload{}
+	if t != model.EventResume {
+		payload, err = decodeMatchStatusChangeRequest(r)
+		if err != nil {
+			errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+			return
+		}
+	}
+
+	kickoff, err := convertStatusChangeKickoff(t, payload.Kickoff)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	now := time.Now().Format("15:04")
+
+	event := event.New(tournament.ID, match.ID, newStatusChangeValue(t, payload.Reason, kickoff, now))
+	event.ID = eventID
+
+	if !insertEvent(w, r, event) {
+		return
+	}
+
+	data := map[string]string{
+		"matchEventType": string(t),
+		"tournamentID":   tournament.ID,
+		"matchID":        match.ID,
+		"eventID":        event.ID,
+		"reason":         payload.Reason,
+		"time":           now,
+		"created":        event.Created.Format(time.RFC3339Nano),
+	}
+
+	if kickoff != nil {
+		data["kickoff"] = kickoff.Format(time.RFC3339)
+	}
+
+	go kafka.Notify(ctx, data, model.ActionGameEvents, fmt.Sprintf("Game Events - %s", t), model.KafkaTopicMatchEvents)
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func decodeMatchStatusChangeRequest(r *http.Request) (MatchStatusChangePayload, errs.AppError) {
+	payload := MatchStatusChangePayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	if payload.Reason == "" {
+		return payload, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "reason is required")
+	}
+
+	return payload, nil
+}
+
+// convertStatusChangeKickoff parses the new kickoff of a postponed match
+func convertStatusChangeKickoff(t model.EventsMatchType, kickoff string) (*time.Time, errs.AppError) {
+	if kickoff == "" {
+		return nil, nil
+	}
+
+	if t != model.EventPostpone {
+		return nil, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "kickoff is only allowed when postponing")
+	}
+
+	newKickoff, err_ := timeParse(time.RFC3339, kickoff)
+	if err_ != nil {
+		return nil, errs.ErrParsingTime.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+	}
+
+	newKickoff = newKickoff.UTC()
+	return &newKickoff, nil
+}
+
+func newStatusChangeValue(t model.EventsMatchType, reason string, kickoff *time.Time, now string) event.Value {
+	switch t {
+	case model.EventPostpone:
+		return event.Postpone{Reason: reason, Kickoff: kickoff}
+	case model.EventSuspend:
+		return event.Suspend{Reason: reason, TimeSuspended: now}
+	case model.EventResume:
+		return event.Resume{TimeResumed: now}
+	case model.EventAbandon:
+		return event.Abandon{Reason: reason, TimeAbandoned: now}
+	}
+	return event.Cancel{Reason: reason}
+}
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockFindMatchSuspendedForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusSuspended
+	matchMock.Period = model.PeriodFirstHalf
+	return &matchMock, nil
+}
+
+func TestHandlePostMatchStatusChange(t *testing.T) {
+	newRequest := func(vars map[string]string, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/postpone", nil)
+		req.Body = ioutil.NopCloser(bytes.NewReader([]byte(body)))
+		return mux.SetURLVars(req, vars)
+	}
+
+	goodVars := map[string]string{"id": "any", "match_id": "any"}
+
+	testCases := []struct {
+		Name                             string
+		Handler                          http.HandlerFunc
+		Request                          *http.Request
+		HandleGetTournamentFunc          func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleFindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
+		HandlePostEventFunc              func(ctx context.Context, e event.Event) errs.AppError
+		ExpectedStatusCode               int
+	}{
+		{
+			Name:                             "Should return 201 postponing a match to a new kickoff",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(goodVars, `{"reason": "Waterlogged pitch", "kickoff": "2022-02-08T19:00:00Z"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 postponing a match without a new kickoff",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(goodVars, `{"reason": "Waterlogged pitch"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 suspending a match in progress",
+			Handler:                          HandlePostMatchSuspend,
+			Request:                          newRequest(goodVars, `{"reason": "Floodlight failure"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 resuming a suspended match without a body",
+			Handler:                          HandlePostMatchResume,
+			Request:                          newRequest(goodVars, ""),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchSuspendedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 abandoning a suspended match",
+			Handler:                          HandlePostMatchAbandon,
+			Request:                          newRequest(goodVars, `{"reason": "Floodlight failure"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchSuspendedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 cancelling a not started match",
+			Handler:                          HandlePostMatchCancel,
+			Request:                          newRequest(goodVars, `{"reason": "Team withdrew"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 404 missing id param",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(map[string]string{"id": "", "match_id": "any"}, `{"reason": "Waterlogged pitch"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error get tournament function",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(goodVars, `{"reason": "Waterlogged pitch"}`),
+			HandleGetTournamentFunc:          mockGetTournamentThrowFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if tournament is not found",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(goodVars, `{"reason": "Waterlogged pitch"}`),
+			HandleGetTournamentFunc:          mockGetTournamentNilFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 404 missing match id param",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(map[string]string{"id": "any", "match_id": ""}, `{"reason": "Waterlogged pitch"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 500 throwing error find match to tournament function",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(goodVars, `{"reason": "Waterlogged pitch"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentThrowFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               500,
+		}, {
+			Name:                             "Should return 404 if match is not found",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(goodVars, `{"reason": "Waterlogged pitch"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentNilFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               404,
+		}, {
+			Name:                             "Should return 422 postponing a match in progress",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(goodVars, `{"reason": "Waterlogged pitch"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 resuming a match that is not suspended",
+			Handler:                          HandlePostMatchResume,
+			Request:                          newRequest(goodVars, ""),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 without a reason",
+			Handler:                          HandlePostMatchSuspend,
+			Request:                          newRequest(goodVars, `{}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStartedForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 without a body",
+			Handler:                          HandlePostMatchCancel,
+			Request:                          newRequest(goodVars, ""),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 with an invalid kickoff",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(goodVars, `{"reason": "Waterlogged pitch", "kickoff": "2022-02-08 19:00"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 with a kickoff when not postponing",
+			Handler:                          HandlePostMatchCancel,
+			Request:                          newRequest(goodVars, `{"reason": "Team withdrew", "kickoff": "2022-02-08T19:00:00Z"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 500 throwing error post event function",
+			Handler:                          HandlePostMatchPostpone,
+			Request:                          newRequest(goodVars, `{"reason": "Waterlogged pitch"}`),
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventThrowFunc,
+			ExpectedStatusCode:               500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.HandleFindMatchForTournamentFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			InsertFunc: tc.HandlePostEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		w := httptest.NewRecorder()
+
+		tc.Handler(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
+
+func TestConvertStatusChangeKickoff(t *testing.T) {
+	kickoff, err := convertStatusChangeKickoff(model.EventPostpone, "2022-02-08T16:00:00-03:00")
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2022, 2, 8, 19, 0, 0, 0, time.UTC), *kickoff)
+
+	kickoff, err = convertStatusChangeKickoff(model.EventPostpone, "")
+	assert.Nil(t, err)
+	assert.Nil(t, kickoff)
+
+	_, err = convertStatusChangeKickoff(model.EventSuspend, "2022-02-08T16:00:00-03:00")
+	assert.NotNil(t, err)
+}
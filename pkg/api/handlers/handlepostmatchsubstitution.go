@@ -64,8 +64,14 @@ func HandlePostMatchSubstitution(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if match.Status != model.MatchStatusInProgress && match.Status != model.MatchStatusHalftime {
-		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", "A sub cannot happen when the game is not in progress or in halftime"))
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, model.EventSubstitution)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventSubstitution)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
 		return
 	}
 
@@ -87,33 +93,45 @@ func HandlePostMatchSubstitution(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	minute := matchSubstitutionPayload.Minute
+	minute, stoppage := match.EventMinute(matchSubstitutionPayload.Minute, matchSubstitutionPayload.Stoppage, time.Now())
 	minuteAsString := strconv.Itoa(minute)
 
-	value := struct {
-		TeamScore          team.Team
-		PlayerOut          player.Player
-		PlayerIn           player.Player
-		SubstitutionMinute int
-		Created            time.Time
-	}{
-		TeamScore:          *teamSub,
-		PlayerOut:          *playerOut,
-		PlayerIn:           *playerIn,
-		SubstitutionMinute: minute,
-		Created:            time.Now(),
+	period, err := match.CheckMinute(minute, stoppage)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	event := event.New(tournament.ID, match.ID, event.Substitution{
+		Team:       *teamSub,
+		PlayerOut:  *playerOut,
+		PlayerIn:   *playerIn,
+		Period:     period,
+		Minute:     minute,
+		Stoppage:   stoppage,
+		Concussion: matchSubstitutionPayload.Concussion,
+	})
+	event.ID = eventID
+
+	err = match.CheckSentOff(event)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
 	}
 
-	event := event.Event{
-		TournamentID: tournament.ID,
-		MatchID:      match.ID,
-		Type:         model.EventSubstitution,
-		Value:        value,
+	err = match.CheckSubstitution(event)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
 	}
 
-	err = repo.GetEventRepo().Insert(ctx, event)
+	err = match.CheckSubstitutionRules(tournament.Substitutions, event)
 	if err != nil {
-		errs.HttpInternalServerError(w)
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if !insertEvent(w, r, event) {
 		return
 	}
 
@@ -121,10 +139,14 @@ func HandlePostMatchSubstitution(w http.ResponseWriter, r *http.Request) {
 		"matchEventType":     string(model.EventSubstitution),
 		"tournamentID":       tournament.ID,
 		"matchID":            match.ID,
+		"eventID":            event.ID,
 		"teamID":             teamSub.ID,
 		"playerOutID":        playerOut.ID,
 		"playerInID":         playerIn.ID,
+		"period":             string(period),
 		"substitutionMinute": minuteAsString,
+		"stoppage":           strconv.Itoa(stoppage),
+		"concussion":         strconv.FormatBool(matchSubstitutionPayload.Concussion),
 	}
 
 	go kafka.Notify(ctx, data, model.ActionGameEvents, "Game Events - Substitution Players", model.KafkaTopicMatchEvents)
@@ -15,6 +15,7 @@ import (
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
 	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 	"github.com/rafaelsanzio/go-flashscore/pkg/player"
 	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
@@ -38,11 +39,54 @@ func mockGetTeamPlayerSubsThrowFunc(ctx context.Context, id, teamID string) (*pl
 	return &playerMock, nil
 }
 
+func mockFindMatchWithLineupForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	starter := prototype.PrototypePlayer()
+	starter.ID = "3"
+	substitute := prototype.PrototypePlayer()
+	substitute.ID = "4"
+	matchMock.AddEvent(event.New(matchMock.Tournament.ID, matchMock.ID, event.Lineup{
+		Team:       prototype.PrototypeTeam(),
+		StartingXI: []player.Player{starter},
+		Bench:      []player.Player{substitute},
+		Captain:    starter,
+	}))
+	matchMock.Status = model.MatchStatusInProgress
+	return &matchMock, nil
+}
+
+func mockGetTournamentOneSubstitutionFunc(ctx context.Context, id string) (*tournament.Tournament, errs.AppError) {
+	tournamentMock := prototype.PrototypeTournament()
+	tournamentMock.Substitutions = tournament.SubstitutionRules{MaxSubstitutions: 1}
+	return &tournamentMock, nil
+}
+
+func mockFindMatchWithSubstitutionForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusInProgress
+	matchMock.AddEvent(event.New(matchMock.Tournament.ID, matchMock.ID, event.Substitution{
+		Team:      prototype.PrototypeTeam(),
+		PlayerOut: player.Player{ID: "3"},
+		PlayerIn:  player.Player{ID: "4"},
+		Minute:    20,
+	}))
+	return &matchMock, nil
+}
+
 func TestHandlePostMatchSubstitution(t *testing.T) {
 	body, err := json.Marshal(MatchSubstitutionPayload{
 		Team:      "1",
 		PlayerOut: "1",
 		PlayerIn:  "2",
+		Minute:    30,
+	})
+	assert.Equal(t, nil, err)
+
+	halftimeBody, err := json.Marshal(MatchSubstitutionPayload{
+		Team:      "1",
+		PlayerOut: "1",
+		PlayerIn:  "2",
+		Minute:    45,
 	})
 	assert.Equal(t, nil, err)
 
@@ -50,6 +94,26 @@ func TestHandlePostMatchSubstitution(t *testing.T) {
 	goodReq = mux.SetURLVars(goodReq, map[string]string{"id": "any", "match_id": "any"})
 	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
 
+	sentOffReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/substitution", nil)
+	sentOffReq = mux.SetURLVars(sentOffReq, map[string]string{"id": "any", "match_id": "any"})
+	sentOffReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	notOnPitchReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/substitution", nil)
+	notOnPitchReq = mux.SetURLVars(notOnPitchReq, map[string]string{"id": "any", "match_id": "any"})
+	notOnPitchReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	halftimeReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/substitution", nil)
+	halftimeReq = mux.SetURLVars(halftimeReq, map[string]string{"id": "any", "match_id": "any"})
+	halftimeReq.Body = ioutil.NopCloser(bytes.NewReader(halftimeBody))
+
+	halftimeMinuteReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/substitution", nil)
+	halftimeMinuteReq = mux.SetURLVars(halftimeMinuteReq, map[string]string{"id": "any", "match_id": "any"})
+	halftimeMinuteReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	limitReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/substitution", nil)
+	limitReq = mux.SetURLVars(limitReq, map[string]string{"id": "any", "match_id": "any"})
+	limitReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
 	noBodyReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/substitution", nil)
 	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{"id": "any", "match_id": "any"})
 
@@ -99,6 +163,51 @@ func TestHandlePostMatchSubstitution(t *testing.T) {
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerSubsFunc,
 			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 201 if the substitution is at halftime",
+			Request:                          halftimeReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusHalftimeForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerSubsFunc,
+			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 422 if the halftime substitution is not at the end of the period",
+			Request:                          halftimeMinuteReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusHalftimeForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerSubsFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the player is sent off",
+			Request:                          sentOffReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchPlayerSentOffForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerSubsFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the player out is not on the pitch",
+			Request:                          notOnPitchReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchWithLineupForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerSubsFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the team has no substitutions left",
+			Request:                          limitReq,
+			HandleGetTournamentFunc:          mockGetTournamentOneSubstitutionFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchWithSubstitutionForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerSubsFunc,
+			ExpectedStatusCode:               422,
 		}, {
 			Name:                             "Should return 422 if no body request",
 			Request:                          noBodyReq,
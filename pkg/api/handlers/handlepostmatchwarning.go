@@ -64,8 +64,14 @@ func HandlePostMatchWarning(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if match.Status != model.MatchStatusInProgress {
-		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", "A warning cannot happen when the game is not in progress"))
+	eventID, ok := handleIdempotencyKey(w, r, match.ID, model.EventWarning)
+	if !ok {
+		return
+	}
+
+	err = match.CanApply(model.EventWarning)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
 		return
 	}
 
@@ -87,33 +93,32 @@ func HandlePostMatchWarning(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	minute := matchWarningPayload.Minute
+	minute, stoppage := match.EventMinute(matchWarningPayload.Minute, matchWarningPayload.Stoppage, time.Now())
 	minuteAsString := strconv.Itoa(minute)
 
-	value := struct {
-		Team          team.Team
-		Player        player.Player
-		Warning       model.Warnings
-		WarningMinute int
-		Created       time.Time
-	}{
-		Team:          *teamWarn,
-		Player:        *playerWarn,
-		Warning:       matchWarningPayload.Warning,
-		WarningMinute: minute,
-		Created:       time.Now(),
+	period, err := match.CheckMinute(minute, stoppage)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
 	}
 
-	event := event.Event{
-		TournamentID: tournament.ID,
-		MatchID:      match.ID,
-		Type:         model.EventWarning,
-		Value:        value,
-	}
+	event := event.New(tournament.ID, match.ID, event.Warning{
+		Team:     *teamWarn,
+		Player:   *playerWarn,
+		Warning:  matchWarningPayload.Warning,
+		Period:   period,
+		Minute:   minute,
+		Stoppage: stoppage,
+	})
+	event.ID = eventID
 
-	err = repo.GetEventRepo().Insert(ctx, event)
+	err = match.CheckSentOff(event)
 	if err != nil {
-		errs.HttpInternalServerError(w)
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if !insertEvent(w, r, event) {
 		return
 	}
 
@@ -121,10 +126,13 @@ func HandlePostMatchWarning(w http.ResponseWriter, r *http.Request) {
 		"matchEventType": string(model.EventWarning),
 		"tournamentID":   tournament.ID,
 		"matchID":        match.ID,
+		"eventID":        event.ID,
 		"teamID":         teamWarn.ID,
 		"playerID":       playerWarn.ID,
 		"warning":        string(matchWarningPayload.Warning),
+		"period":         string(period),
 		"warningMinute":  minuteAsString,
+		"stoppage":       strconv.Itoa(stoppage),
 	}
 
 	go kafka.Notify(ctx, data, model.ActionGameEvents, "Game Events - Warning Player", model.KafkaTopicMatchEvents)
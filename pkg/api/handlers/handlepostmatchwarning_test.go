@@ -23,8 +23,28 @@ import (
 	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
 )
 
+func mockFindMatchPlayerSentOffForTournamentFunc(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusInProgress
+	matchMock.AddEvent(event.New(matchMock.Tournament.ID, matchMock.ID, event.Warning{
+		Team:    prototype.PrototypeTeam(),
+		Player:  prototype.PrototypePlayer(),
+		Warning: model.WarningRedCard,
+		Minute:  30,
+	}))
+	return &matchMock, nil
+}
+
 func TestHandlePostMatchWarning(t *testing.T) {
 	body, err := json.Marshal(MatchWarningPayload{
+		Team:    "1",
+		Player:  "1",
+		Warning: model.WarningYellowCard,
+		Minute:  35,
+	})
+	assert.Equal(t, nil, err)
+
+	outOfPeriodBody, err := json.Marshal(MatchWarningPayload{
 		Team:    "1",
 		Player:  "1",
 		Warning: model.WarningYellowCard,
@@ -32,10 +52,18 @@ func TestHandlePostMatchWarning(t *testing.T) {
 	})
 	assert.Equal(t, nil, err)
 
+	outOfPeriodReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/warning", nil)
+	outOfPeriodReq = mux.SetURLVars(outOfPeriodReq, map[string]string{"id": "any", "match_id": "any"})
+	outOfPeriodReq.Body = ioutil.NopCloser(bytes.NewReader(outOfPeriodBody))
+
 	goodReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/warning", nil)
 	goodReq = mux.SetURLVars(goodReq, map[string]string{"id": "any", "match_id": "any"})
 	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
 
+	sentOffReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/warning", nil)
+	sentOffReq = mux.SetURLVars(sentOffReq, map[string]string{"id": "any", "match_id": "any"})
+	sentOffReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
 	noBodyReq := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/warning", nil)
 	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{"id": "any", "match_id": "any"})
 
@@ -86,6 +114,24 @@ func TestHandlePostMatchWarning(t *testing.T) {
 			HandleGetTeamFunc:                mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:          mockGetTeamPlayerSubsFunc,
 			ExpectedStatusCode:               201,
+		}, {
+			Name:                             "Should return 422 if the minute is out of the current period",
+			Request:                          outOfPeriodReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchStatusInProgressForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerFunc,
+			ExpectedStatusCode:               422,
+		}, {
+			Name:                             "Should return 422 if the player is sent off",
+			Request:                          sentOffReq,
+			HandleGetTournamentFunc:          mockGetTournamentFunc,
+			HandleFindMatchForTournamentFunc: mockFindMatchPlayerSentOffForTournamentFunc,
+			HandlePostEventFunc:              mockPostEventFunc,
+			HandleGetTeamFunc:                mockGetTeamFunc,
+			HandleGetTeamPlayerFunc:          mockGetTeamPlayerSubsFunc,
+			ExpectedStatusCode:               422,
 		}, {
 			Name:                             "Should return 422 if no body request",
 			Request:                          noBodyReq,
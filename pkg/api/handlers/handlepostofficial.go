@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+)
+
+func HandlePostOfficial(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	officialPayload, err := decodeOfficialRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	official, err := convertPayloadToOfficialFunc(officialPayload)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	err = repo.GetOfficialRepo().Insert(ctx, official)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func decodeOfficialRequest(r *http.Request) (OfficialEntityPayload, errs.AppError) {
+	payload := OfficialEntityPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
+
+func convertPayloadToOfficial(o OfficialEntityPayload) (official.Official, errs.AppError) {
+	if o.Name == "" {
+		return official.Official{}, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "name is required")
+	}
+
+	if o.Role == "" {
+		return official.Official{}, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "role is required")
+	}
+
+	result := official.Official{
+		Name:    o.Name,
+		Country: o.Country,
+		Role:    o.Role,
+	}
+
+	return result, nil
+}
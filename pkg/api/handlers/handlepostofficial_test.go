@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+)
+
+func mockPostOfficialFunc(ctx context.Context, t official.Official) errs.AppError {
+	return nil
+}
+
+func mockPostOfficialThrowFunc(ctx context.Context, t official.Official) errs.AppError {
+	return errs.ErrRepoMockAction
+}
+
+func TestHandlePostOfficial(t *testing.T) {
+	body, err := json.Marshal(OfficialEntityPayload{
+		Name:    "Antonio Mateu Lahoz",
+		Country: "Spain",
+		Role:    model.OfficialReferee,
+	})
+	assert.Equal(t, nil, err)
+
+	goodReq := httptest.NewRequest(http.MethodPost, "/officials", nil)
+	goodReq = mux.SetURLVars(goodReq, map[string]string{})
+	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	noBodyReq := httptest.NewRequest(http.MethodPost, "/officials", nil)
+	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{})
+
+	throwReq := httptest.NewRequest(http.MethodPost, "/officials", nil)
+	throwReq = mux.SetURLVars(throwReq, map[string]string{})
+
+	throwReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	goodReq2 := httptest.NewRequest(http.MethodPost, "/officials", nil)
+	goodReq2 = mux.SetURLVars(goodReq2, map[string]string{})
+	goodReq2.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	testCases := []struct {
+		Name                  string
+		Request               *http.Request
+		HandlePostFunc        func(ctx context.Context, t official.Official) errs.AppError
+		ConvertingPayloadFunc func(t OfficialEntityPayload) (official.Official, errs.AppError)
+		ExpectedStatusCode    int
+	}{
+		{
+			Name:                  "Should return 201 if successful",
+			Request:               goodReq,
+			HandlePostFunc:        mockPostOfficialFunc,
+			ConvertingPayloadFunc: convertPayloadToOfficialFunc,
+			ExpectedStatusCode:    201,
+		}, {
+			Name:                  "Should return 422 bad request",
+			Request:               noBodyReq,
+			HandlePostFunc:        mockPostOfficialFunc,
+			ConvertingPayloadFunc: convertPayloadToOfficialFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 422 throwing error on converting payload func",
+			Request:               goodReq2,
+			HandlePostFunc:        mockPostOfficialFunc,
+			ConvertingPayloadFunc: fakeConvertPayloadToOfficialFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 500 throwing error on function",
+			Request:               throwReq,
+			HandlePostFunc:        mockPostOfficialThrowFunc,
+			ConvertingPayloadFunc: convertPayloadToOfficialFunc,
+			ExpectedStatusCode:    500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetOfficialRepo(repo.MockOfficialRepo{
+			InsertFunc: tc.HandlePostFunc,
+		})
+		defer repo.SetOfficialRepo(nil)
+
+		convertPayloadToOfficialFunc = tc.ConvertingPayloadFunc
+		defer restoreConvertPayloadToOfficialFunc(convertPayloadToOfficialFunc)
+
+		w := httptest.NewRecorder()
+
+		HandlePostOfficial(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
+
+func TestConvertPayloadToOfficial(t *testing.T) {
+	inPayload := OfficialEntityPayload{
+		Name:    "Antonio Mateu Lahoz",
+		Country: "Spain",
+		Role:    model.OfficialReferee,
+	}
+
+	expectedOfficial := official.Official{
+		ID:      "",
+		Name:    "Antonio Mateu Lahoz",
+		Country: "Spain",
+		Role:    model.OfficialReferee,
+	}
+
+	testCases := []struct {
+		Name             string
+		Payload          OfficialEntityPayload
+		ExpectedOfficial official.Official
+		ExpectError      bool
+		ExpectedError    string
+	}{
+		{
+			Name:             "Test Case: 1 - correct body, no error",
+			Payload:          inPayload,
+			ExpectedOfficial: expectedOfficial,
+			ExpectError:      false,
+		}, {
+			Name:          "Test Case: 2 - no name, error found",
+			Payload:       OfficialEntityPayload{Country: "Spain", Role: model.OfficialReferee},
+			ExpectError:   true,
+			ExpectedError: "name is required",
+		}, {
+			Name:          "Test Case: 3 - no role, error found",
+			Payload:       OfficialEntityPayload{Name: "Antonio Mateu Lahoz", Country: "Spain"},
+			ExpectError:   true,
+			ExpectedError: "role is required",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		official, err := convertPayloadToOfficial(tc.Payload)
+		if tc.ExpectError {
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.ExpectedError)
+		} else {
+			assert.Equal(t, tc.ExpectedOfficial, official)
+		}
+	}
+}
+
+func TestDecodeOfficialRequest(t *testing.T) {
+	body, err := json.Marshal(OfficialEntityPayload{
+		Name:    "Antonio Mateu Lahoz",
+		Country: "Spain",
+		Role:    model.OfficialReferee,
+	})
+	assert.Equal(t, nil, err)
+
+	goodReq := httptest.NewRequest(http.MethodPost, "/officials", nil)
+	goodReq = mux.SetURLVars(goodReq, map[string]string{})
+
+	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	noBodyReq := httptest.NewRequest(http.MethodPost, "/officials", nil)
+	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{})
+
+	testCases := []struct {
+		Name          string
+		Request       *http.Request
+		Payload       *OfficialEntityPayload
+		ExpectedError bool
+	}{
+		{
+			Name:    "Test Case: 1 - correct body, no error",
+			Request: goodReq, Payload: &OfficialEntityPayload{
+				Name:    "Antonio Mateu Lahoz",
+				Country: "Spain",
+				Role:    model.OfficialReferee,
+			}, ExpectedError: false,
+		},
+		{Name: "Test Case: 2 - no body, error found", Request: noBodyReq, Payload: nil, ExpectedError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		decodedPayload, err := decodeOfficialRequest(tc.Request)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.Equal(t, *tc.Payload, decodedPayload)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func HandlePostRebuild(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	tournamentID := query.Get("tournament")
+	matchID := query.Get("match")
+
+	if tournamentID == "" && matchID == "" {
+		err := errs.ErrRebuildNotScoped.Throwf(applog.Log, errs.ErrFmt, r.URL.RawQuery)
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	dryRun, err := decodeDryRun(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if tournamentID != "" {
+		tournament, err := repo.GetTournamentRepo().Get(ctx, tournamentID)
+		if err != nil {
+			errs.HttpInternalServerError(w)
+			return
+		}
+
+		if tournament == nil {
+			_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, tournamentID)
+			errs.HttpNotFound(w)
+			return
+		}
+	}
+
+	matches, err := match.FindMatches(ctx, repo.GetMatchRepo(), tournamentID, matchID)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if matchID != "" && len(matches) == 0 {
+		_ = errs.ErrMatchIsNotFound.Throwf(applog.Log, errs.ErrFmt, matchID)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	rebuilds, err := match.RebuildMatches(ctx, repo.GetMatchRepo(), repo.GetEventRepo(), matches, dryRun)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	data, err_ := jsonMarshal(rebuilds)
+	if err_ != nil {
+		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	_, err_ = write(w, data)
+	if err_ != nil {
+		_ = errs.ErrResponseWriter.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func decodeDryRun(r *http.Request) (bool, errs.AppError) {
+	v := r.URL.Query().Get("dry_run")
+	if v == "" {
+		return false, nil
+	}
+
+	dryRun, err_ := strconv.ParseBool(v)
+	if err_ != nil {
+		return false, errs.ErrParsingBool.Throwf(applog.Log, errs.ErrFmt, v)
+	}
+
+	return dryRun, nil
+}
@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func mockListTournamentMatchesFunc(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	return []match.Match{prototype.PrototypeMatch()}, nil
+}
+
+func mockGetMatchFunc(ctx context.Context, id string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	return &matchMock, nil
+}
+
+func mockGetMatchNilFunc(ctx context.Context, id string) (*match.Match, errs.AppError) {
+	return nil, nil
+}
+
+func mockUpdateMatchRebuildFunc(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
+	return &mt, nil
+}
+
+func mockUpdateMatchRebuildThrowFunc(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func TestHandlePostRebuild(t *testing.T) {
+	testCases := []struct {
+		Name                          string
+		Query                         string
+		HandleGetTournamentFunc       func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleGetMatchFunc            func(ctx context.Context, id string) (*match.Match, errs.AppError)
+		HandleListMatchFunc           func(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError)
+		HandleUpdateMatchFunc         func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
+		HandleListEventsFromMatchFunc func(ctx context.Context, matchID string) ([]event.Event, errs.AppError)
+		MarshalFunc                   func(v interface{}) ([]byte, error)
+		WriteFunc                     func(http.ResponseWriter, []byte) (int, error)
+		ExpectedUpdated               bool
+		ExpectedStatusCode            int
+	}{
+		{
+			Name:                          "Success handle rebuild the matches of a tournament",
+			Query:                         "?tournament=1",
+			HandleGetTournamentFunc:       mockGetTournamentFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     write,
+			ExpectedUpdated:               true,
+			ExpectedStatusCode:            200,
+		}, {
+			Name:                          "Success handle rebuild one match in dry run",
+			Query:                         "?tournament=1&match=1&dry_run=true",
+			HandleGetTournamentFunc:       mockGetTournamentFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildThrowFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     write,
+			ExpectedUpdated:               false,
+			ExpectedStatusCode:            200,
+		}, {
+			Name:                          "Rebuild without a tournament or a match",
+			HandleGetTournamentFunc:       mockGetTournamentFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     write,
+			ExpectedStatusCode:            422,
+		}, {
+			Name:                          "Invalid dry run param to handle rebuild",
+			Query:                         "?tournament=1&dry_run=maybe",
+			HandleGetTournamentFunc:       mockGetTournamentFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     write,
+			ExpectedStatusCode:            422,
+		}, {
+			Name:                          "Getting error on get tournament function",
+			Query:                         "?tournament=1",
+			HandleGetTournamentFunc:       mockGetTournamentThrowFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     write,
+			ExpectedStatusCode:            500,
+		}, {
+			Name:                          "Getting error on get tournament function returning nil",
+			Query:                         "?tournament=1",
+			HandleGetTournamentFunc:       mockGetTournamentNilFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     write,
+			ExpectedStatusCode:            404,
+		}, {
+			Name:                          "Getting error on get match function returning nil",
+			Query:                         "?match=1",
+			HandleGetTournamentFunc:       mockGetTournamentFunc,
+			HandleGetMatchFunc:            mockGetMatchNilFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     write,
+			ExpectedStatusCode:            404,
+		}, {
+			Name:                          "Getting error on list match function",
+			Query:                         "?tournament=1",
+			HandleGetTournamentFunc:       mockGetTournamentFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchThrowFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     write,
+			ExpectedStatusCode:            500,
+		}, {
+			Name:                          "Getting error on list events function",
+			Query:                         "?tournament=1",
+			HandleGetTournamentFunc:       mockGetTournamentFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchThrowFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     write,
+			ExpectedStatusCode:            500,
+		}, {
+			Name:                          "Getting error on update match function",
+			Query:                         "?tournament=1",
+			HandleGetTournamentFunc:       mockGetTournamentFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildThrowFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     write,
+			ExpectedStatusCode:            500,
+		}, {
+			Name:                          "Getting error on marshal function",
+			Query:                         "?tournament=1",
+			HandleGetTournamentFunc:       mockGetTournamentFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   fakeMarshal,
+			WriteFunc:                     write,
+			ExpectedStatusCode:            500,
+		}, {
+			Name:                          "Getting error on write function",
+			Query:                         "?tournament=1",
+			HandleGetTournamentFunc:       mockGetTournamentFunc,
+			HandleGetMatchFunc:            mockGetMatchFunc,
+			HandleListMatchFunc:           mockListTournamentMatchesFunc,
+			HandleUpdateMatchFunc:         mockUpdateMatchRebuildFunc,
+			HandleListEventsFromMatchFunc: mockListEventsFromMatchFunc,
+			MarshalFunc:                   jsonMarshal,
+			WriteFunc:                     fakeWrite,
+			ExpectedStatusCode:            500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			GetFunc:              tc.HandleGetMatchFunc,
+			ListByTournamentFunc: tc.HandleListMatchFunc,
+			UpdateFunc:           tc.HandleUpdateMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			ListEventsFromMatchFunc: tc.HandleListEventsFromMatchFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		req, err := http.NewRequest(http.MethodPost, "/admin/rebuild"+tc.Query, nil)
+		assert.NoError(t, err)
+		res := httptest.NewRecorder()
+
+		HandlePostRebuild(res, req)
+
+		assert.Equal(t, tc.ExpectedStatusCode, res.Code)
+		t.Logf("Response Body: %v", res.Body)
+
+		if res.Code == http.StatusOK {
+			rebuilds := []match.Rebuild{}
+			err = json.Unmarshal(res.Body.Bytes(), &rebuilds)
+			assert.NoError(t, err)
+
+			assert.Len(t, rebuilds, 1)
+			assert.NotEmpty(t, rebuilds[0].Discrepancies)
+			assert.Equal(t, tc.ExpectedUpdated, rebuilds[0].Updated)
+		}
+	}
+}
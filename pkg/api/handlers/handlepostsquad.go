@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func HandlePostSquad(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if id == "" {
+		_ = errs.ErrGettingParam.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	t, err := repo.GetTournamentRepo().Get(ctx, id)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	if t == nil {
+		_ = errs.ErrTournamentIsNotFound.Throwf(applog.Log, errs.ErrFmt, id)
+		errs.HttpNotFound(w)
+		return
+	}
+
+	squadPayload, err := decodeSquadRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	if !t.FindTeam(squadPayload.Team) {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("this team is not in this tournament: [%v]", squadPayload.Team))
+		return
+	}
+
+	if len(squadPayload.Players) == 0 {
+		errs.HttpUnprocessableEntity(w, "err: [Players array cannot be null]")
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, playerID := range squadPayload.Players {
+		if seen[playerID] {
+			err = errs.ErrLineupDuplicatedPlayer.Throwf(applog.Log, errs.ErrFmt, playerID)
+			errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+			return
+		}
+		seen[playerID] = true
+	}
+
+	_, err = getTeamPlayers(ctx, squadPayload.Team, squadPayload.Players)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	t.SetSquad(tournament.Squad{TeamID: squadPayload.Team, Players: squadPayload.Players})
+
+	t, err = repo.GetTournamentRepo().Update(ctx, *t)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	data, err_ := jsonMarshal(t)
+	if err_ != nil {
+		_ = errs.ErrMarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	_, err_ = write(w, data)
+	if err_ != nil {
+		_ = errs.ErrResponseWriter.Throwf(applog.Log, errs.ErrFmt, err_)
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func decodeSquadRequest(r *http.Request) (SquadPayload, errs.AppError) {
+	payload := SquadPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func TestHandlePostSquad(t *testing.T) {
+	newRequest := func(id string, payload interface{}) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/tournaments/:id/squads", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": id})
+		if payload != nil {
+			body, err := json.Marshal(payload)
+			assert.NoError(t, err)
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		return req
+	}
+
+	squad := SquadPayload{Team: "1", Players: []string{"1", "2"}}
+
+	testCases := []struct {
+		Name                       string
+		Request                    *http.Request
+		HandleGetTournamentFunc    func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		HandleUpdateTournamentFunc func(ctx context.Context, t tournament.Tournament) (*tournament.Tournament, errs.AppError)
+		HandleGetTeamPlayerFunc    func(ctx context.Context, id, teamID string) (*player.Player, errs.AppError)
+		MarshalFunc                func(v interface{}) ([]byte, error)
+		WriteFunc                  func(http.ResponseWriter, []byte) (int, error)
+		ExpectedStatusCode         int
+	}{
+		{
+			Name:                       "Should return 200 registering the squad",
+			Request:                    newRequest("1", squad),
+			HandleGetTournamentFunc:    mockGetSeasonFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         200,
+		}, {
+			Name:                       "Should return 404 if missing param",
+			Request:                    newRequest("", squad),
+			HandleGetTournamentFunc:    mockGetSeasonFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         404,
+		}, {
+			Name:                       "Should return 500 throwing error on get tournament",
+			Request:                    newRequest("1", squad),
+			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         500,
+		}, {
+			Name:                       "Should return 404 if the tournament is not found",
+			Request:                    newRequest("1", squad),
+			HandleGetTournamentFunc:    mockGetTournamentNilFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         404,
+		}, {
+			Name:                       "Should return 422 bad request",
+			Request:                    newRequest("1", nil),
+			HandleGetTournamentFunc:    mockGetSeasonFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         422,
+		}, {
+			Name:                       "Should return 422 if the team is not in the tournament",
+			Request:                    newRequest("1", SquadPayload{Team: "9", Players: []string{"1"}}),
+			HandleGetTournamentFunc:    mockGetSeasonFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         422,
+		}, {
+			Name:                       "Should return 422 without players",
+			Request:                    newRequest("1", SquadPayload{Team: "1"}),
+			HandleGetTournamentFunc:    mockGetSeasonFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         422,
+		}, {
+			Name:                       "Should return 422 with a repeated player",
+			Request:                    newRequest("1", SquadPayload{Team: "1", Players: []string{"1", "1"}}),
+			HandleGetTournamentFunc:    mockGetSeasonFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         422,
+		}, {
+			Name:                       "Should return 422 with a player out of the team",
+			Request:                    newRequest("1", squad),
+			HandleGetTournamentFunc:    mockGetSeasonFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerThrowFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         422,
+		}, {
+			Name:                       "Should return 500 throwing error on update tournament",
+			Request:                    newRequest("1", squad),
+			HandleGetTournamentFunc:    mockGetSeasonFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentThrowFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         500,
+		}, {
+			Name:                       "Should return 500 throwing error on marshal function",
+			Request:                    newRequest("1", squad),
+			HandleGetTournamentFunc:    mockGetSeasonFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                fakeMarshal,
+			WriteFunc:                  write,
+			ExpectedStatusCode:         500,
+		}, {
+			Name:                       "Should return 500 throwing error on write function",
+			Request:                    newRequest("1", squad),
+			HandleGetTournamentFunc:    mockGetSeasonFunc,
+			HandleUpdateTournamentFunc: mockUpdateTournamentFunc,
+			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
+			MarshalFunc:                jsonMarshal,
+			WriteFunc:                  fakeWrite,
+			ExpectedStatusCode:         500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc:    tc.HandleGetTournamentFunc,
+			UpdateFunc: tc.HandleUpdateTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: tc.HandleGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		jsonMarshal = tc.MarshalFunc
+		defer restoreMarshal(jsonMarshal)
+
+		write = tc.WriteFunc
+		defer restoreWrite(write)
+
+		w := httptest.NewRecorder()
+
+		HandlePostSquad(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+
+		if w.Code == http.StatusOK {
+			season := tournament.Tournament{}
+			err := json.Unmarshal(w.Body.Bytes(), &season)
+			assert.NoError(t, err)
+			assert.Equal(t, []tournament.Squad{{TeamID: "1", Players: []string{"1", "2"}}}, season.Squads)
+		}
+	}
+}
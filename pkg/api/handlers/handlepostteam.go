@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,7 +21,7 @@ func HandlePostTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	team, err := convertPayloadToTeamFunc(teamPayload)
+	team, err := convertPayloadToTeamFunc(ctx, teamPayload)
 	if err != nil {
 		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
 		return
@@ -47,7 +48,7 @@ func decodeTeamRequest(r *http.Request) (TeamEntityPayload, errs.AppError) {
 	return payload, nil
 }
 
-func convertPayloadToTeam(t TeamEntityPayload) (team.Team, errs.AppError) {
+func convertPayloadToTeam(ctx context.Context, t TeamEntityPayload) (team.Team, errs.AppError) {
 	result := team.Team{
 		Name:      t.Name,
 		ShortCode: t.ShortCode,
@@ -55,5 +56,13 @@ func convertPayloadToTeam(t TeamEntityPayload) (team.Team, errs.AppError) {
 		City:      t.City,
 	}
 
+	if t.Venue != "" {
+		venue, err := repo.GetVenueRepo().Get(ctx, t.Venue)
+		if err != nil || venue == nil {
+			return team.Team{}, errs.ErrVenueIsNotFound.Throwf(applog.Log, errs.ErrFmt, t.Venue)
+		}
+		result.Venue = venue
+	}
+
 	return result, nil
 }
@@ -14,7 +14,9 @@ import (
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
 	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
 )
 
 func mockPostTeamFunc(ctx context.Context, t team.Team) errs.AppError {
@@ -54,7 +56,7 @@ func TestHandlePostTeam(t *testing.T) {
 		Name                  string
 		Request               *http.Request
 		HandlePostFunc        func(ctx context.Context, t team.Team) errs.AppError
-		ConvertingPayloadFunc func(t TeamEntityPayload) (team.Team, errs.AppError)
+		ConvertingPayloadFunc func(ctx context.Context, t TeamEntityPayload) (team.Team, errs.AppError)
 		ExpectedStatusCode    int
 	}{
 		{
@@ -118,6 +120,13 @@ func TestConvertPayloadToTeam(t *testing.T) {
 		City:      "Madrid",
 	}
 
+	venuePayload := inPayload
+	venuePayload.Venue = "1"
+
+	homeVenue := prototype.PrototypeVenue()
+	venueTeam := expectedTeam
+	venueTeam.Venue = &homeVenue
+
 	testCases := []struct {
 		Name          string
 		Payload       TeamEntityPayload
@@ -130,16 +139,37 @@ func TestConvertPayloadToTeam(t *testing.T) {
 			Payload:      inPayload,
 			ExpectedTeam: expectedTeam,
 			ExpectError:  false,
+		}, {
+			Name:         "Test Case: 2 - home venue, no error",
+			Payload:      venuePayload,
+			ExpectedTeam: venueTeam,
+			ExpectError:  false,
+		}, {
+			Name:          "Test Case: 3 - home venue not found, error found",
+			Payload:       TeamEntityPayload{Name: "Real Madrid Club", Venue: "unknown"},
+			ExpectError:   true,
+			ExpectedError: "venue is not found",
 		},
 	}
 
+	repo.SetVenueRepo(repo.MockVenueRepo{
+		GetFunc: func(ctx context.Context, id string) (*venue.Venue, errs.AppError) {
+			if id != "1" {
+				return nil, nil
+			}
+			venueMock := prototype.PrototypeVenue()
+			return &venueMock, nil
+		},
+	})
+	defer repo.SetVenueRepo(nil)
+
 	for _, tc := range testCases {
 		t.Log(tc.Name)
 
-		team, err := convertPayloadToTeam(tc.Payload)
+		team, err := convertPayloadToTeam(context.Background(), tc.Payload)
 		if tc.ExpectError {
 			assert.NotNil(t, err)
-			assert.Equal(t, err.Error(), tc.ExpectedError)
+			assert.Contains(t, err.Error(), tc.ExpectedError)
 		} else {
 			assert.Equal(t, tc.ExpectedTeam, team)
 		}
@@ -28,8 +28,19 @@ func HandlePostTournament(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	err = checkSeasonIsNew(ctx, *tournament)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
 	err = repo.GetTournamentRepo().Insert(ctx, *tournament)
 	if err != nil {
+		if errs.ErrMongoDuplicateKey.Is(err) {
+			err = errs.ErrSeasonAlreadyExists.Throwf(applog.Log, errs.ErrFmt, tournament.Season)
+			errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+			return
+		}
 		errs.HttpInternalServerError(w)
 		return
 	}
@@ -50,6 +61,19 @@ func decodeTournamentRequest(r *http.Request) (TournamentEntityPayload, errs.App
 }
 
 func convertPayloadToTournament(ctx context.Context, t TournamentEntityPayload) (*tournament.Tournament, errs.AppError) {
+	if t.Competition != "" {
+		competition, err := repo.GetCompetitionRepo().Get(ctx, t.Competition)
+		if err != nil || competition == nil {
+			return nil, errs.ErrCompetitionIsNotFound.Throwf(applog.Log, errs.ErrFmt, t.Competition)
+		}
+
+		if t.Season == "" {
+			return nil, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "season is required")
+		}
+	} else if t.Season != "" {
+		return nil, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "competition is required")
+	}
+
 	var teams []team.Team
 	if len(t.Teams) > 0 {
 		for _, teamID := range t.Teams {
@@ -61,10 +85,56 @@ func convertPayloadToTournament(ctx context.Context, t TournamentEntityPayload)
 		}
 	}
 
+	rules := tournament.SubstitutionRules{
+		MaxSubstitutions:        t.Substitutions.MaxSubstitutions,
+		MaxWindows:              t.Substitutions.MaxWindows,
+		ExtraTimeSubstitutions:  t.Substitutions.ExtraTimeSubstitutions,
+		ExtraTimeWindows:        t.Substitutions.ExtraTimeWindows,
+		ConcussionSubstitutions: t.Substitutions.ConcussionSubstitutions,
+	}
+
+	if rules.MaxSubstitutions < 0 || rules.MaxWindows < 0 || rules.ExtraTimeSubstitutions < 0 || rules.ExtraTimeWindows < 0 || rules.ConcussionSubstitutions < 0 {
+		return nil, errs.ErrValidation.Throwf(applog.Log, "substitution rules cannot be negative: [%v]", t.Substitutions)
+	}
+
+	for i, tb := range t.TieBreakers {
+		for _, other := range t.TieBreakers[:i] {
+			if tb == other {
+				return nil, errs.ErrValidation.Throwf(applog.Log, "tie-breaker is repeated: [%v]", tb)
+			}
+		}
+	}
+
 	result := tournament.Tournament{
-		Name:  t.Name,
-		Teams: teams,
+		Name:          t.Name,
+		CompetitionID: t.Competition,
+		Season:        t.Season,
+		Teams:         teams,
+		Substitutions: rules,
+		TieBreakers:   t.TieBreakers,
 	}
 
 	return &result, nil
 }
+
+// checkSeasonIsNew rejects a tournament that repeats a season of its
+// competition, other than the tournament itself. A concurrent request can still
+// pass the check, the unique index of the seasons rejects it on write.
+func checkSeasonIsNew(ctx context.Context, t tournament.Tournament) errs.AppError {
+	if t.CompetitionID == "" {
+		return nil
+	}
+
+	seasons, err := repo.GetTournamentRepo().ListByCompetition(ctx, t.CompetitionID)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range seasons {
+		if other.ID != t.ID && other.Season == t.Season {
+			return errs.ErrSeasonAlreadyExists.Throwf(applog.Log, errs.ErrFmt, t.Season)
+		}
+	}
+
+	return nil
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
 	"github.com/rafaelsanzio/go-flashscore/pkg/team"
@@ -27,6 +29,10 @@ func mockPostTournamentThrowFunc(ctx context.Context, t tournament.Tournament) e
 	return errs.ErrRepoMockAction
 }
 
+func mockPostTournamentDuplicateFunc(ctx context.Context, t tournament.Tournament) errs.AppError {
+	return errs.ErrMongoDuplicateKey
+}
+
 func TestHandlePostTournament(t *testing.T) {
 	body, err := json.Marshal(TournamentEntityPayload{
 		Name:  "Any Tournament Name",
@@ -50,6 +56,32 @@ func TestHandlePostTournament(t *testing.T) {
 	goodReq2 = mux.SetURLVars(goodReq2, map[string]string{})
 	goodReq2.Body = ioutil.NopCloser(bytes.NewReader(body))
 
+	seasonBody, err := json.Marshal(TournamentEntityPayload{
+		Name:        "Premier League",
+		Competition: "1",
+		Season:      "2027/28",
+	})
+	assert.Equal(t, nil, err)
+
+	seasonReq := httptest.NewRequest(http.MethodPost, "/tournaments", nil)
+	seasonReq = mux.SetURLVars(seasonReq, map[string]string{})
+	seasonReq.Body = ioutil.NopCloser(bytes.NewReader(seasonBody))
+
+	raceSeasonReq := httptest.NewRequest(http.MethodPost, "/tournaments", nil)
+	raceSeasonReq = mux.SetURLVars(raceSeasonReq, map[string]string{})
+	raceSeasonReq.Body = ioutil.NopCloser(bytes.NewReader(seasonBody))
+
+	takenSeasonBody, err := json.Marshal(TournamentEntityPayload{
+		Name:        "Premier League",
+		Competition: "1",
+		Season:      "2026/27",
+	})
+	assert.Equal(t, nil, err)
+
+	takenSeasonReq := httptest.NewRequest(http.MethodPost, "/tournaments", nil)
+	takenSeasonReq = mux.SetURLVars(takenSeasonReq, map[string]string{})
+	takenSeasonReq.Body = ioutil.NopCloser(bytes.NewReader(takenSeasonBody))
+
 	testCases := []struct {
 		Name                     string
 		Request                  *http.Request
@@ -86,6 +118,27 @@ func TestHandlePostTournament(t *testing.T) {
 			HandleGetTeamFunc:        mockGetTeamFunc,
 			ConvertingPayloadFunc:    convertPayloadToTournamentFunc,
 			ExpectedStatusCode:       500,
+		}, {
+			Name:                     "Should return 201 creating a new season of a competition",
+			Request:                  seasonReq,
+			HandlePostTournamentFunc: mockPostTournamentFunc,
+			HandleGetTeamFunc:        mockGetTeamFunc,
+			ConvertingPayloadFunc:    convertPayloadToTournamentFunc,
+			ExpectedStatusCode:       201,
+		}, {
+			Name:                     "Should return 422 if the season of the competition already exists",
+			Request:                  takenSeasonReq,
+			HandlePostTournamentFunc: mockPostTournamentFunc,
+			HandleGetTeamFunc:        mockGetTeamFunc,
+			ConvertingPayloadFunc:    convertPayloadToTournamentFunc,
+			ExpectedStatusCode:       422,
+		}, {
+			Name:                     "Should return 422 if the season is created concurrently",
+			Request:                  raceSeasonReq,
+			HandlePostTournamentFunc: mockPostTournamentDuplicateFunc,
+			HandleGetTeamFunc:        mockGetTeamFunc,
+			ConvertingPayloadFunc:    convertPayloadToTournamentFunc,
+			ExpectedStatusCode:       422,
 		},
 	}
 
@@ -97,8 +150,14 @@ func TestHandlePostTournament(t *testing.T) {
 		})
 		defer repo.SetTeamRepo(nil)
 
+		repo.SetCompetitionRepo(repo.MockCompetitionRepo{
+			GetFunc: mockGetCompetitionFunc,
+		})
+		defer repo.SetCompetitionRepo(nil)
+
 		repo.SetTournamentRepo(repo.MockTournamentRepo{
-			InsertFunc: tc.HandlePostTournamentFunc,
+			InsertFunc:            tc.HandlePostTournamentFunc,
+			ListByCompetitionFunc: mockListCompetitionSeasonsFunc,
 		})
 		defer repo.SetTournamentRepo(nil)
 
@@ -124,13 +183,76 @@ func TestConvertPayloadToTournament(t *testing.T) {
 		Teams: []team.Team{prototype.PrototypeTeam(), prototype.PrototypeTeam()},
 	}
 
+	rulesPayload := TournamentEntityPayload{
+		Name:  "Any Tournament Name",
+		Teams: []string{"any_team_id"},
+		Substitutions: SubstitutionRulesPayload{
+			MaxSubstitutions:        5,
+			MaxWindows:              3,
+			ExtraTimeSubstitutions:  1,
+			ExtraTimeWindows:        1,
+			ConcussionSubstitutions: 2,
+		},
+	}
+
+	expectedRulesTeam := tournament.Tournament{
+		Name:  "Any Tournament Name",
+		Teams: []team.Team{prototype.PrototypeTeam()},
+		Substitutions: tournament.SubstitutionRules{
+			MaxSubstitutions:        5,
+			MaxWindows:              3,
+			ExtraTimeSubstitutions:  1,
+			ExtraTimeWindows:        1,
+			ConcussionSubstitutions: 2,
+		},
+	}
+
+	negativeRulesPayload := rulesPayload
+	negativeRulesPayload.Substitutions.MaxWindows = -1
+
+	tieBreakersPayload := TournamentEntityPayload{
+		Name:        "Any Tournament Name",
+		Teams:       []string{"any_team_id"},
+		TieBreakers: []model.TieBreaker{model.TieBreakerHeadToHead, model.TieBreakerGoalDifference},
+	}
+
+	expectedTieBreakersTeam := tournament.Tournament{
+		Name:        "Any Tournament Name",
+		Teams:       []team.Team{prototype.PrototypeTeam()},
+		TieBreakers: []model.TieBreaker{model.TieBreakerHeadToHead, model.TieBreakerGoalDifference},
+	}
+
+	repeatedTieBreakersPayload := tieBreakersPayload
+	repeatedTieBreakersPayload.TieBreakers = []model.TieBreaker{model.TieBreakerFairPlay, model.TieBreakerFairPlay}
+
+	seasonPayload := TournamentEntityPayload{
+		Name:        "Premier League",
+		Competition: "1",
+		Season:      "2026/27",
+		Teams:       []string{"any_team_id"},
+	}
+
+	expectedSeasonTeam := tournament.Tournament{
+		Name:          "Premier League",
+		CompetitionID: "1",
+		Season:        "2026/27",
+		Teams:         []team.Team{prototype.PrototypeTeam()},
+	}
+
+	noSeasonPayload := seasonPayload
+	noSeasonPayload.Season = ""
+
+	noCompetitionPayload := seasonPayload
+	noCompetitionPayload.Competition = ""
+
 	testCases := []struct {
-		Name              string
-		Payload           TournamentEntityPayload
-		HandleGetTeamFunc func(ctx context.Context, id string) (*team.Team, errs.AppError)
-		ExpectedTeam      tournament.Tournament
-		ExpectError       bool
-		ExpectedError     string
+		Name                     string
+		Payload                  TournamentEntityPayload
+		HandleGetTeamFunc        func(ctx context.Context, id string) (*team.Team, errs.AppError)
+		HandleGetCompetitionFunc func(ctx context.Context, id string) (*competition.Competition, errs.AppError)
+		ExpectedTeam             tournament.Tournament
+		ExpectError              bool
+		ExpectedError            string
 	}{
 		{
 			Name:              "Test Case: 1 - correct body, no error",
@@ -144,6 +266,52 @@ func TestConvertPayloadToTournament(t *testing.T) {
 			HandleGetTeamFunc: mockGetTeamThrowFunc,
 			ExpectedTeam:      expectedTeam,
 			ExpectError:       true,
+		}, {
+			Name:              "Test Case: 3 - substitution rules",
+			Payload:           rulesPayload,
+			HandleGetTeamFunc: mockGetTeamFunc,
+			ExpectedTeam:      expectedRulesTeam,
+			ExpectError:       false,
+		}, {
+			Name:              "Test Case: 4 - negative substitution rules",
+			Payload:           negativeRulesPayload,
+			HandleGetTeamFunc: mockGetTeamFunc,
+			ExpectError:       true,
+		}, {
+			Name:              "Test Case: 5 - tie-breakers",
+			Payload:           tieBreakersPayload,
+			HandleGetTeamFunc: mockGetTeamFunc,
+			ExpectedTeam:      expectedTieBreakersTeam,
+			ExpectError:       false,
+		}, {
+			Name:              "Test Case: 6 - repeated tie-breakers",
+			Payload:           repeatedTieBreakersPayload,
+			HandleGetTeamFunc: mockGetTeamFunc,
+			ExpectError:       true,
+		}, {
+			Name:                     "Test Case: 7 - season of a competition",
+			Payload:                  seasonPayload,
+			HandleGetTeamFunc:        mockGetTeamFunc,
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			ExpectedTeam:             expectedSeasonTeam,
+			ExpectError:              false,
+		}, {
+			Name:                     "Test Case: 8 - competition not found",
+			Payload:                  seasonPayload,
+			HandleGetTeamFunc:        mockGetTeamFunc,
+			HandleGetCompetitionFunc: mockGetCompetitionNilFunc,
+			ExpectError:              true,
+		}, {
+			Name:                     "Test Case: 9 - competition without a season",
+			Payload:                  noSeasonPayload,
+			HandleGetTeamFunc:        mockGetTeamFunc,
+			HandleGetCompetitionFunc: mockGetCompetitionFunc,
+			ExpectError:              true,
+		}, {
+			Name:              "Test Case: 10 - season without a competition",
+			Payload:           noCompetitionPayload,
+			HandleGetTeamFunc: mockGetTeamFunc,
+			ExpectError:       true,
 		},
 	}
 
@@ -155,6 +323,11 @@ func TestConvertPayloadToTournament(t *testing.T) {
 		})
 		defer repo.SetTeamRepo(nil)
 
+		repo.SetCompetitionRepo(repo.MockCompetitionRepo{
+			GetFunc: tc.HandleGetCompetitionFunc,
+		})
+		defer repo.SetCompetitionRepo(nil)
+
 		tournament, err := convertPayloadToTournamentFunc(context.Background(), tc.Payload)
 		if tc.ExpectError {
 			assert.NotNil(t, err)
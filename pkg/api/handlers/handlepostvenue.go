@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func HandlePostVenue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	venuePayload, err := decodeVenueRequest(r)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	venue, err := convertPayloadToVenueFunc(venuePayload)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	err = repo.GetVenueRepo().Insert(ctx, venue)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func decodeVenueRequest(r *http.Request) (VenueEntityPayload, errs.AppError) {
+	payload := VenueEntityPayload{}
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&payload)
+	if err != nil {
+		return payload, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return payload, nil
+}
+
+func convertPayloadToVenue(v VenueEntityPayload) (venue.Venue, errs.AppError) {
+	if v.Name == "" {
+		return venue.Venue{}, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "name is required")
+	}
+
+	if v.Capacity <= 0 {
+		return venue.Venue{}, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "capacity must be positive")
+	}
+
+	if v.TimeZone != "" {
+		_, err_ := time.LoadLocation(v.TimeZone)
+		if err_ != nil {
+			return venue.Venue{}, errs.ErrLoadingTimeZone.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+		}
+	}
+
+	if v.Latitude < -90 || v.Latitude > 90 || v.Longitude < -180 || v.Longitude > 180 {
+		return venue.Venue{}, errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "coordinates are out of range")
+	}
+
+	result := venue.Venue{
+		Name:     v.Name,
+		City:     v.City,
+		Country:  v.Country,
+		Capacity: v.Capacity,
+		TimeZone: v.TimeZone,
+		Coordinates: venue.Coordinates{
+			Latitude:  v.Latitude,
+			Longitude: v.Longitude,
+		},
+	}
+
+	return result, nil
+}
@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func mockPostVenueFunc(ctx context.Context, t venue.Venue) errs.AppError {
+	return nil
+}
+
+func mockPostVenueThrowFunc(ctx context.Context, t venue.Venue) errs.AppError {
+	return errs.ErrRepoMockAction
+}
+
+func TestHandlePostVenue(t *testing.T) {
+	body, err := json.Marshal(VenueEntityPayload{
+		Name:      "Santiago Bernabéu",
+		City:      "Madrid",
+		Country:   "Spain",
+		Capacity:  81044,
+		TimeZone:  "Europe/Madrid",
+		Latitude:  40.453054,
+		Longitude: -3.688344,
+	})
+	assert.Equal(t, nil, err)
+
+	goodReq := httptest.NewRequest(http.MethodPost, "/venues", nil)
+	goodReq = mux.SetURLVars(goodReq, map[string]string{})
+	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	noBodyReq := httptest.NewRequest(http.MethodPost, "/venues", nil)
+	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{})
+
+	throwReq := httptest.NewRequest(http.MethodPost, "/venues", nil)
+	throwReq = mux.SetURLVars(throwReq, map[string]string{})
+
+	throwReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	goodReq2 := httptest.NewRequest(http.MethodPost, "/venues", nil)
+	goodReq2 = mux.SetURLVars(goodReq2, map[string]string{})
+	goodReq2.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	testCases := []struct {
+		Name                  string
+		Request               *http.Request
+		HandlePostFunc        func(ctx context.Context, t venue.Venue) errs.AppError
+		ConvertingPayloadFunc func(t VenueEntityPayload) (venue.Venue, errs.AppError)
+		ExpectedStatusCode    int
+	}{
+		{
+			Name:                  "Should return 201 if successful",
+			Request:               goodReq,
+			HandlePostFunc:        mockPostVenueFunc,
+			ConvertingPayloadFunc: convertPayloadToVenueFunc,
+			ExpectedStatusCode:    201,
+		}, {
+			Name:                  "Should return 422 bad request",
+			Request:               noBodyReq,
+			HandlePostFunc:        mockPostVenueFunc,
+			ConvertingPayloadFunc: convertPayloadToVenueFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 422 throwing error on converting payload func",
+			Request:               goodReq2,
+			HandlePostFunc:        mockPostVenueFunc,
+			ConvertingPayloadFunc: fakeConvertPayloadToVenueFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 500 throwing error on function",
+			Request:               throwReq,
+			HandlePostFunc:        mockPostVenueThrowFunc,
+			ConvertingPayloadFunc: convertPayloadToVenueFunc,
+			ExpectedStatusCode:    500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetVenueRepo(repo.MockVenueRepo{
+			InsertFunc: tc.HandlePostFunc,
+		})
+		defer repo.SetVenueRepo(nil)
+
+		convertPayloadToVenueFunc = tc.ConvertingPayloadFunc
+		defer restoreConvertPayloadToVenueFunc(convertPayloadToVenueFunc)
+
+		w := httptest.NewRecorder()
+
+		HandlePostVenue(w, tc.Request)
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
+
+func TestConvertPayloadToVenue(t *testing.T) {
+	inPayload := VenueEntityPayload{
+		Name:      "Santiago Bernabéu",
+		City:      "Madrid",
+		Country:   "Spain",
+		Capacity:  81044,
+		TimeZone:  "Europe/Madrid",
+		Latitude:  40.453054,
+		Longitude: -3.688344,
+	}
+
+	expectedVenue := prototype.PrototypeVenue()
+	expectedVenue.ID = ""
+
+	testCases := []struct {
+		Name          string
+		Payload       VenueEntityPayload
+		ExpectedVenue venue.Venue
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name:          "Test Case: 1 - correct body, no error",
+			Payload:       inPayload,
+			ExpectedVenue: expectedVenue,
+			ExpectError:   false,
+		}, {
+			Name:          "Test Case: 2 - no name, error found",
+			Payload:       VenueEntityPayload{City: "Madrid", Capacity: 81044},
+			ExpectError:   true,
+			ExpectedError: "name is required",
+		}, {
+			Name:          "Test Case: 3 - no capacity, error found",
+			Payload:       VenueEntityPayload{Name: "Santiago Bernabéu", City: "Madrid"},
+			ExpectError:   true,
+			ExpectedError: "capacity must be positive",
+		}, {
+			Name:          "Test Case: 4 - invalid time zone, error found",
+			Payload:       VenueEntityPayload{Name: "Santiago Bernabéu", Capacity: 81044, TimeZone: "Europe/Nowhere"},
+			ExpectError:   true,
+			ExpectedError: "error loading timezone data",
+		}, {
+			Name:          "Test Case: 5 - coordinates out of range, error found",
+			Payload:       VenueEntityPayload{Name: "Santiago Bernabéu", Capacity: 81044, Latitude: 91},
+			ExpectError:   true,
+			ExpectedError: "coordinates are out of range",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		venue, err := convertPayloadToVenue(tc.Payload)
+		if tc.ExpectError {
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.ExpectedError)
+		} else {
+			assert.Equal(t, tc.ExpectedVenue, venue)
+		}
+	}
+}
+
+func TestDecodeVenueRequest(t *testing.T) {
+	body, err := json.Marshal(VenueEntityPayload{
+		Name:      "Santiago Bernabéu",
+		City:      "Madrid",
+		Country:   "Spain",
+		Capacity:  81044,
+		TimeZone:  "Europe/Madrid",
+		Latitude:  40.453054,
+		Longitude: -3.688344,
+	})
+	assert.Equal(t, nil, err)
+
+	goodReq := httptest.NewRequest(http.MethodPost, "/venues", nil)
+	goodReq = mux.SetURLVars(goodReq, map[string]string{})
+
+	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	noBodyReq := httptest.NewRequest(http.MethodPost, "/venues", nil)
+	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{})
+
+	testCases := []struct {
+		Name          string
+		Request       *http.Request
+		Payload       *VenueEntityPayload
+		ExpectedError bool
+	}{
+		{
+			Name:    "Test Case: 1 - correct body, no error",
+			Request: goodReq, Payload: &VenueEntityPayload{
+				Name:      "Santiago Bernabéu",
+				City:      "Madrid",
+				Country:   "Spain",
+				Capacity:  81044,
+				TimeZone:  "Europe/Madrid",
+				Latitude:  40.453054,
+				Longitude: -3.688344,
+			}, ExpectedError: false,
+		},
+		{Name: "Test Case: 2 - no body, error found", Request: noBodyReq, Payload: nil, ExpectedError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		decodedPayload, err := decodeVenueRequest(tc.Request)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.Equal(t, *tc.Payload, decodedPayload)
+		}
+	}
+}
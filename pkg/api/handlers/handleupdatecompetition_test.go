@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func mockUpdateCompetitionFunc(ctx context.Context, t competition.Competition) (*competition.Competition, errs.AppError) {
+	return &t, nil
+}
+
+func mockUpdateCompetitionThrowFunc(ctx context.Context, t competition.Competition) (*competition.Competition, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func TestHandleUpdateCompetition(t *testing.T) {
+	body, err := json.Marshal(CompetitionEntityPayload{
+		Name:    "Premier League",
+		Country: "England",
+	})
+	assert.Equal(t, nil, err)
+
+	goodReq := httptest.NewRequest(http.MethodPut, "/competitions/:id", nil)
+	goodReq = mux.SetURLVars(goodReq, map[string]string{"id": "any_id"})
+	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	noBodyReq := httptest.NewRequest(http.MethodPut, "/competitions/:id", nil)
+	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{"id": "any_id"})
+
+	throwReq := httptest.NewRequest(http.MethodPut, "/competitions/:id", nil)
+	throwReq = mux.SetURLVars(throwReq, map[string]string{"id": "any_id"})
+	throwReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	goodReq2 := httptest.NewRequest(http.MethodPut, "/competitions/:id", nil)
+	goodReq2 = mux.SetURLVars(goodReq2, map[string]string{"id": "any_id"})
+	goodReq2.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	missParamReq := httptest.NewRequest(http.MethodPut, "/competitions/:id", nil)
+	missParamReq = mux.SetURLVars(missParamReq, map[string]string{})
+	missParamReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	testCases := []struct {
+		Name                  string
+		Request               *http.Request
+		HandleUpdateFunction  func(ctx context.Context, t competition.Competition) (*competition.Competition, errs.AppError)
+		ConvertingPayloadFunc func(t CompetitionEntityPayload) (competition.Competition, errs.AppError)
+		ExpectedStatusCode    int
+	}{
+		{
+			Name:                  "Should return 200 if successful",
+			Request:               goodReq,
+			HandleUpdateFunction:  mockUpdateCompetitionFunc,
+			ConvertingPayloadFunc: convertPayloadToCompetitionFunc,
+			ExpectedStatusCode:    200,
+		}, {
+			Name:                  "Throwing error on function",
+			Request:               throwReq,
+			HandleUpdateFunction:  mockUpdateCompetitionThrowFunc,
+			ConvertingPayloadFunc: convertPayloadToCompetitionFunc,
+			ExpectedStatusCode:    500,
+		}, {
+			Name:                  "Should return 422 bad request",
+			Request:               noBodyReq,
+			HandleUpdateFunction:  mockUpdateCompetitionFunc,
+			ConvertingPayloadFunc: convertPayloadToCompetitionFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 500 throwing error on convertPayloadToCompetition function",
+			Request:               goodReq2,
+			HandleUpdateFunction:  mockUpdateCompetitionFunc,
+			ConvertingPayloadFunc: fakeConvertPayloadToCompetitionFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 404 is missing param",
+			Request:               missParamReq,
+			HandleUpdateFunction:  mockUpdateCompetitionFunc,
+			ConvertingPayloadFunc: convertPayloadToCompetitionFunc,
+			ExpectedStatusCode:    404,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetCompetitionRepo(repo.MockCompetitionRepo{
+			UpdateFunc: tc.HandleUpdateFunction,
+		})
+		defer repo.SetCompetitionRepo(nil)
+
+		convertPayloadToCompetitionFunc = tc.ConvertingPayloadFunc
+		defer restoreConvertPayloadToCompetitionFunc(convertPayloadToCompetitionFunc)
+
+		w := httptest.NewRecorder()
+
+		HandleUpdateCompetition(w, tc.Request)
+
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+)
+
+func mockUpdateOfficialFunc(ctx context.Context, t official.Official) (*official.Official, errs.AppError) {
+	return &t, nil
+}
+
+func mockUpdateOfficialThrowFunc(ctx context.Context, t official.Official) (*official.Official, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func TestHandleUpdateOfficial(t *testing.T) {
+	body, err := json.Marshal(OfficialEntityPayload{
+		Name:    "Antonio Mateu Lahoz",
+		Country: "Spain",
+		Role:    model.OfficialReferee,
+	})
+	assert.Equal(t, nil, err)
+
+	goodReq := httptest.NewRequest(http.MethodPut, "/officials/:id", nil)
+	goodReq = mux.SetURLVars(goodReq, map[string]string{"id": "any_id"})
+	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	noBodyReq := httptest.NewRequest(http.MethodPut, "/officials/:id", nil)
+	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{"id": "any_id"})
+
+	throwReq := httptest.NewRequest(http.MethodPut, "/officials/:id", nil)
+	throwReq = mux.SetURLVars(throwReq, map[string]string{"id": "any_id"})
+	throwReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	goodReq2 := httptest.NewRequest(http.MethodPut, "/officials/:id", nil)
+	goodReq2 = mux.SetURLVars(goodReq2, map[string]string{"id": "any_id"})
+	goodReq2.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	missParamReq := httptest.NewRequest(http.MethodPut, "/officials/:id", nil)
+	missParamReq = mux.SetURLVars(missParamReq, map[string]string{})
+	missParamReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	testCases := []struct {
+		Name                  string
+		Request               *http.Request
+		HandleUpdateFunction  func(ctx context.Context, t official.Official) (*official.Official, errs.AppError)
+		ConvertingPayloadFunc func(t OfficialEntityPayload) (official.Official, errs.AppError)
+		ExpectedStatusCode    int
+	}{
+		{
+			Name:                  "Should return 200 if successful",
+			Request:               goodReq,
+			HandleUpdateFunction:  mockUpdateOfficialFunc,
+			ConvertingPayloadFunc: convertPayloadToOfficialFunc,
+			ExpectedStatusCode:    200,
+		}, {
+			Name:                  "Throwing error on function",
+			Request:               throwReq,
+			HandleUpdateFunction:  mockUpdateOfficialThrowFunc,
+			ConvertingPayloadFunc: convertPayloadToOfficialFunc,
+			ExpectedStatusCode:    500,
+		}, {
+			Name:                  "Should return 422 bad request",
+			Request:               noBodyReq,
+			HandleUpdateFunction:  mockUpdateOfficialFunc,
+			ConvertingPayloadFunc: convertPayloadToOfficialFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 500 throwing error on convertPayloadToOfficial function",
+			Request:               goodReq2,
+			HandleUpdateFunction:  mockUpdateOfficialFunc,
+			ConvertingPayloadFunc: fakeConvertPayloadToOfficialFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 404 is missing param",
+			Request:               missParamReq,
+			HandleUpdateFunction:  mockUpdateOfficialFunc,
+			ConvertingPayloadFunc: convertPayloadToOfficialFunc,
+			ExpectedStatusCode:    404,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetOfficialRepo(repo.MockOfficialRepo{
+			UpdateFunc: tc.HandleUpdateFunction,
+		})
+		defer repo.SetOfficialRepo(nil)
+
+		convertPayloadToOfficialFunc = tc.ConvertingPayloadFunc
+		defer restoreConvertPayloadToOfficialFunc(convertPayloadToOfficialFunc)
+
+		w := httptest.NewRecorder()
+
+		HandleUpdateOfficial(w, tc.Request)
+
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
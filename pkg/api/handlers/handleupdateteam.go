@@ -27,7 +27,7 @@ func HandleUpdateTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	team, err := convertPayloadToTeamFunc(teamPayload)
+	team, err := convertPayloadToTeamFunc(ctx, teamPayload)
 	if err != nil {
 		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
 		return
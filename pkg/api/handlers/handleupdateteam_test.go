@@ -57,7 +57,7 @@ func TestHandleUpdateTeam(t *testing.T) {
 		Name                  string
 		Request               *http.Request
 		HandleUpdateFunction  func(ctx context.Context, t team.Team) (*team.Team, errs.AppError)
-		ConvertingPayloadFunc func(t TeamEntityPayload) (team.Team, errs.AppError)
+		ConvertingPayloadFunc func(ctx context.Context, t TeamEntityPayload) (team.Team, errs.AppError)
 		ExpectedStatusCode    int
 	}{
 		{
@@ -34,8 +34,19 @@ func HandleUpdateTournament(w http.ResponseWriter, r *http.Request) {
 	}
 
 	tournament.ID = id
+	err = checkSeasonIsNew(ctx, *tournament)
+	if err != nil {
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
 	_, err = repo.GetTournamentRepo().Update(ctx, *tournament)
 	if err != nil {
+		if errs.ErrMongoDuplicateKey.Is(err) {
+			err = errs.ErrSeasonAlreadyExists.Throwf(applog.Log, errs.ErrFmt, tournament.Season)
+			errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+			return
+		}
 		errs.HttpInternalServerError(w)
 		return
 	}
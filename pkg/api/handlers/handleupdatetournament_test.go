@@ -26,6 +26,10 @@ func mockUpdateTournamentThrowFunc(ctx context.Context, t tournament.Tournament)
 	return nil, errs.ErrRepoMockAction
 }
 
+func mockUpdateTournamentDuplicateFunc(ctx context.Context, t tournament.Tournament) (*tournament.Tournament, errs.AppError) {
+	return nil, errs.ErrMongoDuplicateKey
+}
+
 func TestHandleUpdateTournament(t *testing.T) {
 	body, err := json.Marshal(TournamentEntityPayload{
 		Name:  "Any Tournament Name",
@@ -48,6 +52,10 @@ func TestHandleUpdateTournament(t *testing.T) {
 	goodReq2 = mux.SetURLVars(goodReq2, map[string]string{"id": "any_id"})
 	goodReq2.Body = ioutil.NopCloser(bytes.NewReader(body))
 
+	duplicateReq := httptest.NewRequest(http.MethodPut, "/tournaments/:id", nil)
+	duplicateReq = mux.SetURLVars(duplicateReq, map[string]string{"id": "any_id"})
+	duplicateReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
 	missParamReq := httptest.NewRequest(http.MethodPut, "/tournaments/:id", nil)
 	missParamReq = mux.SetURLVars(missParamReq, map[string]string{})
 	missParamReq.Body = ioutil.NopCloser(bytes.NewReader(body))
@@ -95,6 +103,13 @@ func TestHandleUpdateTournament(t *testing.T) {
 			HandleGetTeamFunc:              mockGetTeamFunc,
 			ConvertingPayloadFunc:          convertPayloadToTournamentFunc,
 			ExpectedStatusCode:             404,
+		}, {
+			Name:                           "Should return 422 if the season is taken concurrently",
+			Request:                        duplicateReq,
+			HandleUpdateTournamentFunction: mockUpdateTournamentDuplicateFunc,
+			HandleGetTeamFunc:              mockGetTeamFunc,
+			ConvertingPayloadFunc:          convertPayloadToTournamentFunc,
+			ExpectedStatusCode:             422,
 		},
 	}
 
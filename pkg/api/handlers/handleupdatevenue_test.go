@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func mockUpdateVenueFunc(ctx context.Context, t venue.Venue) (*venue.Venue, errs.AppError) {
+	return &t, nil
+}
+
+func mockUpdateVenueThrowFunc(ctx context.Context, t venue.Venue) (*venue.Venue, errs.AppError) {
+	return nil, errs.ErrRepoMockAction
+}
+
+func TestHandleUpdateVenue(t *testing.T) {
+	body, err := json.Marshal(VenueEntityPayload{
+		Name:      "Santiago Bernabéu",
+		City:      "Madrid",
+		Country:   "Spain",
+		Capacity:  81044,
+		TimeZone:  "Europe/Madrid",
+		Latitude:  40.453054,
+		Longitude: -3.688344,
+	})
+	assert.Equal(t, nil, err)
+
+	goodReq := httptest.NewRequest(http.MethodPut, "/venues/:id", nil)
+	goodReq = mux.SetURLVars(goodReq, map[string]string{"id": "any_id"})
+	goodReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	noBodyReq := httptest.NewRequest(http.MethodPut, "/venues/:id", nil)
+	noBodyReq = mux.SetURLVars(noBodyReq, map[string]string{"id": "any_id"})
+
+	throwReq := httptest.NewRequest(http.MethodPut, "/venues/:id", nil)
+	throwReq = mux.SetURLVars(throwReq, map[string]string{"id": "any_id"})
+	throwReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	goodReq2 := httptest.NewRequest(http.MethodPut, "/venues/:id", nil)
+	goodReq2 = mux.SetURLVars(goodReq2, map[string]string{"id": "any_id"})
+	goodReq2.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	missParamReq := httptest.NewRequest(http.MethodPut, "/venues/:id", nil)
+	missParamReq = mux.SetURLVars(missParamReq, map[string]string{})
+	missParamReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	testCases := []struct {
+		Name                  string
+		Request               *http.Request
+		HandleUpdateFunction  func(ctx context.Context, t venue.Venue) (*venue.Venue, errs.AppError)
+		ConvertingPayloadFunc func(t VenueEntityPayload) (venue.Venue, errs.AppError)
+		ExpectedStatusCode    int
+	}{
+		{
+			Name:                  "Should return 200 if successful",
+			Request:               goodReq,
+			HandleUpdateFunction:  mockUpdateVenueFunc,
+			ConvertingPayloadFunc: convertPayloadToVenueFunc,
+			ExpectedStatusCode:    200,
+		}, {
+			Name:                  "Throwing error on function",
+			Request:               throwReq,
+			HandleUpdateFunction:  mockUpdateVenueThrowFunc,
+			ConvertingPayloadFunc: convertPayloadToVenueFunc,
+			ExpectedStatusCode:    500,
+		}, {
+			Name:                  "Should return 422 bad request",
+			Request:               noBodyReq,
+			HandleUpdateFunction:  mockUpdateVenueFunc,
+			ConvertingPayloadFunc: convertPayloadToVenueFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 500 throwing error on convertPayloadToVenue function",
+			Request:               goodReq2,
+			HandleUpdateFunction:  mockUpdateVenueFunc,
+			ConvertingPayloadFunc: fakeConvertPayloadToVenueFunc,
+			ExpectedStatusCode:    422,
+		}, {
+			Name:                  "Should return 404 is missing param",
+			Request:               missParamReq,
+			HandleUpdateFunction:  mockUpdateVenueFunc,
+			ConvertingPayloadFunc: convertPayloadToVenueFunc,
+			ExpectedStatusCode:    404,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetVenueRepo(repo.MockVenueRepo{
+			UpdateFunc: tc.HandleUpdateFunction,
+		})
+		defer repo.SetVenueRepo(nil)
+
+		convertPayloadToVenueFunc = tc.ConvertingPayloadFunc
+		defer restoreConvertPayloadToVenueFunc(convertPayloadToVenueFunc)
+
+		w := httptest.NewRecorder()
+
+		HandleUpdateVenue(w, tc.Request)
+
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+	}
+}
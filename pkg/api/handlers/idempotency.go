@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 64
+)
+
+// handleIdempotencyKey returns the id of the event being posted, the client
+// Idempotency-Key when sent or a new id. A key already stored for an event of
+// the same type and match is a retry, it is answered as created again and
+// nothing else is done, ok is false whenever the response was already written.
+func handleIdempotencyKey(w http.ResponseWriter, r *http.Request, matchID string, eventType model.EventsMatchType) (eventID string, ok bool) {
+	key := strings.TrimSpace(r.Header.Get(IdempotencyKeyHeader))
+	if key == "" {
+		return event.NewID(), true
+	}
+
+	if len(key) > maxIdempotencyKeyLength {
+		err := errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, fmt.Sprintf("%s is over %d characters", IdempotencyKeyHeader, maxIdempotencyKeyLength))
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return "", false
+	}
+
+	stored, err := repo.GetEventRepo().Get(r.Context(), key)
+	if err != nil {
+		errs.HttpInternalServerError(w)
+		return "", false
+	}
+
+	if stored == nil {
+		return key, true
+	}
+
+	replayEvent(w, *stored, matchID, eventType)
+	return "", false
+}
+
+// insertEvent stores the event being posted. A retry with the same
+// Idempotency-Key may store it first, the duplicate key is then answered like
+// any other retry. ok is false whenever the response was already written.
+func insertEvent(w http.ResponseWriter, r *http.Request, e event.Event) (ok bool) {
+	err := repo.GetEventRepo().Insert(r.Context(), e)
+	if err == nil {
+		return true
+	}
+
+	if !errs.ErrMongoDuplicateKey.Is(err) {
+		errs.HttpInternalServerError(w)
+		return false
+	}
+
+	stored, err := repo.GetEventRepo().Get(r.Context(), e.ID)
+	if err != nil || stored == nil {
+		errs.HttpInternalServerError(w)
+		return false
+	}
+
+	replayEvent(w, *stored, e.MatchID, e.Type)
+	return false
+}
+
+// replayEvent answers a retry of the stored event, the key of an event of
+// another match or of another type is a conflict.
+func replayEvent(w http.ResponseWriter, stored event.Event, matchID string, eventType model.EventsMatchType) {
+	if stored.MatchID != matchID || stored.Type != eventType {
+		err := errs.ErrEventIDConflict.Throwf(applog.Log, errs.ErrFmt, stored.ID)
+		errs.HttpUnprocessableEntity(w, fmt.Sprintf("err: [%v]", err.Error()))
+		return
+	}
+
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(http.StatusCreated)
+}
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
+)
+
+func mockGetEventStoredFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	return &event.Event{ID: id, MatchID: "1", Type: model.EventGoal}, nil
+}
+
+func mockGetEventStoredWarningFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	return &event.Event{ID: id, MatchID: "1", Type: model.EventWarning}, nil
+}
+
+func mockPostEventDuplicateFunc(ctx context.Context, e event.Event) errs.AppError {
+	return errs.ErrMongoDuplicateKey
+}
+
+func TestHandleIdempotencyKey(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Key                string
+		MatchID            string
+		HandleGetEventFunc func(ctx context.Context, id string) (*event.Event, errs.AppError)
+		ExpectedOk         bool
+		ExpectedEventID    string
+		ExpectedStatusCode int
+		ExpectedReplayed   bool
+	}{
+		{
+			Name:               "Should generate an event id without a key",
+			MatchID:            "1",
+			HandleGetEventFunc: mockGetEventThrowFunc,
+			ExpectedOk:         true,
+		}, {
+			Name:               "Should use a new key as the event id",
+			Key:                "goal-1",
+			MatchID:            "1",
+			HandleGetEventFunc: mockGetEventNilFunc,
+			ExpectedOk:         true,
+			ExpectedEventID:    "goal-1",
+		}, {
+			Name:               "Should answer a retry as created",
+			Key:                "goal-1",
+			MatchID:            "1",
+			HandleGetEventFunc: mockGetEventStoredFunc,
+			ExpectedStatusCode: 201,
+			ExpectedReplayed:   true,
+		}, {
+			Name:               "Should return 422 with a key used by another match",
+			Key:                "goal-1",
+			MatchID:            "2",
+			HandleGetEventFunc: mockGetEventStoredFunc,
+			ExpectedStatusCode: 422,
+		}, {
+			Name:               "Should return 422 with a key used by another event type",
+			Key:                "goal-1",
+			MatchID:            "1",
+			HandleGetEventFunc: mockGetEventStoredWarningFunc,
+			ExpectedStatusCode: 422,
+		}, {
+			Name:               "Should return 422 with a key too long",
+			Key:                strings.Repeat("k", maxIdempotencyKeyLength+1),
+			MatchID:            "1",
+			HandleGetEventFunc: mockGetEventNilFunc,
+			ExpectedStatusCode: 422,
+		}, {
+			Name:               "Should return 500 throwing error get event function",
+			Key:                "goal-1",
+			MatchID:            "1",
+			HandleGetEventFunc: mockGetEventThrowFunc,
+			ExpectedStatusCode: 500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			GetFunc: tc.HandleGetEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/goal", nil)
+		req.Header.Set(IdempotencyKeyHeader, tc.Key)
+		w := httptest.NewRecorder()
+
+		eventID, ok := handleIdempotencyKey(w, req, tc.MatchID, model.EventGoal)
+		assert.Equal(t, tc.ExpectedOk, ok)
+
+		if ok {
+			assert.NotEmpty(t, eventID)
+			if tc.ExpectedEventID != "" {
+				assert.Equal(t, tc.ExpectedEventID, eventID)
+			}
+			continue
+		}
+
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+		assert.Equal(t, tc.ExpectedReplayed, w.Header().Get(IdempotentReplayedHeader) == "true")
+	}
+}
+
+func TestInsertEvent(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		MatchID             string
+		HandlePostEventFunc func(ctx context.Context, e event.Event) errs.AppError
+		HandleGetEventFunc  func(ctx context.Context, id string) (*event.Event, errs.AppError)
+		ExpectedOk          bool
+		ExpectedStatusCode  int
+		ExpectedReplayed    bool
+	}{
+		{
+			Name:                "Should insert the event",
+			MatchID:             "1",
+			HandlePostEventFunc: mockPostEventFunc,
+			HandleGetEventFunc:  mockGetEventThrowFunc,
+			ExpectedOk:          true,
+		}, {
+			Name:                "Should answer a retry stored first as created",
+			MatchID:             "1",
+			HandlePostEventFunc: mockPostEventDuplicateFunc,
+			HandleGetEventFunc:  mockGetEventStoredFunc,
+			ExpectedStatusCode:  201,
+			ExpectedReplayed:    true,
+		}, {
+			Name:                "Should return 422 with a key stored first by another match",
+			MatchID:             "2",
+			HandlePostEventFunc: mockPostEventDuplicateFunc,
+			HandleGetEventFunc:  mockGetEventStoredFunc,
+			ExpectedStatusCode:  422,
+		}, {
+			Name:                "Should return 422 with a key stored first by another event type",
+			MatchID:             "1",
+			HandlePostEventFunc: mockPostEventDuplicateFunc,
+			HandleGetEventFunc:  mockGetEventStoredWarningFunc,
+			ExpectedStatusCode:  422,
+		}, {
+			Name:                "Should return 500 throwing error get event function",
+			MatchID:             "1",
+			HandlePostEventFunc: mockPostEventDuplicateFunc,
+			HandleGetEventFunc:  mockGetEventThrowFunc,
+			ExpectedStatusCode:  500,
+		}, {
+			Name:                "Should return 500 throwing error post event function",
+			MatchID:             "1",
+			HandlePostEventFunc: mockPostEventThrowFunc,
+			HandleGetEventFunc:  mockGetEventStoredFunc,
+			ExpectedStatusCode:  500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			InsertFunc: tc.HandlePostEventFunc,
+			GetFunc:    tc.HandleGetEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/tournament/{id}/matches/{match_id}/events/goal", nil)
+		w := httptest.NewRecorder()
+
+		ok := insertEvent(w, req, event.Event{ID: "goal-1", MatchID: tc.MatchID, Type: model.EventGoal})
+		assert.Equal(t, tc.ExpectedOk, ok)
+
+		if ok {
+			continue
+		}
+
+		assert.Equal(t, tc.ExpectedStatusCode, w.Code)
+		assert.Equal(t, tc.ExpectedReplayed, w.Header().Get(IdempotentReplayedHeader) == "true")
+	}
+}
@@ -10,6 +10,28 @@ type TeamEntityPayload struct {
 	ShortCode string `json:"short_code"`
 	Country   string `json:"country"`
 	City      string `json:"city"`
+	Venue     string `json:"venue"`
+}
+
+type OfficialEntityPayload struct {
+	Name    string             `json:"name"`
+	Country string             `json:"country"`
+	Role    model.OfficialRole `json:"role"`
+}
+
+type VenueEntityPayload struct {
+	Name      string  `json:"name"`
+	City      string  `json:"city"`
+	Country   string  `json:"country"`
+	Capacity  int     `json:"capacity"`
+	TimeZone  string  `json:"time_zone"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type CompetitionEntityPayload struct {
+	Name    string `json:"name"`
+	Country string `json:"country"`
 }
 
 type PlayerEntityPayload struct {
@@ -28,25 +50,83 @@ type TransferEntityPayload struct {
 }
 
 type TournamentEntityPayload struct {
-	Name  string   `json:"name"`
-	Teams []string `json:"teams"`
+	Name          string                   `json:"name"`
+	Competition   string                   `json:"competition"`
+	Season        string                   `json:"season"`
+	Teams         []string                 `json:"teams"`
+	Substitutions SubstitutionRulesPayload `json:"substitutions"`
+	TieBreakers   []model.TieBreaker       `json:"tie_breakers"`
+}
+
+type SubstitutionRulesPayload struct {
+	MaxSubstitutions        int `json:"max_substitutions"`
+	MaxWindows              int `json:"max_windows"`
+	ExtraTimeSubstitutions  int `json:"extra_time_substitutions"`
+	ExtraTimeWindows        int `json:"extra_time_windows"`
+	ConcussionSubstitutions int `json:"concussion_substitutions"`
 }
 
 type AddTeamsTournamentEntityPayload struct {
 	Teams []string `json:"teams"`
 }
 
+type CloneSeasonPayload struct {
+	Name   string `json:"name"`
+	Season string `json:"season"`
+}
+
+type SquadPayload struct {
+	Team    string   `json:"team"`
+	Players []string `json:"players"`
+}
+
+type DrawPayload struct {
+	Groups     int        `json:"groups"`
+	Pots       [][]string `json:"pots"`
+	Seed       *int64     `json:"seed"`
+	PerGroup   int        `json:"per_group"`
+	BestThirds int        `json:"best_thirds"`
+}
+
+type SchedulePayload struct {
+	StartDate         string   `json:"start_date"`
+	DaysBetweenRounds int      `json:"days_between_rounds"`
+	KickoffSlots      []string `json:"kickoff_slots"`
+	TimeZone          string   `json:"time_zone"`
+	Preview           bool     `json:"preview"`
+}
+
+type FixturesPayload struct {
+	SchedulePayload
+	Double bool `json:"double"`
+}
+
+type BracketPayload struct {
+	SchedulePayload
+	Seeds      []string `json:"seeds"`
+	ThirdPlace bool     `json:"third_place"`
+}
+
+type KnockoutPayload struct {
+	SchedulePayload
+	ThirdPlace bool `json:"third_place"`
+}
+
 type MatchEntityPayload struct {
-	HomeTeam    string `json:"home_team"`
-	AwayTeam    string `json:"away_team"`
-	DateOfMatch string `json:"date_of_match"`
-	TimeOfMatch string `json:"time_of_match"`
+	HomeTeam string `json:"home_team"`
+	AwayTeam string `json:"away_team"`
+	Kickoff  string `json:"kickoff"`
+	TimeZone string `json:"time_zone"`
+	Venue    string `json:"venue"`
 }
 
 type MatchGoalEntityPayload struct {
-	TeamScore string `json:"team_score"`
-	Player    string `json:"player"`
-	Minute    int    `json:"minute"`
+	TeamScore string         `json:"team_score"`
+	Player    string         `json:"player"`
+	Minute    int            `json:"minute"`
+	Stoppage  int            `json:"stoppage"`
+	Type      model.GoalType `json:"type,omitempty"`
+	Assist    string         `json:"assist,omitempty"`
 }
 
 type ExtraTimeEntityPayload struct {
@@ -54,15 +134,68 @@ type ExtraTimeEntityPayload struct {
 }
 
 type MatchSubstitutionPayload struct {
-	Team      string `json:"team"`
-	PlayerOut string `json:"player_out"`
-	PlayerIn  string `json:"player_in"`
-	Minute    int    `json:"minute"`
+	Team       string `json:"team"`
+	PlayerOut  string `json:"player_out"`
+	PlayerIn   string `json:"player_in"`
+	Minute     int    `json:"minute"`
+	Stoppage   int    `json:"stoppage"`
+	Concussion bool   `json:"concussion"`
 }
 
 type MatchWarningPayload struct {
-	Team    string         `json:"team"`
-	Player  string         `json:"player"`
-	Warning model.Warnings `json:"warning"`
-	Minute  int            `json:"minute"`
+	Team     string         `json:"team"`
+	Player   string         `json:"player"`
+	Warning  model.Warnings `json:"warning"`
+	Minute   int            `json:"minute"`
+	Stoppage int            `json:"stoppage"`
+}
+
+type MatchStatisticPayload struct {
+	Team     string `json:"team"`
+	Player   string `json:"player"`
+	OnTarget bool   `json:"on_target"`
+	Minute   int    `json:"minute"`
+	Stoppage int    `json:"stoppage"`
+}
+
+type MatchPossessionPayload struct {
+	Home     int `json:"home"`
+	Away     int `json:"away"`
+	Minute   int `json:"minute"`
+	Stoppage int `json:"stoppage"`
+}
+
+type MatchPenaltyKickPayload struct {
+	Team   string `json:"team"`
+	Player string `json:"player"`
+	Scored bool   `json:"scored"`
+}
+
+type MatchCorrectionPayload struct {
+	Reason string `json:"reason"`
+}
+
+type MatchLineupPayload struct {
+	Team       string   `json:"team"`
+	StartingXI []string `json:"starting_xi"`
+	Bench      []string `json:"bench"`
+	Captain    string   `json:"captain"`
+}
+
+type MatchStatusChangePayload struct {
+	Reason  string `json:"reason"`
+	Kickoff string `json:"kickoff"`
+}
+
+type MatchOfficialsPayload struct {
+	Officials []MatchOfficialPayload `json:"officials"`
+}
+
+type MatchOfficialPayload struct {
+	Official string             `json:"official"`
+	Role     model.OfficialRole `json:"role"`
+}
+
+type MatchFinishPayload struct {
+	Attendance int `json:"attendance"`
 }
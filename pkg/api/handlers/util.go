@@ -6,11 +6,14 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
 	"github.com/rafaelsanzio/go-flashscore/pkg/player"
 	"github.com/rafaelsanzio/go-flashscore/pkg/team"
 	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
 	"github.com/rafaelsanzio/go-flashscore/pkg/transfer"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
 )
 
 var rateLimitAllow = rateLimit.Allow
@@ -45,14 +48,44 @@ func restoreWrite(replace func(http.ResponseWriter, []byte) (int, error)) {
 
 var convertPayloadToTeamFunc = convertPayloadToTeam
 
-func fakeConvertPayloadToTeamFunc(t TeamEntityPayload) (team.Team, errs.AppError) {
+func fakeConvertPayloadToTeamFunc(ctx context.Context, t TeamEntityPayload) (team.Team, errs.AppError) {
 	return team.Team{}, errs.ErrConvertingPayload
 }
 
-func restoreConvertPayloadToTeamFunc(replace func(t TeamEntityPayload) (team.Team, errs.AppError)) {
+func restoreConvertPayloadToTeamFunc(replace func(ctx context.Context, t TeamEntityPayload) (team.Team, errs.AppError)) {
 	convertPayloadToTeamFunc = replace
 }
 
+var convertPayloadToOfficialFunc = convertPayloadToOfficial
+
+func fakeConvertPayloadToOfficialFunc(o OfficialEntityPayload) (official.Official, errs.AppError) {
+	return official.Official{}, errs.ErrConvertingPayload
+}
+
+func restoreConvertPayloadToOfficialFunc(replace func(o OfficialEntityPayload) (official.Official, errs.AppError)) {
+	convertPayloadToOfficialFunc = replace
+}
+
+var convertPayloadToVenueFunc = convertPayloadToVenue
+
+func fakeConvertPayloadToVenueFunc(v VenueEntityPayload) (venue.Venue, errs.AppError) {
+	return venue.Venue{}, errs.ErrConvertingPayload
+}
+
+func restoreConvertPayloadToVenueFunc(replace func(v VenueEntityPayload) (venue.Venue, errs.AppError)) {
+	convertPayloadToVenueFunc = replace
+}
+
+var convertPayloadToCompetitionFunc = convertPayloadToCompetition
+
+func fakeConvertPayloadToCompetitionFunc(c CompetitionEntityPayload) (competition.Competition, errs.AppError) {
+	return competition.Competition{}, errs.ErrConvertingPayload
+}
+
+func restoreConvertPayloadToCompetitionFunc(replace func(c CompetitionEntityPayload) (competition.Competition, errs.AppError)) {
+	convertPayloadToCompetitionFunc = replace
+}
+
 var convertPayloadToPlayerFunc = convertPayloadToPlayer
 
 func fakeConvertPayloadToPlayerFunc(ctx context.Context, p PlayerEntityPayload) (*player.Player, errs.AppError) {
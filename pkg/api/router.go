@@ -42,11 +42,34 @@ var routes = []Route{
 	{Name: "Updating a player", Methods: []string{http.MethodPut}, Path: "/players/{id}", Handler: handlers.HandleAdapter(handlers.HandleUpdatePlayer)},
 	{Name: "Deleting a player", Methods: []string{http.MethodDelete}, Path: "/players/{id}", Handler: handlers.HandleAdapter(handlers.HandleDeletePlayer)},
 
+	// Venue
+	{Name: "Creating a venue", Methods: []string{http.MethodPost}, Path: "/venues", Handler: handlers.HandleAdapter(handlers.HandlePostVenue)},
+	{Name: "Listing all venues", Methods: []string{http.MethodGet}, Path: "/venues", Handler: handlers.HandleAdapter(handlers.HandleListVenue)},
+	{Name: "Getting a venue", Methods: []string{http.MethodGet}, Path: "/venues/{id}", Handler: handlers.HandleAdapter(handlers.HandleGetVenue)},
+	{Name: "Updating a venue", Methods: []string{http.MethodPut}, Path: "/venues/{id}", Handler: handlers.HandleAdapter(handlers.HandleUpdateVenue)},
+	{Name: "Deleting a venue", Methods: []string{http.MethodDelete}, Path: "/venues/{id}", Handler: handlers.HandleAdapter(handlers.HandleDeleteVenue)},
+
+	// Official
+	{Name: "Creating an official", Methods: []string{http.MethodPost}, Path: "/officials", Handler: handlers.HandleAdapter(handlers.HandlePostOfficial)},
+	{Name: "Listing all officials", Methods: []string{http.MethodGet}, Path: "/officials", Handler: handlers.HandleAdapter(handlers.HandleListOfficial)},
+	{Name: "Getting an official", Methods: []string{http.MethodGet}, Path: "/officials/{id}", Handler: handlers.HandleAdapter(handlers.HandleGetOfficial)},
+	{Name: "Updating an official", Methods: []string{http.MethodPut}, Path: "/officials/{id}", Handler: handlers.HandleAdapter(handlers.HandleUpdateOfficial)},
+	{Name: "Deleting an official", Methods: []string{http.MethodDelete}, Path: "/officials/{id}", Handler: handlers.HandleAdapter(handlers.HandleDeleteOfficial)},
+	{Name: "Getting the discipline summary of an official", Methods: []string{http.MethodGet}, Path: "/officials/{id}/discipline", Handler: handlers.HandleAdapter(handlers.HandleGetOfficialDiscipline)},
+
 	// Transfer
 	{Name: "Creating a transfer", Methods: []string{http.MethodPost}, Path: "/transfers", Handler: handlers.HandleAdapter(handlers.HandlePostTransfer)},
 	{Name: "Getting a transfer", Methods: []string{http.MethodGet}, Path: "/transfers/{id}", Handler: handlers.HandleAdapter(handlers.HandleGetTransfer)},
 	{Name: "Listing all transfers", Methods: []string{http.MethodGet}, Path: "/transfers", Handler: handlers.HandleAdapter(handlers.HandleListTransfer)},
 
+	// Competition
+	{Name: "Creating a competition", Methods: []string{http.MethodPost}, Path: "/competitions", Handler: handlers.HandleAdapter(handlers.HandlePostCompetition)},
+	{Name: "Listing all competitions", Methods: []string{http.MethodGet}, Path: "/competitions", Handler: handlers.HandleAdapter(handlers.HandleListCompetition)},
+	{Name: "Getting a competition", Methods: []string{http.MethodGet}, Path: "/competitions/{id}", Handler: handlers.HandleAdapter(handlers.HandleGetCompetition)},
+	{Name: "Updating a competition", Methods: []string{http.MethodPut}, Path: "/competitions/{id}", Handler: handlers.HandleAdapter(handlers.HandleUpdateCompetition)},
+	{Name: "Deleting a competition", Methods: []string{http.MethodDelete}, Path: "/competitions/{id}", Handler: handlers.HandleAdapter(handlers.HandleDeleteCompetition)},
+	{Name: "Listing the seasons of a competition", Methods: []string{http.MethodGet}, Path: "/competitions/{id}/seasons", Handler: handlers.HandleAdapter(handlers.HandleListCompetitionSeasons)},
+
 	// Tournament
 	{Name: "Creating a tournament", Methods: []string{http.MethodPost}, Path: "/tournaments", Handler: handlers.HandleAdapter(handlers.HandlePostTournament)},
 	{Name: "Listing all tournaments", Methods: []string{http.MethodGet}, Path: "/tournaments", Handler: handlers.HandleAdapter(handlers.HandleListTournament)},
@@ -54,21 +77,65 @@ var routes = []Route{
 	{Name: "Updating a tournament", Methods: []string{http.MethodPut}, Path: "/tournaments/{id}", Handler: handlers.HandleAdapter(handlers.HandleUpdateTournament)},
 	{Name: "Deleting a tournament", Methods: []string{http.MethodDelete}, Path: "/tournaments/{id}", Handler: handlers.HandleAdapter(handlers.HandleDeleteTournament)},
 
+	// Tournament -> Standings
+	{Name: "Getting the standings of a tournament", Methods: []string{http.MethodGet}, Path: "/tournaments/{id}/standings", Handler: handlers.HandleAdapter(handlers.HandleGetTournamentStandings)},
+
 	// Tournament -> Teams
 	{Name: "Adding teams to a tournament", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/add-teams", Handler: handlers.HandleAdapter(handlers.HandleAddTeamsTournament)},
+	{Name: "Registering the squad of a team", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/squads", Handler: handlers.HandleAdapter(handlers.HandlePostSquad)},
+
+	// Tournament -> Seasons
+	{Name: "Cloning the next season of a tournament", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/clone", Handler: handlers.HandleAdapter(handlers.HandlePostCloneSeason)},
+
+	// Tournament -> Fixtures
+	{Name: "Generating the round-robin fixtures of a tournament", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/fixtures/generate", Handler: handlers.HandleAdapter(handlers.HandlePostFixtures)},
+
+	// Tournament -> Groups
+	{Name: "Drawing the groups of a tournament", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/draw", Handler: handlers.HandleAdapter(handlers.HandlePostDraw)},
+	{Name: "Getting the group standings of a tournament", Methods: []string{http.MethodGet}, Path: "/tournaments/{id}/groups/standings", Handler: handlers.HandleAdapter(handlers.HandleGetGroupStandings)},
+	{Name: "Generating the knockout of the group stage qualified teams", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/knockout/generate", Handler: handlers.HandleAdapter(handlers.HandlePostKnockout)},
+
+	// Tournament -> Bracket
+	{Name: "Generating the knockout bracket of a tournament", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/bracket/generate", Handler: handlers.HandleAdapter(handlers.HandlePostBracket)},
 
 	// Tournament -> Matches
 	{Name: "Creating a match to a tournament", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches", Handler: handlers.HandleAdapter(handlers.HandlePostMatch)},
 	{Name: "Listing all match from tournament", Methods: []string{http.MethodGet}, Path: "/tournaments/{id}/matches", Handler: handlers.HandleAdapter(handlers.HandleListMatch)},
 	{Name: "Getting a match from tournament", Methods: []string{http.MethodGet}, Path: "/tournaments/{id}/matches/{match_id}", Handler: handlers.HandleAdapter(handlers.HandleGetMatch)},
 	{Name: "Deleting a match from tournament", Methods: []string{http.MethodDelete}, Path: "/tournaments/{id}/matches/{match_id}", Handler: handlers.HandleAdapter(handlers.HandleDeleteMatch)},
+	{Name: "Getting the score of a match from tournament", Methods: []string{http.MethodGet}, Path: "/tournaments/{id}/matches/{match_id}/score", Handler: handlers.HandleAdapter(handlers.HandleGetMatchScore)},
+	{Name: "Assigning the officials of a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/officials", Handler: handlers.HandleAdapter(handlers.HandlePostMatchOfficials)},
+	{Name: "Creating the lineup of a team in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/lineups", Handler: handlers.HandleAdapter(handlers.HandlePostMatchLineup)},
 
 	// Tournament -> Matches -> Events
+	{Name: "Getting the statistics of each team in a match", Methods: []string{http.MethodGet}, Path: "/tournaments/{id}/matches/{match_id}/statistics", Handler: handlers.HandleAdapter(handlers.HandleGetMatchStatistics)},
+	{Name: "Listing the events timeline of a match", Methods: []string{http.MethodGet}, Path: "/tournaments/{id}/matches/{match_id}/events", Handler: handlers.HandleAdapter(handlers.HandleListMatchEvents)},
 	{Name: "Creating an event to start a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/start", Handler: handlers.HandleAdapter(handlers.HandlePostMatchStart)},
 	{Name: "Creating an event to score a goal in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/goal", Handler: handlers.HandleAdapter(handlers.HandlePostMatchGoal)},
 	{Name: "Creating an event to halftime a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/halftime", Handler: handlers.HandleAdapter(handlers.HandlePostMatchHalftime)},
+	{Name: "Creating an event to start the second half of a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/secondhalf", Handler: handlers.HandleAdapter(handlers.HandlePostMatchSecondHalf)},
 	{Name: "Creating an event to substitution players in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/substitution", Handler: handlers.HandleAdapter(handlers.HandlePostMatchSubstitution)},
 	{Name: "Creating an event to add a warning in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/warning", Handler: handlers.HandleAdapter(handlers.HandlePostMatchWarning)},
+	{Name: "Creating an event to add a shot in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/shot", Handler: handlers.HandleAdapter(handlers.HandlePostMatchShot)},
+	{Name: "Creating an event to add a corner in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/corner", Handler: handlers.HandleAdapter(handlers.HandlePostMatchCorner)},
+	{Name: "Creating an event to add a foul in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/foul", Handler: handlers.HandleAdapter(handlers.HandlePostMatchFoul)},
+	{Name: "Creating an event to add an offside in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/offside", Handler: handlers.HandleAdapter(handlers.HandlePostMatchOffside)},
+	{Name: "Creating an event to add a save in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/save", Handler: handlers.HandleAdapter(handlers.HandlePostMatchSave)},
+	{Name: "Creating an event to add a possession snapshot in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/possession", Handler: handlers.HandleAdapter(handlers.HandlePostMatchPossession)},
 	{Name: "Creating an event to add extratime in a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/extratime", Handler: handlers.HandleAdapter(handlers.HandlePostMatchExtratime)},
+	{Name: "Creating an event to start the first half of extra time of a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/extratimefirsthalf", Handler: handlers.HandleAdapter(handlers.HandlePostMatchExtraTimeFirstHalf)},
+	{Name: "Creating an event to start the second half of extra time of a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/extratimesecondhalf", Handler: handlers.HandleAdapter(handlers.HandlePostMatchExtraTimeSecondHalf)},
+	{Name: "Creating an event to start the penalty shootout of a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/penaltyshootout", Handler: handlers.HandleAdapter(handlers.HandlePostMatchPenaltyShootout)},
+	{Name: "Creating an event to a penalty kick of a shootout", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/penaltykick", Handler: handlers.HandleAdapter(handlers.HandlePostMatchPenaltyKick)},
 	{Name: "Creating an event to finish a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/finish", Handler: handlers.HandleAdapter(handlers.HandlePostMatchFinish)},
+	{Name: "Creating an event to postpone a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/postpone", Handler: handlers.HandleAdapter(handlers.HandlePostMatchPostpone)},
+	{Name: "Creating an event to suspend a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/suspend", Handler: handlers.HandleAdapter(handlers.HandlePostMatchSuspend)},
+	{Name: "Creating an event to resume a suspended match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/resume", Handler: handlers.HandleAdapter(handlers.HandlePostMatchResume)},
+	{Name: "Creating an event to abandon a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/abandon", Handler: handlers.HandleAdapter(handlers.HandlePostMatchAbandon)},
+	{Name: "Creating an event to cancel a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/cancel", Handler: handlers.HandleAdapter(handlers.HandlePostMatchCancel)},
+	{Name: "Retracting an event of a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/{event_id}/retract", Handler: handlers.HandleAdapter(handlers.HandlePostMatchRetraction)},
+	{Name: "Amending an event of a match", Methods: []string{http.MethodPost}, Path: "/tournaments/{id}/matches/{match_id}/events/{event_id}/amend", Handler: handlers.HandleAdapter(handlers.HandlePostMatchAmendment)},
+
+	// Admin
+	{Name: "Rebuilding the matches from their events", Methods: []string{http.MethodPost}, Path: "/admin/rebuild", Handler: handlers.HandleAdminAdapter(handlers.HandlePostRebuild)},
 }
@@ -0,0 +1,33 @@
+package competition
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+)
+
+type CompetitionRepo interface {
+	Insert(ctx context.Context, c Competition) errs.AppError
+	Get(ctx context.Context, id string) (*Competition, errs.AppError)
+	List(ctx context.Context) ([]Competition, errs.AppError)
+	Update(ctx context.Context, c Competition) (*Competition, errs.AppError)
+	Delete(ctx context.Context, id string) errs.AppError
+}
+
+// Competition is what a tournament is an edition of, each season of the
+// competition is a tournament with its own teams, matches and standings.
+type Competition struct {
+	ID      string `bson:"_id"`
+	Name    string
+	Country string `json:",omitempty" bson:",omitempty"`
+	Created time.Time
+}
+
+func (c Competition) GetID() string {
+	return c.ID
+}
+
+func (c *Competition) SetID(id string) {
+	c.ID = id
+}
@@ -22,6 +22,7 @@ var (
 	AppPort         = Key{Name: "APP_PORT", Secure: false, Provider: ProviderStore}
 	AppAuthPort     = Key{Name: "APP_AUTH_PORT", Secure: false, Provider: ProviderStore}
 	SecretApiKey    = Key{Name: "SECRET_API_KEY", Secure: false, Provider: ProviderStore}
+	AdminApiKey     = Key{Name: "ADMIN_API_KEY", Secure: true, Provider: ProviderStore}
 
 	RedisPort = Key{Name: "REDIS_PORT", Secure: false, Provider: ProviderStore}
 
@@ -15,6 +15,7 @@ var Values = map[key.Key]string{
 	key.AppPort:         getDefaultOrEnvVar("5000", "APP_PORT"),
 	key.AppAuthPort:     getDefaultOrEnvVar("5001", "APP_AUTH_PORT"),
 	key.SecretApiKey:    getDefaultOrEnvVar("flashscore", "SECRET_API_KEY"),
+	key.AdminApiKey:     getDefaultOrEnvVar("", "ADMIN_API_KEY"),
 
 	key.RedisPort: getDefaultOrEnvVar("6379", "REDIS_PORT"),
 
@@ -23,6 +23,7 @@ var (
 	ErrReadingFile           = _new("CMN015", "error reading file")
 	ErrActionNotImplemented  = _new("CMN016", "error action is not implemented")
 	ErrParsingAtoi           = _new("CMN017", "error converting string to a int (Atoi)")
+	ErrParsingBool           = _new("CMN018", "error converting string to a bool")
 )
 
 // pkg/api
@@ -56,6 +57,9 @@ var (
 	ErrMarshalingBson       = _new("STR012", "error marshaling bson")
 	ErrUnmarshalingBson     = _new("STR013", "error unmarshaling bson")
 	ErrRedisConnect         = _new("STR014", "error connecting to redis")
+	ErrMongoInsertMany      = _new("STR015", "error inserting many mongo documents")
+	ErrMongoDuplicateKey    = _new("STR016", "mongo document with the same key already exists")
+	ErrMongoCreateIndex     = _new("STR017", "error creating mongo index")
 )
 
 // pkg/middleware
@@ -64,6 +68,8 @@ var (
 	ErrTokenSignatureInvalid = _new("MID002", "token signature is invalid")
 	ErrTokenInvalid          = _new("MID003", "token is invalid")
 	ErrTokenWithInvalidEmail = _new("MID004", "token with invalid email")
+	ErrAdminKeyIsEmpty       = _new("MID005", "admin key is empty")
+	ErrAdminKeyInvalid       = _new("MID006", "admin key is invalid")
 )
 
 // pkg/repo
@@ -74,8 +80,12 @@ var (
 	ErrTransferIsNotFound         = _new("REP004", "transfer is not found")
 	ErrMatchIsNotFound            = _new("REP005", "match is not found")
 	ErrPlayerIsNotFoundInThisTeam = _new("REP006", "player is not found in this team")
-	ErrGoalMinuteUpperToLimit     = _new("REP007", "goal minute cannot be more than 90")
 	ErrSubsSamePlayer             = _new("REP008", "subs cannot be with same player")
+	ErrGoalAssistNotAllowed       = _new("REP009", "assist is not allowed for this goal type")
+	ErrGoalAssistSamePlayer       = _new("REP010", "assist cannot be the same player as the scorer")
+	ErrOfficialIsNotFound         = _new("REP011", "official is not found")
+	ErrVenueIsNotFound            = _new("REP012", "venue is not found")
+	ErrCompetitionIsNotFound      = _new("REP013", "competition is not found")
 )
 
 // pkg/model
@@ -85,6 +95,59 @@ var (
 	ErrInvalidActionType   = _new("MDL003", "invalid action type")
 )
 
+// pkg/event
+var (
+	ErrUnknownEventType      = _new("EVT001", "unknown match event type")
+	ErrEventIsNotFound       = _new("EVT002", "event is not found")
+	ErrEventNotCorrectable   = _new("EVT003", "event cannot be corrected")
+	ErrEventAlreadyRetracted = _new("EVT004", "event is already retracted")
+	ErrEventIDConflict       = _new("EVT005", "event id is already used by another match or event type")
+)
+
+// pkg/match
+var (
+	ErrMatchInvalidTransition   = _new("MAT001", "invalid match status transition")
+	ErrMatchEventNotAllowed     = _new("MAT002", "match event is not allowed in the current match status")
+	ErrMatchIsNotLevel          = _new("MAT003", "extra time and penalties need a level score")
+	ErrMatchPenaltiesNotDecided = _new("MAT004", "penalty shootout is not decided")
+	ErrPlayerIsSentOff          = _new("MAT005", "player is sent off")
+	ErrPlayerIsNotOnThePitch    = _new("MAT006", "player is not on the pitch")
+	ErrPlayerIsNotOnTheBench    = _new("MAT007", "player is not on the bench or was already substituted")
+	ErrLineupStartingXI         = _new("MAT008", "starting lineup must have 11 players")
+	ErrLineupDuplicatedPlayer   = _new("MAT009", "player is more than once in the lineup")
+	ErrLineupCaptainNotStarting = _new("MAT010", "captain must be in the starting lineup")
+	ErrSubstitutionLimit        = _new("MAT011", "team has no substitutions left")
+	ErrSubstitutionWindowLimit  = _new("MAT012", "team has no substitution windows left")
+	ErrConcussionSubstitution   = _new("MAT013", "team has no concussion substitutions left")
+	ErrMinuteOutOfPeriod        = _new("MAT014", "minute is out of the match period")
+	ErrStoppageNotAllowed       = _new("MAT015", "stoppage time is only allowed at the end of a period")
+	ErrStoppageOverExtratime    = _new("MAT016", "stoppage time is over the announced extratime")
+	ErrOfficialDuplicated       = _new("MAT017", "official is assigned more than once to the match")
+	ErrOfficialConflict         = _new("MAT018", "official is already assigned to a match with an overlapping kickoff")
+	ErrAttendanceOverCapacity   = _new("MAT019", "attendance is over the venue capacity")
+	ErrPossessionInvalid        = _new("MAT020", "possession of both teams must add up to 100")
+	ErrFixturesNotEnoughTeams   = _new("MAT021", "fixtures need at least two teams")
+	ErrMatchTeamsNotDecided     = _new("MAT022", "match teams are not decided yet")
+	ErrMatchNeedsWinner         = _new("MAT023", "knockout match cannot finish without a winner")
+	ErrBracketSeedInvalid       = _new("MAT024", "bracket seed is not a team of the tournament or is repeated")
+	ErrBracketMatchStarted      = _new("MAT025", "bracket match has already started")
+	ErrGroupStageNotFinished    = _new("MAT026", "group stage matches are not all finished")
+	ErrKnockoutAlreadyExists    = _new("MAT027", "knockout bracket of the tournament is already generated")
+	ErrLineupPlayerNotInSquad   = _new("MAT028", "player is not in the season squad of the team")
+	ErrRebuildNotScoped         = _new("MAT029", "rebuild needs a tournament or a match")
+	ErrGroupMatchesExist        = _new("MAT030", "group matches of the tournament are already generated")
+	ErrFixturesAlreadyExist     = _new("MAT031", "round-robin fixtures of the tournament are already generated")
+)
+
+// pkg/tournament
+var (
+	ErrDrawInvalidGroups     = _new("TOU001", "groups need at least two teams each")
+	ErrDrawInvalidPots       = _new("TOU002", "pots must hold every team of the tournament once and no more teams than groups")
+	ErrQualificationRules    = _new("TOU003", "qualification rules don't fit the groups")
+	ErrSeasonAlreadyExists   = _new("TOU004", "season of the competition already exists")
+	ErrCompetitionHasSeasons = _new("TOU005", "competition still has seasons")
+)
+
 // pkg/kafka
 var (
 	ErrReadingKafkaMessage           = _new("KAF001", "error reading kafka message")
@@ -98,6 +161,15 @@ var (
 	ErrHandlingGameEventExtratime    = _new("KAF009", "error handling game event extratime")
 	ErrHandlingGameEventSubstitution = _new("KAF010", "error handling game event substitution")
 	ErrHandlingGameEventWarning      = _new("KAF011", "error handling game event warning")
+	ErrHandlingGameEventRetraction   = _new("KAF012", "error handling game event retraction")
+	ErrHandlingGameEventAmendment    = _new("KAF013", "error handling game event amendment")
+	ErrHandlingGameEventSecondHalf   = _new("KAF014", "error handling game event second half")
+	ErrHandlingGameEventExtraTime    = _new("KAF015", "error handling game event extra time period")
+	ErrHandlingGameEventPenalties    = _new("KAF016", "error handling game event penalty shootout")
+	ErrHandlingGameEventPenaltyKick  = _new("KAF017", "error handling game event penalty kick")
+	ErrHandlingGameEventLineup       = _new("KAF018", "error handling game event lineup")
+	ErrHandlingGameEventStatusChange = _new("KAF019", "error handling game event status change")
+	ErrHandlingGameEventStatistic    = _new("KAF020", "error handling game event statistic")
 )
 
 // general jobs
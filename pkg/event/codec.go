@@ -0,0 +1,187 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+type bsonEvent struct {
+	ID           string `bson:"_id"`
+	TournamentID string
+	MatchID      string
+	Type         model.EventsMatchType
+	Value        bson.RawValue
+	Created      time.Time
+}
+
+// legacyEvent is how the match events were stored before they had a typed
+// value, the type sat in MatchEvent next to the fields of the value.
+type legacyEvent struct {
+	MatchEvent model.EventsMatchType
+}
+
+// legacyValue has the value fields that were renamed since.
+type legacyValue struct {
+	TeamScore          team.Team
+	GoalMinute         int
+	SubstitutionMinute int
+	WarningMinute      int
+}
+
+type jsonEvent struct {
+	ID           string
+	TournamentID string
+	MatchID      string
+	Type         model.EventsMatchType
+	Value        json.RawMessage
+	Created      time.Time
+}
+
+func (e *Event) UnmarshalBSON(data []byte) error {
+	raw := bsonEvent{}
+	err_ := bson.Unmarshal(data, &raw)
+	if err_ != nil {
+		return errs.ErrUnmarshalingBson.Throwf(applog.Log, errs.ErrFmt, err_)
+	}
+
+	if raw.Type == "" {
+		legacy := legacyEvent{}
+		err_ = bson.Unmarshal(data, &legacy)
+		if err_ != nil {
+			return errs.ErrUnmarshalingBson.Throwf(applog.Log, errs.ErrFmt, err_)
+		}
+
+		if legacy.MatchEvent != "" {
+			raw.Type = legacy.MatchEvent
+			raw.Value = bson.RawValue{Type: bsontype.EmbeddedDocument, Value: data}
+		}
+	}
+
+	v, err := decodeBSONValue(raw.Type, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*e = Event{
+		ID:           raw.ID,
+		TournamentID: raw.TournamentID,
+		MatchID:      raw.MatchID,
+		Type:         raw.Type,
+		Value:        v,
+		Created:      raw.Created,
+	}
+
+	return nil
+}
+
+func (e *Event) UnmarshalJSON(data []byte) error {
+	raw := jsonEvent{}
+	err_ := json.Unmarshal(data, &raw)
+	if err_ != nil {
+		return errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+	}
+
+	v, err := decodeJSONValue(raw.Type, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*e = Event{
+		ID:           raw.ID,
+		TournamentID: raw.TournamentID,
+		MatchID:      raw.MatchID,
+		Type:         raw.Type,
+		Value:        v,
+		Created:      raw.Created,
+	}
+
+	return nil
+}
+
+func decodeBSONValue(t model.EventsMatchType, raw bson.RawValue) (Value, errs.AppError) {
+	if raw.Type == 0 || raw.Type == bsontype.Null {
+		return nil, nil
+	}
+
+	v, err := newValue(t)
+	if err != nil {
+		return nil, err
+	}
+
+	err_ := raw.Unmarshal(v)
+	if err_ != nil {
+		return nil, errs.ErrUnmarshalingBson.Throwf(applog.Log, errs.ErrFmt, err_)
+	}
+
+	err = decodeLegacyValue(v, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return deref(v), nil
+}
+
+// decodeLegacyValue fills the fields of a value stored before they were
+// renamed, the team was TeamScore and the minute was named after the event.
+func decodeLegacyValue(v Value, raw bson.RawValue) errs.AppError {
+	switch v.(type) {
+	case *Goal, *Substitution, *Warning:
+	default:
+		return nil
+	}
+
+	legacy := legacyValue{}
+	err_ := raw.Unmarshal(&legacy)
+	if err_ != nil {
+		return errs.ErrUnmarshalingBson.Throwf(applog.Log, errs.ErrFmt, err_)
+	}
+
+	switch val := v.(type) {
+	case *Goal:
+		if val.Team.ID == "" {
+			val.Team = legacy.TeamScore
+		}
+		if val.Minute == 0 {
+			val.Minute = legacy.GoalMinute
+		}
+	case *Substitution:
+		if val.Team.ID == "" {
+			val.Team = legacy.TeamScore
+		}
+		if val.Minute == 0 {
+			val.Minute = legacy.SubstitutionMinute
+		}
+	case *Warning:
+		if val.Minute == 0 {
+			val.Minute = legacy.WarningMinute
+		}
+	}
+
+	return nil
+}
+
+func decodeJSONValue(t model.EventsMatchType, raw json.RawMessage) (Value, errs.AppError) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	v, err := newValue(t)
+	if err != nil {
+		return nil, err
+	}
+
+	err_ := json.Unmarshal(raw, v)
+	if err_ != nil {
+		return nil, errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+	}
+
+	return deref(v), nil
+}
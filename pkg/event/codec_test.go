@@ -0,0 +1,240 @@
+package event
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+func TestEventCodec(t *testing.T) {
+	teamMock := team.Team{ID: "1", Name: "Real Madrid Club"}
+	playerMock := player.Player{ID: "1", Name: "Cristiano Ronaldo", Team: teamMock}
+	kickoff := time.Date(2022, 2, 8, 19, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name  string
+		Event Event
+	}{
+		{
+			Name:  "Start event",
+			Event: New("1", "1", Start{TimeStarted: "16:00"}),
+		}, {
+			Name:  "Goal event",
+			Event: New("1", "1", Goal{Team: teamMock, Player: playerMock, Minute: 10}),
+		}, {
+			Name:  "Lineup event",
+			Event: New("1", "1", Lineup{Team: teamMock, StartingXI: []player.Player{playerMock}, Bench: []player.Player{}, Captain: playerMock}),
+		}, {
+			Name:  "Goal event with type and assist",
+			Event: New("1", "1", Goal{Team: teamMock, Player: playerMock, Minute: 10, Type: model.GoalHeader, Assist: &playerMock}),
+		}, {
+			Name:  "Halftime event",
+			Event: New("1", "1", Halftime{Halftime: "16:45"}),
+		}, {
+			Name:  "SecondHalf event",
+			Event: New("1", "1", SecondHalf{TimeStarted: "17:00"}),
+		}, {
+			Name:  "Extratime event",
+			Event: New("1", "1", Extratime{Extratime: 3}),
+		}, {
+			Name:  "Substitution event",
+			Event: New("1", "1", Substitution{Team: teamMock, PlayerOut: playerMock, PlayerIn: playerMock, Minute: 60}),
+		}, {
+			Name:  "Warning event",
+			Event: New("1", "1", Warning{Team: teamMock, Player: playerMock, Warning: model.WarningYellowCard, Minute: 70}),
+		}, {
+			Name:  "Warning event in stoppage time",
+			Event: New("1", "1", Warning{Team: teamMock, Player: playerMock, Warning: model.WarningRedCard, Period: model.PeriodSecondHalf, Minute: 90, Stoppage: 4}),
+		}, {
+			Name:  "Finish event",
+			Event: New("1", "1", Finish{TimeFinished: "17:50"}),
+		}, {
+			Name:  "Finish event decided on penalties",
+			Event: New("1", "1", Finish{TimeFinished: "19:10", DecidedBy: model.DecisionPenalties}),
+		}, {
+			Name:  "ExtraTimeFirstHalf event",
+			Event: New("1", "1", ExtraTimeFirstHalf{TimeStarted: "17:55"}),
+		}, {
+			Name:  "ExtraTimeSecondHalf event",
+			Event: New("1", "1", ExtraTimeSecondHalf{TimeStarted: "18:12"}),
+		}, {
+			Name:  "PenaltyShootout event",
+			Event: New("1", "1", PenaltyShootout{TimeStarted: "18:30"}),
+		}, {
+			Name:  "PenaltyKick event",
+			Event: New("1", "1", PenaltyKick{Team: teamMock, Player: playerMock, Scored: true}),
+		}, {
+			Name:  "Retraction event",
+			Event: New("1", "1", Retraction{EventID: "2", Reason: "Offside"}),
+		}, {
+			Name:  "Amendment event",
+			Event: New("1", "1", Amendment{EventID: "2", Reason: "Wrong scorer", Type: model.EventGoal, Value: Goal{Team: teamMock, Player: playerMock, Minute: 12}}),
+		}, {
+			Name:  "Postpone event with a new kickoff",
+			Event: New("1", "1", Postpone{Reason: "Waterlogged pitch", Kickoff: &kickoff}),
+		}, {
+			Name:  "Postpone event without a new kickoff",
+			Event: New("1", "1", Postpone{Reason: "Waterlogged pitch"}),
+		}, {
+			Name:  "Suspend event",
+			Event: New("1", "1", Suspend{Reason: "Floodlight failure", TimeSuspended: "16:20"}),
+		}, {
+			Name:  "Resume event",
+			Event: New("1", "1", Resume{TimeResumed: "16:50"}),
+		}, {
+			Name:  "Abandon event",
+			Event: New("1", "1", Abandon{Reason: "Crowd trouble", TimeAbandoned: "17:00"}),
+		}, {
+			Name:  "Cancel event",
+			Event: New("1", "1", Cancel{Reason: "Team withdrew"}),
+		}, {
+			Name:  "Shot event on target",
+			Event: New("1", "1", Shot{Team: teamMock, Player: playerMock, OnTarget: true, Period: model.PeriodFirstHalf, Minute: 12}),
+		}, {
+			Name:  "Corner event",
+			Event: New("1", "1", Corner{Team: teamMock, Period: model.PeriodFirstHalf, Minute: 45, Stoppage: 2}),
+		}, {
+			Name:  "Foul event",
+			Event: New("1", "1", Foul{Team: teamMock, Player: playerMock, Minute: 20}),
+		}, {
+			Name:  "Offside event",
+			Event: New("1", "1", Offside{Team: teamMock, Player: playerMock, Minute: 25}),
+		}, {
+			Name:  "Save event",
+			Event: New("1", "1", Save{Team: teamMock, Player: playerMock, Minute: 30}),
+		}, {
+			Name:  "Possession event",
+			Event: New("1", "1", Possession{Home: 58, Away: 42, Period: model.PeriodFirstHalf, Minute: 30}),
+		}, {
+			Name:  "Event without value",
+			Event: Event{ID: "1", Type: model.EventStart},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		data, err := bson.Marshal(tc.Event)
+		assert.NoError(t, err)
+
+		fromBson := Event{}
+		err = bson.Unmarshal(data, &fromBson)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Event.Value, fromBson.Value)
+		assert.Equal(t, tc.Event.Type, fromBson.Type)
+
+		data, err = json.Marshal(tc.Event)
+		assert.NoError(t, err)
+
+		fromJson := Event{}
+		err = json.Unmarshal(data, &fromJson)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Event.Value, fromJson.Value)
+		assert.Equal(t, tc.Event.Type, fromJson.Type)
+	}
+}
+
+func TestEventCodecUnknownType(t *testing.T) {
+	data, err := bson.Marshal(Event{Type: model.EventsMatchType("Unknown"), Value: Start{}})
+	assert.NoError(t, err)
+
+	e := Event{}
+	err = bson.Unmarshal(data, &e)
+	assert.NotNil(t, err)
+}
+
+func TestEventCodecLegacy(t *testing.T) {
+	teamMock := team.Team{ID: "1", Name: "Real Madrid Club"}
+	playerMock := player.Player{ID: "1", Name: "Cristiano Ronaldo", Team: teamMock}
+	created := time.Date(2022, 2, 8, 19, 10, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name          string
+		Document      bson.M
+		ExpectedType  model.EventsMatchType
+		ExpectedValue Value
+	}{
+		{
+			Name: "Legacy goal of the match events",
+			Document: bson.M{
+				"matchevent": model.EventGoal,
+				"teamscore":  teamMock,
+				"player":     playerMock,
+				"goalminute": 10,
+				"created":    created,
+			},
+			ExpectedType:  model.EventGoal,
+			ExpectedValue: Goal{Team: teamMock, Player: playerMock, Minute: 10},
+		}, {
+			Name: "Legacy substitution of the match events",
+			Document: bson.M{
+				"matchevent":         model.EventSubstitution,
+				"teamscore":          teamMock,
+				"playerout":          playerMock,
+				"playerin":           playerMock,
+				"substitutionminute": 60,
+				"created":            created,
+			},
+			ExpectedType:  model.EventSubstitution,
+			ExpectedValue: Substitution{Team: teamMock, PlayerOut: playerMock, PlayerIn: playerMock, Minute: 60},
+		}, {
+			Name: "Legacy warning of the match events",
+			Document: bson.M{
+				"matchevent":    model.EventWarning,
+				"team":          teamMock,
+				"player":        playerMock,
+				"warning":       model.WarningYellowCard,
+				"warningminute": 70,
+				"created":       created,
+			},
+			ExpectedType:  model.EventWarning,
+			ExpectedValue: Warning{Team: teamMock, Player: playerMock, Warning: model.WarningYellowCard, Minute: 70},
+		}, {
+			Name: "Legacy start of the match events",
+			Document: bson.M{
+				"matchevent":  model.EventStart,
+				"timestarted": "16:00",
+				"created":     created,
+			},
+			ExpectedType:  model.EventStart,
+			ExpectedValue: Start{TimeStarted: "16:00"},
+		}, {
+			Name: "Legacy goal value of the events collection",
+			Document: bson.M{
+				"_id":     "1",
+				"matchid": "1",
+				"type":    model.EventGoal,
+				"value": bson.M{
+					"teamscore":  teamMock,
+					"player":     playerMock,
+					"goalminute": 10,
+					"created":    created,
+				},
+				"created": created,
+			},
+			ExpectedType:  model.EventGoal,
+			ExpectedValue: Goal{Team: teamMock, Player: playerMock, Minute: 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		data, err := bson.Marshal(tc.Document)
+		assert.NoError(t, err)
+
+		e := Event{}
+		err = bson.Unmarshal(data, &e)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ExpectedType, e.Type)
+		assert.Equal(t, tc.ExpectedValue, e.Value)
+		assert.Equal(t, created, e.Created)
+	}
+}
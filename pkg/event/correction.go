@@ -0,0 +1,98 @@
+package event
+
+import (
+	"encoding/json"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+type Retraction struct {
+	EventID string
+	Reason  string
+}
+
+// Amendment replaces the value of a previous event, Type is the type of the amended event.
+type Amendment struct {
+	EventID string
+	Reason  string
+	Type    model.EventsMatchType
+	Value   Value
+}
+
+func (Retraction) EventType() model.EventsMatchType { return model.EventRetraction }
+func (Amendment) EventType() model.EventsMatchType  { return model.EventAmendment }
+
+func IsCorrectable(t model.EventsMatchType) bool {
+	return t == model.EventGoal || t == model.EventWarning || t == model.EventSubstitution
+}
+
+func IsRetracted(events []Event, id string) bool {
+	for _, e := range events {
+		if r, ok := e.Value.(Retraction); ok && r.EventID == id {
+			return true
+		}
+	}
+	return false
+}
+
+type bsonAmendment struct {
+	EventID string
+	Reason  string
+	Type    model.EventsMatchType
+	Value   bson.RawValue
+}
+
+type jsonAmendment struct {
+	EventID string
+	Reason  string
+	Type    model.EventsMatchType
+	Value   json.RawMessage
+}
+
+func (a *Amendment) UnmarshalBSON(data []byte) error {
+	raw := bsonAmendment{}
+	err_ := bson.Unmarshal(data, &raw)
+	if err_ != nil {
+		return errs.ErrUnmarshalingBson.Throwf(applog.Log, errs.ErrFmt, err_)
+	}
+
+	v, err := decodeBSONValue(raw.Type, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*a = Amendment{
+		EventID: raw.EventID,
+		Reason:  raw.Reason,
+		Type:    raw.Type,
+		Value:   v,
+	}
+
+	return nil
+}
+
+func (a *Amendment) UnmarshalJSON(data []byte) error {
+	raw := jsonAmendment{}
+	err_ := json.Unmarshal(data, &raw)
+	if err_ != nil {
+		return errs.ErrUnmarshalingJson.Throwf(applog.Log, errs.ErrFmt, err_)
+	}
+
+	v, err := decodeJSONValue(raw.Type, raw.Value)
+	if err != nil {
+		return err
+	}
+
+	*a = Amendment{
+		EventID: raw.EventID,
+		Reason:  raw.Reason,
+		Type:    raw.Type,
+		Value:   v,
+	}
+
+	return nil
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 )
 
 type EventRepo interface {
 	Insert(ctx context.Context, e Event) errs.AppError
+	Get(ctx context.Context, id string) (*Event, errs.AppError)
 	ListEventsFromMatch(ctx context.Context, matchID string) ([]Event, errs.AppError)
 }
 
@@ -18,10 +21,25 @@ type Event struct {
 	TournamentID string
 	MatchID      string
 	Type         model.EventsMatchType
-	Value        interface{}
+	Value        Value
 	Created      time.Time
 }
 
+func NewID() string {
+	return primitive.NewObjectID().Hex()
+}
+
+func New(tournamentID, matchID string, v Value) Event {
+	return Event{
+		ID:           NewID(),
+		TournamentID: tournamentID,
+		MatchID:      matchID,
+		Type:         v.EventType(),
+		Value:        v,
+		Created:      time.Now(),
+	}
+}
+
 func (e Event) GetID() string {
 	return e.ID
 }
@@ -0,0 +1,190 @@
+package event
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+type Filter struct {
+	Types      []model.EventsMatchType
+	TeamID     string
+	PlayerID   string
+	MinuteFrom *int
+	MinuteTo   *int
+	Since      string
+}
+
+func (e Event) TeamID() string {
+	switch v := e.Value.(type) {
+	case Goal:
+		return v.Team.ID
+	case Substitution:
+		return v.Team.ID
+	case Warning:
+		return v.Team.ID
+	case Shot:
+		return v.Team.ID
+	case Corner:
+		return v.Team.ID
+	case Foul:
+		return v.Team.ID
+	case Offside:
+		return v.Team.ID
+	case Save:
+		return v.Team.ID
+	}
+	return ""
+}
+
+func (e Event) PlayerIDs() []string {
+	switch v := e.Value.(type) {
+	case Goal:
+		if v.Assist != nil {
+			return []string{v.Player.ID, v.Assist.ID}
+		}
+		return []string{v.Player.ID}
+	case Substitution:
+		return []string{v.PlayerOut.ID, v.PlayerIn.ID}
+	case Warning:
+		return []string{v.Player.ID}
+	case Shot:
+		return []string{v.Player.ID}
+	case Foul:
+		return []string{v.Player.ID}
+	case Offside:
+		return []string{v.Player.ID}
+	case Save:
+		return []string{v.Player.ID}
+	}
+	return nil
+}
+
+func (e Event) Minute() (int, bool) {
+	switch v := e.Value.(type) {
+	case Goal:
+		return v.Minute, true
+	case Substitution:
+		return v.Minute, true
+	case Warning:
+		return v.Minute, true
+	case Shot:
+		return v.Minute, true
+	case Corner:
+		return v.Minute, true
+	case Foul:
+		return v.Minute, true
+	case Offside:
+		return v.Minute, true
+	case Save:
+		return v.Minute, true
+	case Possession:
+		return v.Minute, true
+	}
+	return 0, false
+}
+
+// events stored before periods were tracked have no Period
+func (e Event) Period() model.MatchPeriod {
+	switch v := e.Value.(type) {
+	case Goal:
+		return v.Period
+	case Substitution:
+		return v.Period
+	case Warning:
+		return v.Period
+	case Extratime:
+		return v.Period
+	case Shot:
+		return v.Period
+	case Corner:
+		return v.Period
+	case Foul:
+		return v.Period
+	case Offside:
+		return v.Period
+	case Save:
+		return v.Period
+	case Possession:
+		return v.Period
+	}
+	return ""
+}
+
+// Apply expects the events in timeline order and keeps the ones matching every criteria.
+func (f Filter) Apply(events []Event) ([]Event, errs.AppError) {
+	if f.Since != "" {
+		found := false
+		for i, e := range events {
+			if e.ID == f.Since {
+				events = events[i+1:]
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, errs.ErrEventIsNotFound.Throwf(applog.Log, errs.ErrFmt, f.Since)
+		}
+	}
+
+	filtered := []Event{}
+	for _, e := range events {
+		if f.match(e) {
+			filtered = append(filtered, e)
+		}
+	}
+
+	return filtered, nil
+}
+
+func (f Filter) match(e Event) bool {
+	if len(f.Types) > 0 {
+		found := false
+		for _, t := range f.Types {
+			if e.Type == t {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if f.TeamID != "" && e.TeamID() != f.TeamID {
+		return false
+	}
+
+	if f.PlayerID != "" {
+		found := false
+		for _, id := range e.PlayerIDs() {
+			if id == f.PlayerID {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if f.MinuteFrom != nil || f.MinuteTo != nil {
+		minute, ok := e.Minute()
+		if !ok {
+			return false
+		}
+
+		if f.MinuteFrom != nil && minute < *f.MinuteFrom {
+			return false
+		}
+
+		if f.MinuteTo != nil && minute > *f.MinuteTo {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,314 @@
+package event
+
+import (
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+// Value is the payload of a match event, one concrete type per model.EventsMatchType.
+type Value interface {
+	EventType() model.EventsMatchType
+}
+
+type Start struct {
+	TimeStarted string
+}
+
+type Goal struct {
+	Team     team.Team
+	Player   player.Player
+	Period   model.MatchPeriod `json:",omitempty" bson:",omitempty"`
+	Minute   int
+	Stoppage int            `json:",omitempty" bson:",omitempty"`
+	Type     model.GoalType `json:",omitempty" bson:",omitempty"`
+	Assist   *player.Player `json:",omitempty" bson:",omitempty"`
+}
+
+type Halftime struct {
+	Halftime string
+}
+
+type SecondHalf struct {
+	TimeStarted string
+}
+
+type Extratime struct {
+	Period    model.MatchPeriod `json:",omitempty" bson:",omitempty"`
+	Extratime int
+}
+
+type Substitution struct {
+	Team       team.Team
+	PlayerOut  player.Player
+	PlayerIn   player.Player
+	Period     model.MatchPeriod `json:",omitempty" bson:",omitempty"`
+	Minute     int
+	Stoppage   int  `json:",omitempty" bson:",omitempty"`
+	Concussion bool `json:",omitempty" bson:",omitempty"`
+}
+
+type Warning struct {
+	Team     team.Team
+	Player   player.Player
+	Warning  model.Warnings
+	Period   model.MatchPeriod `json:",omitempty" bson:",omitempty"`
+	Minute   int
+	Stoppage int `json:",omitempty" bson:",omitempty"`
+}
+
+type Finish struct {
+	TimeFinished string
+	DecidedBy    model.MatchDecision `json:",omitempty" bson:",omitempty"`
+	Attendance   int                 `json:",omitempty" bson:",omitempty"`
+}
+
+type ExtraTimeFirstHalf struct {
+	TimeStarted string
+}
+
+type ExtraTimeSecondHalf struct {
+	TimeStarted string
+}
+
+type PenaltyShootout struct {
+	TimeStarted string
+}
+
+type PenaltyKick struct {
+	Team   team.Team
+	Player player.Player
+	Scored bool
+}
+
+type Lineup struct {
+	Team       team.Team
+	StartingXI []player.Player
+	Bench      []player.Player
+	Captain    player.Player
+}
+
+type Postpone struct {
+	Reason  string
+	Kickoff *time.Time `json:",omitempty" bson:",omitempty"`
+}
+
+type Suspend struct {
+	Reason        string
+	TimeSuspended string
+}
+
+type Resume struct {
+	TimeResumed string
+}
+
+type Abandon struct {
+	Reason        string
+	TimeAbandoned string
+}
+
+type Cancel struct {
+	Reason string
+}
+
+type Shot struct {
+	Team     team.Team
+	Player   player.Player
+	OnTarget bool
+	Period   model.MatchPeriod `json:",omitempty" bson:",omitempty"`
+	Minute   int
+	Stoppage int `json:",omitempty" bson:",omitempty"`
+}
+
+type Corner struct {
+	Team     team.Team
+	Period   model.MatchPeriod `json:",omitempty" bson:",omitempty"`
+	Minute   int
+	Stoppage int `json:",omitempty" bson:",omitempty"`
+}
+
+// Foul is booked to the team and player committing it
+type Foul struct {
+	Team     team.Team
+	Player   player.Player
+	Period   model.MatchPeriod `json:",omitempty" bson:",omitempty"`
+	Minute   int
+	Stoppage int `json:",omitempty" bson:",omitempty"`
+}
+
+type Offside struct {
+	Team     team.Team
+	Player   player.Player
+	Period   model.MatchPeriod `json:",omitempty" bson:",omitempty"`
+	Minute   int
+	Stoppage int `json:",omitempty" bson:",omitempty"`
+}
+
+// Save is booked to the team and goalkeeper making it
+type Save struct {
+	Team     team.Team
+	Player   player.Player
+	Period   model.MatchPeriod `json:",omitempty" bson:",omitempty"`
+	Minute   int
+	Stoppage int `json:",omitempty" bson:",omitempty"`
+}
+
+// Possession is a snapshot of the ball possession percentage of each team so far
+type Possession struct {
+	Home     int
+	Away     int
+	Period   model.MatchPeriod `json:",omitempty" bson:",omitempty"`
+	Minute   int
+	Stoppage int `json:",omitempty" bson:",omitempty"`
+}
+
+func (Start) EventType() model.EventsMatchType        { return model.EventStart }
+func (Goal) EventType() model.EventsMatchType         { return model.EventGoal }
+func (Halftime) EventType() model.EventsMatchType     { return model.EventHalftime }
+func (SecondHalf) EventType() model.EventsMatchType   { return model.EventSecondHalf }
+func (Extratime) EventType() model.EventsMatchType    { return model.EventExtratime }
+func (Substitution) EventType() model.EventsMatchType { return model.EventSubstitution }
+func (Warning) EventType() model.EventsMatchType      { return model.EventWarning }
+func (Finish) EventType() model.EventsMatchType       { return model.EventFinish }
+
+func (ExtraTimeFirstHalf) EventType() model.EventsMatchType  { return model.EventExtraTimeFirstHalf }
+func (ExtraTimeSecondHalf) EventType() model.EventsMatchType { return model.EventExtraTimeSecondHalf }
+func (PenaltyShootout) EventType() model.EventsMatchType     { return model.EventPenaltyShootout }
+func (PenaltyKick) EventType() model.EventsMatchType         { return model.EventPenaltyKick }
+func (Lineup) EventType() model.EventsMatchType              { return model.EventLineup }
+
+func (Postpone) EventType() model.EventsMatchType { return model.EventPostpone }
+func (Suspend) EventType() model.EventsMatchType  { return model.EventSuspend }
+func (Resume) EventType() model.EventsMatchType   { return model.EventResume }
+func (Abandon) EventType() model.EventsMatchType  { return model.EventAbandon }
+func (Cancel) EventType() model.EventsMatchType   { return model.EventCancel }
+
+func (Shot) EventType() model.EventsMatchType       { return model.EventShot }
+func (Corner) EventType() model.EventsMatchType     { return model.EventCorner }
+func (Foul) EventType() model.EventsMatchType       { return model.EventFoul }
+func (Offside) EventType() model.EventsMatchType    { return model.EventOffside }
+func (Save) EventType() model.EventsMatchType       { return model.EventSave }
+func (Possession) EventType() model.EventsMatchType { return model.EventPossession }
+
+func newValue(t model.EventsMatchType) (Value, errs.AppError) {
+	switch t {
+	case model.EventStart:
+		return &Start{}, nil
+	case model.EventGoal:
+		return &Goal{}, nil
+	case model.EventHalftime:
+		return &Halftime{}, nil
+	case model.EventSecondHalf:
+		return &SecondHalf{}, nil
+	case model.EventExtratime:
+		return &Extratime{}, nil
+	case model.EventSubstitution:
+		return &Substitution{}, nil
+	case model.EventWarning:
+		return &Warning{}, nil
+	case model.EventFinish:
+		return &Finish{}, nil
+	case model.EventExtraTimeFirstHalf:
+		return &ExtraTimeFirstHalf{}, nil
+	case model.EventExtraTimeSecondHalf:
+		return &ExtraTimeSecondHalf{}, nil
+	case model.EventPenaltyShootout:
+		return &PenaltyShootout{}, nil
+	case model.EventPenaltyKick:
+		return &PenaltyKick{}, nil
+	case model.EventLineup:
+		return &Lineup{}, nil
+	case model.EventPostpone:
+		return &Postpone{}, nil
+	case model.EventSuspend:
+		return &Suspend{}, nil
+	case model.EventResume:
+		return &Resume{}, nil
+	case model.EventAbandon:
+		return &Abandon{}, nil
+	case model.EventCancel:
+		return &Cancel{}, nil
+	case model.EventShot:
+		return &Shot{}, nil
+	case model.EventCorner:
+		return &Corner{}, nil
+	case model.EventFoul:
+		return &Foul{}, nil
+	case model.EventOffside:
+		return &Offside{}, nil
+	case model.EventSave:
+		return &Save{}, nil
+	case model.EventPossession:
+		return &Possession{}, nil
+	case model.EventRetraction:
+		return &Retraction{}, nil
+	case model.EventAmendment:
+		return &Amendment{}, nil
+	default:
+		return nil, errs.ErrUnknownEventType.Throwf(applog.Log, errs.ErrFmt, t)
+	}
+}
+
+// deref turns the pointer handed out by newValue back into the value type
+// the handlers build, so decoded events compare equal to the ones written.
+func deref(v Value) Value {
+	switch val := v.(type) {
+	case *Start:
+		return *val
+	case *Goal:
+		return *val
+	case *Halftime:
+		return *val
+	case *SecondHalf:
+		return *val
+	case *Extratime:
+		return *val
+	case *Substitution:
+		return *val
+	case *Warning:
+		return *val
+	case *Finish:
+		return *val
+	case *ExtraTimeFirstHalf:
+		return *val
+	case *ExtraTimeSecondHalf:
+		return *val
+	case *PenaltyShootout:
+		return *val
+	case *PenaltyKick:
+		return *val
+	case *Lineup:
+		return *val
+	case *Postpone:
+		return *val
+	case *Suspend:
+		return *val
+	case *Resume:
+		return *val
+	case *Abandon:
+		return *val
+	case *Cancel:
+		return *val
+	case *Shot:
+		return *val
+	case *Corner:
+		return *val
+	case *Foul:
+		return *val
+	case *Offside:
+		return *val
+	case *Save:
+		return *val
+	case *Possession:
+		return *val
+	case *Retraction:
+		return *val
+	case *Amendment:
+		return *val
+	}
+	return v
+}
@@ -0,0 +1,26 @@
+package match
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+)
+
+// CheckAttendance fails when the attendance recorded on finish is over the
+// capacity of the match venue, matches without a venue take any attendance.
+func (mt Match) CheckAttendance(e event.Event) errs.AppError {
+	finish, ok := e.Value.(event.Finish)
+	if !ok {
+		return nil
+	}
+
+	if finish.Attendance < 0 {
+		return errs.ErrValidation.Throwf(applog.Log, errs.ErrFmt, "attendance cannot be negative")
+	}
+
+	if mt.Venue != nil && mt.Venue.Capacity > 0 && finish.Attendance > mt.Venue.Capacity {
+		return errs.ErrAttendanceOverCapacity.Throwf(applog.Log, errs.ErrFmtMore, finish.Attendance, mt.Venue.Capacity)
+	}
+
+	return nil
+}
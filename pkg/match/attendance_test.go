@@ -0,0 +1,69 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func TestApplyEventAttendance(t *testing.T) {
+	stadium := &venue.Venue{ID: "1", Capacity: 1000}
+
+	testCases := []struct {
+		Name               string
+		Venue              *venue.Venue
+		Attendance         int
+		ExpectError        bool
+		ExpectedAttendance int
+	}{
+		{
+			Name:               "Should record the attendance",
+			Venue:              stadium,
+			Attendance:         800,
+			ExpectedAttendance: 800,
+		}, {
+			Name:               "Should record a full venue",
+			Venue:              stadium,
+			Attendance:         1000,
+			ExpectedAttendance: 1000,
+		}, {
+			Name:        "Should not record an attendance over the capacity",
+			Venue:       stadium,
+			Attendance:  1001,
+			ExpectError: true,
+		}, {
+			Name:        "Should not record a negative attendance",
+			Venue:       stadium,
+			Attendance:  -1,
+			ExpectError: true,
+		}, {
+			Name:               "Should record any attendance without a venue",
+			Attendance:         50000,
+			ExpectedAttendance: 50000,
+		}, {
+			Name:  "Should finish without an attendance",
+			Venue: stadium,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		mt := Match{ID: "1", Venue: tc.Venue, Status: model.MatchStatusInProgress, Period: model.PeriodSecondHalf}
+
+		err := mt.ApplyEvent(event.New("1", "1", event.Finish{TimeFinished: "17:50", Attendance: tc.Attendance}))
+		if tc.ExpectError {
+			assert.NotNil(t, err)
+			assert.Equal(t, model.MatchStatusInProgress, mt.Status)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, model.MatchStatusFinished, mt.Status)
+		assert.Equal(t, tc.ExpectedAttendance, mt.Attendance)
+	}
+}
@@ -0,0 +1,222 @@
+package match
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+// Bracket places a knockout match in the bracket of its tournament, the
+// winner and the loser go on to the slots they fill in their next match.
+type Bracket struct {
+	Winner     *Slot `json:",omitempty" bson:",omitempty"`
+	Loser      *Slot `json:",omitempty" bson:",omitempty"`
+	ThirdPlace bool  `json:",omitempty" bson:",omitempty"`
+}
+
+// Slot is the home or the away team of a bracket match.
+type Slot struct {
+	MatchID string
+	Home    bool
+}
+
+// BracketOptions seeds the teams in the Seeds order, the teams not in Seeds
+// follow in the tournament order. ThirdPlace adds a playoff for the losers of
+// the semi-finals when there are at least four teams.
+type BracketOptions struct {
+	Schedule
+	Seeds      []string
+	ThirdPlace bool
+}
+
+// GenerateBracket builds the knockout bracket of the tournament teams. The
+// bracket is as big as the next power of two, the top seeds get the byes and
+// go straight to the second round.
+func GenerateBracket(t tournament.Tournament, opts BracketOptions) ([]Match, errs.AppError) {
+	if len(t.Teams) < 2 {
+		return nil, errs.ErrFixturesNotEnoughTeams.Throwf(applog.Log, errs.ErrFmt, len(t.Teams))
+	}
+
+	err := opts.Schedule.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	seeded, err := seedTeams(t, opts.Seeds)
+	if err != nil {
+		return nil, err
+	}
+
+	size := 2
+	for size < len(seeded) {
+		size *= 2
+	}
+
+	// every match of the bracket, the first round keeps the matches of a bye
+	// until their team is placed in the second round
+	rounds := [][]Match{}
+	for n := size / 2; n >= 1; n /= 2 {
+		round := make([]Match, n)
+		for i := range round {
+			round[i] = Match{ID: NewID(), Bracket: &Bracket{}}
+		}
+		rounds = append(rounds, round)
+	}
+
+	for r := 0; r < len(rounds)-1; r++ {
+		for i := range rounds[r] {
+			rounds[r][i].Bracket.Winner = &Slot{MatchID: rounds[r+1][i/2].ID, Home: i%2 == 0}
+		}
+	}
+
+	var thirdPlace *Match
+	if opts.ThirdPlace && len(seeded) >= 4 {
+		thirdPlace = &Match{ID: NewID(), Bracket: &Bracket{ThirdPlace: true}}
+
+		semis := rounds[len(rounds)-2]
+		for i := range semis {
+			semis[i].Bracket.Loser = &Slot{MatchID: thirdPlace.ID, Home: i == 0}
+		}
+	}
+
+	positions := SeedPositions(size)
+	byes := map[int]bool{}
+	for i := range rounds[0] {
+		home, away := positions[2*i], positions[2*i+1]
+
+		if away > len(seeded) {
+			byes[i] = true
+			rounds[0][i].Bracket.Winner.fill(rounds[1], seeded[home-1])
+			continue
+		}
+
+		rounds[0][i].HomeTeam = seeded[home-1]
+		rounds[0][i].AwayTeam = seeded[away-1]
+	}
+
+	matches := []Match{}
+	for r, round := range rounds {
+		final := r == len(rounds)-1
+
+		played := []Match{}
+		if final && thirdPlace != nil {
+			played = append(played, *thirdPlace)
+		}
+		for i, mt := range round {
+			if r == 0 && byes[i] {
+				continue
+			}
+			played = append(played, mt)
+		}
+
+		for i, mt := range played {
+			scheduled, err := newFixture(t, mt.HomeTeam, mt.AwayTeam, opts.Schedule, r, i)
+			if err != nil {
+				return nil, err
+			}
+
+			scheduled.ID = mt.ID
+			scheduled.Bracket = mt.Bracket
+			matches = append(matches, scheduled)
+		}
+	}
+
+	return matches, nil
+}
+
+// SeedPositions returns the seeds of a bracket of the given size in the order
+// they are drawn, so the top seeds can only meet in the last rounds.
+func SeedPositions(size int) []int {
+	positions := []int{1}
+	for n := 2; n <= size; n *= 2 {
+		next := []int{}
+		for _, seed := range positions {
+			next = append(next, seed, n+1-seed)
+		}
+		positions = next
+	}
+	return positions
+}
+
+// FillSlot puts the team in the home or the away slot of the bracket match, a
+// match already started cannot change its teams.
+func (mt *Match) FillSlot(home bool, tm team.Team) errs.AppError {
+	slot := mt.AwayTeam
+	if home {
+		slot = mt.HomeTeam
+	}
+
+	// a redelivered finish event fills the slot again
+	if slot.ID == tm.ID {
+		return nil
+	}
+
+	if mt.Started() {
+		return errs.ErrBracketMatchStarted.Throwf(applog.Log, errs.ErrFmt, mt.ID)
+	}
+
+	if home {
+		mt.HomeTeam = tm
+		if mt.Venue == nil {
+			mt.Venue = tm.Venue
+		}
+	} else {
+		mt.AwayTeam = tm
+	}
+
+	return nil
+}
+
+// Result returns the winner and the loser of a finished match, a draw has
+// neither.
+func (mt Match) Result() (team.Team, team.Team, bool) {
+	switch mt.Score.Winner {
+	case "":
+		return team.Team{}, team.Team{}, false
+	case mt.AwayTeam.ID:
+		return mt.AwayTeam, mt.HomeTeam, true
+	}
+	return mt.HomeTeam, mt.AwayTeam, true
+}
+
+func (s *Slot) fill(round []Match, tm team.Team) {
+	for i := range round {
+		if round[i].ID != s.MatchID {
+			continue
+		}
+
+		if s.Home {
+			round[i].HomeTeam = tm
+		} else {
+			round[i].AwayTeam = tm
+		}
+	}
+}
+
+func seedTeams(t tournament.Tournament, seeds []string) ([]team.Team, errs.AppError) {
+	teams := map[string]team.Team{}
+	for _, tm := range t.Teams {
+		teams[tm.ID] = tm
+	}
+
+	seeded := []team.Team{}
+	used := map[string]bool{}
+	for _, id := range seeds {
+		tm, ok := teams[id]
+		if !ok || used[id] {
+			return nil, errs.ErrBracketSeedInvalid.Throwf(applog.Log, errs.ErrFmt, id)
+		}
+
+		seeded = append(seeded, tm)
+		used[id] = true
+	}
+
+	for _, tm := range t.Teams {
+		if !used[tm.ID] {
+			seeded = append(seeded, tm)
+		}
+	}
+
+	return seeded, nil
+}
@@ -0,0 +1,192 @@
+package match
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func bracketTeams(n int) []team.Team {
+	teams := []team.Team{}
+	for i := 1; i <= n; i++ {
+		teams = append(teams, team.Team{ID: fmt.Sprintf("t%d", i)})
+	}
+	return teams
+}
+
+func bracketOptions() BracketOptions {
+	return BracketOptions{
+		Schedule: Schedule{
+			StartDate:         time.Date(2024, 8, 10, 0, 0, 0, 0, time.UTC),
+			DaysBetweenRounds: 7,
+			KickoffSlots:      []time.Duration{15 * time.Hour, 20 * time.Hour},
+		},
+	}
+}
+
+func TestSeedPositions(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, SeedPositions(2))
+	assert.Equal(t, []int{1, 4, 2, 3}, SeedPositions(4))
+	assert.Equal(t, []int{1, 8, 4, 5, 2, 7, 3, 6}, SeedPositions(8))
+}
+
+func TestGenerateBracket(t *testing.T) {
+	for n := 2; n <= 16; n++ {
+		t.Logf("Bracket of %d teams", n)
+
+		cup := tournament.Tournament{ID: "1", Teams: bracketTeams(n)}
+		matches, err := GenerateBracket(cup, bracketOptions())
+		assert.Nil(t, err)
+		assert.Len(t, matches, n-1)
+
+		byID := map[string]Match{}
+		for _, mt := range matches {
+			byID[mt.ID] = mt
+		}
+
+		finals := 0
+		feeds := map[Slot]int{}
+		for _, mt := range matches {
+			assert.NotNil(t, mt.Bracket)
+			assert.Equal(t, model.MatchStatusNotStart, mt.Status)
+
+			if mt.Bracket.Winner == nil {
+				finals++
+				continue
+			}
+
+			next, ok := byID[mt.Bracket.Winner.MatchID]
+			assert.True(t, ok)
+			assert.Equal(t, mt.Round+1, next.Round)
+			feeds[*mt.Bracket.Winner]++
+		}
+		assert.Equal(t, 1, finals)
+
+		// a slot is filled by a match or by a team with a bye, never both
+		for slot, count := range feeds {
+			assert.Equal(t, 1, count)
+			next := byID[slot.MatchID]
+			if slot.Home {
+				assert.Empty(t, next.HomeTeam.ID)
+			} else {
+				assert.Empty(t, next.AwayTeam.ID)
+			}
+		}
+	}
+}
+
+func TestGenerateBracketSeedsAndByes(t *testing.T) {
+	london := &venue.Venue{ID: "1", TimeZone: "Europe/London"}
+
+	teams := bracketTeams(6)
+	teams[4].Venue = london
+	cup := tournament.Tournament{ID: "1", Teams: teams}
+
+	opts := bracketOptions()
+	opts.Seeds = []string{"t5", "t6"}
+	opts.ThirdPlace = true
+
+	matches, err := GenerateBracket(cup, opts)
+	assert.Nil(t, err)
+	assert.Len(t, matches, 6)
+
+	// seeds 1 and 2 get the byes, seeds 3 to 6 play the first round
+	first := matches[:2]
+	assert.Equal(t, 1, first[0].Round)
+	assert.Equal(t, "t2", first[0].HomeTeam.ID)
+	assert.Equal(t, "t3", first[0].AwayTeam.ID)
+	assert.Equal(t, "t1", first[1].HomeTeam.ID)
+	assert.Equal(t, "t4", first[1].AwayTeam.ID)
+
+	semis := matches[2:4]
+	assert.Equal(t, 2, semis[0].Round)
+	assert.Equal(t, "t5", semis[0].HomeTeam.ID)
+	assert.Equal(t, london, semis[0].Venue)
+	assert.Equal(t, "Europe/London", semis[0].TimeZone)
+	assert.Empty(t, semis[0].AwayTeam.ID)
+	assert.Equal(t, "t6", semis[1].HomeTeam.ID)
+	assert.Equal(t, &Slot{MatchID: semis[0].ID, Home: false}, first[0].Bracket.Winner)
+	assert.Equal(t, &Slot{MatchID: semis[1].ID, Home: false}, first[1].Bracket.Winner)
+
+	thirdPlace, final := matches[4], matches[5]
+	assert.True(t, thirdPlace.Bracket.ThirdPlace)
+	assert.Equal(t, 3, thirdPlace.Round)
+	assert.Equal(t, 3, final.Round)
+	assert.Nil(t, final.Bracket.Winner)
+	assert.Equal(t, &Slot{MatchID: thirdPlace.ID, Home: true}, semis[0].Bracket.Loser)
+	assert.Equal(t, &Slot{MatchID: thirdPlace.ID, Home: false}, semis[1].Bracket.Loser)
+	assert.Equal(t, &Slot{MatchID: final.ID, Home: true}, semis[0].Bracket.Winner)
+	assert.Equal(t, time.Date(2024, 8, 24, 15, 0, 0, 0, time.UTC), thirdPlace.Kickoff)
+	assert.Equal(t, time.Date(2024, 8, 24, 20, 0, 0, 0, time.UTC), final.Kickoff)
+}
+
+func TestGenerateBracketInvalid(t *testing.T) {
+	cup := tournament.Tournament{ID: "1", Teams: bracketTeams(4)}
+
+	_, err := GenerateBracket(tournament.Tournament{Teams: bracketTeams(1)}, bracketOptions())
+	assert.NotNil(t, err)
+
+	unknownSeed := bracketOptions()
+	unknownSeed.Seeds = []string{"t9"}
+	_, err = GenerateBracket(cup, unknownSeed)
+	assert.NotNil(t, err)
+
+	repeatedSeed := bracketOptions()
+	repeatedSeed.Seeds = []string{"t1", "t1"}
+	_, err = GenerateBracket(cup, repeatedSeed)
+	assert.NotNil(t, err)
+
+	noSlots := bracketOptions()
+	noSlots.KickoffSlots = nil
+	_, err = GenerateBracket(cup, noSlots)
+	assert.NotNil(t, err)
+
+	// three teams have no semi-final losers to play for the third place
+	thirdPlace := bracketOptions()
+	thirdPlace.ThirdPlace = true
+	matches, err := GenerateBracket(tournament.Tournament{Teams: bracketTeams(3)}, thirdPlace)
+	assert.Nil(t, err)
+	assert.Len(t, matches, 2)
+}
+
+func TestBracketMatch(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	mt := Match{ID: "1", HomeTeam: home, Status: model.MatchStatusNotStart, Bracket: &Bracket{}}
+
+	apply := func(v event.Value) errs.AppError {
+		return mt.ApplyEvent(event.New("1", "1", v))
+	}
+
+	assert.NotNil(t, apply(event.Start{TimeStarted: "16:00"}))
+	assert.Nil(t, mt.FillSlot(false, away))
+	assert.Nil(t, apply(event.Start{TimeStarted: "16:00"}))
+	assert.Nil(t, mt.FillSlot(false, away))
+	assert.NotNil(t, mt.FillSlot(false, home))
+
+	assert.Nil(t, apply(event.Halftime{Halftime: "16:45"}))
+	assert.Nil(t, apply(event.SecondHalf{TimeStarted: "17:00"}))
+	assert.NotNil(t, apply(event.Finish{TimeFinished: "17:50"}))
+
+	_, _, ok := mt.Result()
+	assert.False(t, ok)
+
+	assert.Nil(t, apply(event.Goal{Team: away, Player: player.Player{ID: "p1"}, Minute: 80}))
+	assert.Nil(t, apply(event.Finish{TimeFinished: "17:50"}))
+
+	winner, loser, ok := mt.Result()
+	assert.True(t, ok)
+	assert.Equal(t, away, winner)
+	assert.Equal(t, home, loser)
+}
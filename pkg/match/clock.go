@@ -0,0 +1,101 @@
+package match
+
+import (
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+type Clock struct {
+	Period   model.MatchPeriod
+	Minute   int
+	Stoppage int `json:",omitempty" bson:",omitempty"`
+	Running  bool
+}
+
+// periodPlayed returns how long the ball was in play in the current period,
+// leaving out the time the match was suspended
+func (mt Match) periodPlayed(now time.Time) (time.Duration, bool) {
+	var played time.Duration
+	var resumed time.Time
+	found, running := false, false
+
+	for _, e := range mt.Events {
+		tr, ok := eventTransitions[e.Type]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case tr.To == model.MatchStatusInProgress && tr.Period != "":
+			played, resumed = 0, e.Created
+			found, running = true, true
+		case e.Type == model.EventResume:
+			resumed, running = e.Created, true
+		case e.Type == model.EventSuspend && running:
+			played += e.Created.Sub(resumed)
+			running = false
+		}
+	}
+
+	if running {
+		played += now.Sub(resumed)
+	}
+
+	return played, found
+}
+
+// ClockAt computes the live clock from the time the current period started,
+// past the end of the period the minutes count as stoppage time. The clock
+// stops at the end of the period during halftime and while the match is
+// suspended, there is no clock before the start, in the penalty shootout or
+// once the match is over.
+func (mt Match) ClockAt(now time.Time) *Clock {
+	period := mt.CurrentPeriod()
+	minutes, ok := periodsMinutes[period]
+	if !ok {
+		return nil
+	}
+
+	switch mt.Status {
+	case model.MatchStatusHalftime:
+		return &Clock{Period: period, Minute: minutes.To}
+	case model.MatchStatusInProgress, model.MatchStatusSuspended:
+	default:
+		return nil
+	}
+
+	played, ok := mt.periodPlayed(now)
+	if !ok {
+		return nil
+	}
+
+	elapsed := int(played / time.Minute)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	running := mt.Status == model.MatchStatusInProgress
+	clock := &Clock{Period: period, Minute: minutes.From + elapsed, Running: running}
+	if clock.Minute > minutes.To {
+		clock.Stoppage = clock.Minute - minutes.To
+		clock.Minute = minutes.To
+	}
+
+	return clock
+}
+
+// EventMinute returns the minute of an event, an omitted minute defaults to
+// the live clock.
+func (mt Match) EventMinute(minute, stoppage int, now time.Time) (int, int) {
+	if minute != 0 {
+		return minute, stoppage
+	}
+
+	clock := mt.ClockAt(now)
+	if clock == nil {
+		return minute, stoppage
+	}
+
+	return clock.Minute, clock.Stoppage
+}
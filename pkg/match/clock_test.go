@@ -0,0 +1,118 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+func TestClockAt(t *testing.T) {
+	kickoff := time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC)
+
+	at := func(v event.Value, created time.Time) event.Event {
+		e := event.New("1", "1", v)
+		e.Created = created
+		return e
+	}
+
+	newMatch := func(events ...event.Event) Match {
+		mt := Match{ID: "1", Status: model.MatchStatusNotStart}
+		for _, e := range events {
+			assert.Nil(t, mt.ApplyEvent(e))
+		}
+		return mt
+	}
+
+	start := at(event.Start{TimeStarted: "16:00"}, kickoff)
+	halftime := at(event.Halftime{Halftime: "16:47"}, kickoff.Add(47*time.Minute))
+	secondHalf := at(event.SecondHalf{TimeStarted: "17:02"}, kickoff.Add(62*time.Minute))
+	finish := at(event.Finish{TimeFinished: "17:50"}, kickoff.Add(110*time.Minute))
+	suspend := at(event.Suspend{Reason: "Floodlight failure", TimeSuspended: "16:20"}, kickoff.Add(20*time.Minute))
+	resume := at(event.Resume{TimeResumed: "16:50"}, kickoff.Add(50*time.Minute))
+
+	testCases := []struct {
+		Name          string
+		Match         Match
+		Now           time.Time
+		ExpectedClock *Clock
+	}{
+		{
+			Name:          "Match not started",
+			Match:         newMatch(),
+			Now:           kickoff,
+			ExpectedClock: nil,
+		}, {
+			Name:          "First minute",
+			Match:         newMatch(start),
+			Now:           kickoff.Add(30 * time.Second),
+			ExpectedClock: &Clock{Period: model.PeriodFirstHalf, Minute: 1, Running: true},
+		}, {
+			Name:          "First half",
+			Match:         newMatch(start),
+			Now:           kickoff.Add(20*time.Minute + 10*time.Second),
+			ExpectedClock: &Clock{Period: model.PeriodFirstHalf, Minute: 21, Running: true},
+		}, {
+			Name:          "First half stoppage time",
+			Match:         newMatch(start),
+			Now:           kickoff.Add(46 * time.Minute),
+			ExpectedClock: &Clock{Period: model.PeriodFirstHalf, Minute: 45, Stoppage: 2, Running: true},
+		}, {
+			Name:          "Halftime",
+			Match:         newMatch(start, halftime),
+			Now:           kickoff.Add(55 * time.Minute),
+			ExpectedClock: &Clock{Period: model.PeriodFirstHalf, Minute: 45},
+		}, {
+			Name:          "Second half",
+			Match:         newMatch(start, halftime, secondHalf),
+			Now:           kickoff.Add(72 * time.Minute),
+			ExpectedClock: &Clock{Period: model.PeriodSecondHalf, Minute: 56, Running: true},
+		}, {
+			Name:          "Suspended",
+			Match:         newMatch(start, suspend),
+			Now:           kickoff.Add(35 * time.Minute),
+			ExpectedClock: &Clock{Period: model.PeriodFirstHalf, Minute: 21},
+		}, {
+			Name:          "Resumed after a suspension",
+			Match:         newMatch(start, suspend, resume),
+			Now:           kickoff.Add(60 * time.Minute),
+			ExpectedClock: &Clock{Period: model.PeriodFirstHalf, Minute: 31, Running: true},
+		}, {
+			Name:          "Match finished",
+			Match:         newMatch(start, halftime, secondHalf, finish),
+			Now:           kickoff.Add(120 * time.Minute),
+			ExpectedClock: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		assert.Equal(t, tc.ExpectedClock, tc.Match.ClockAt(tc.Now))
+	}
+}
+
+func TestEventMinute(t *testing.T) {
+	kickoff := time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC)
+
+	start := event.New("1", "1", event.Start{TimeStarted: "16:00"})
+	start.Created = kickoff
+
+	mt := Match{ID: "1", Status: model.MatchStatusNotStart}
+	assert.Nil(t, mt.ApplyEvent(start))
+
+	minute, stoppage := mt.EventMinute(30, 0, kickoff.Add(10*time.Minute))
+	assert.Equal(t, 30, minute)
+	assert.Equal(t, 0, stoppage)
+
+	minute, stoppage = mt.EventMinute(0, 0, kickoff.Add(10*time.Minute))
+	assert.Equal(t, 11, minute)
+	assert.Equal(t, 0, stoppage)
+
+	minute, stoppage = mt.EventMinute(0, 0, kickoff.Add(47*time.Minute))
+	assert.Equal(t, 45, minute)
+	assert.Equal(t, 3, stoppage)
+}
@@ -0,0 +1,74 @@
+package match
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+type PlayerCards struct {
+	TeamID   string
+	PlayerID string
+	Player   string
+	Yellow   int
+	Red      bool
+}
+
+// ComputeCards keeps the cards of each player in the order they were first booked,
+// a second yellow card is a red card.
+func (mt *Match) ComputeCards() []PlayerCards {
+	cards := []PlayerCards{}
+
+	for _, e := range mt.Events {
+		warning, ok := e.Value.(event.Warning)
+		if !ok {
+			continue
+		}
+
+		i := findPlayerCards(cards, warning.Player.ID)
+		if i < 0 {
+			cards = append(cards, PlayerCards{
+				TeamID:   warning.Team.ID,
+				PlayerID: warning.Player.ID,
+				Player:   warning.Player.Name,
+			})
+			i = len(cards) - 1
+		}
+
+		switch warning.Warning {
+		case model.WarningYellowCard:
+			cards[i].Yellow++
+			if cards[i].Yellow >= 2 {
+				cards[i].Red = true
+			}
+		case model.WarningRedCard:
+			cards[i].Red = true
+		}
+	}
+
+	return cards
+}
+
+func findPlayerCards(cards []PlayerCards, playerID string) int {
+	for i, c := range cards {
+		if c.PlayerID == playerID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (mt Match) IsSentOff(playerID string) bool {
+	i := findPlayerCards(mt.Cards, playerID)
+	return i >= 0 && mt.Cards[i].Red
+}
+
+func (mt Match) CheckSentOff(e event.Event) errs.AppError {
+	for _, playerID := range e.PlayerIDs() {
+		if mt.IsSentOff(playerID) {
+			return errs.ErrPlayerIsSentOff.Throwf(applog.Log, errs.ErrFmt, playerID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+func TestComputeCards(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	p1 := player.Player{ID: "p1", Name: "Player 1"}
+	p2 := player.Player{ID: "p2", Name: "Player 2"}
+	p3 := player.Player{ID: "p3", Name: "Player 3"}
+
+	mt := Match{
+		ID:       "1",
+		HomeTeam: home,
+		AwayTeam: away,
+		Status:   model.MatchStatusInProgress,
+	}
+
+	mt.AddEvent(event.New("1", "1", event.Warning{Team: home, Player: p1, Warning: model.WarningYellowCard, Minute: 10}))
+	mt.AddEvent(event.New("1", "1", event.Warning{Team: away, Player: p2, Warning: model.WarningYellowCard, Minute: 20}))
+	mt.AddEvent(event.New("1", "1", event.Warning{Team: home, Player: p1, Warning: model.WarningYellowCard, Minute: 30}))
+	redCard := event.New("1", "1", event.Warning{Team: away, Player: p3, Warning: model.WarningRedCard, Minute: 40})
+	mt.AddEvent(redCard)
+
+	assert.Equal(t, []PlayerCards{
+		{TeamID: "home", PlayerID: "p1", Player: "Player 1", Yellow: 2, Red: true},
+		{TeamID: "away", PlayerID: "p2", Player: "Player 2", Yellow: 1},
+		{TeamID: "away", PlayerID: "p3", Player: "Player 3", Red: true},
+	}, mt.Cards)
+	assert.True(t, mt.IsSentOff("p1"))
+	assert.False(t, mt.IsSentOff("p2"))
+	assert.True(t, mt.IsSentOff("p3"))
+
+	mt.AddEvent(event.New("1", "1", event.Retraction{EventID: redCard.ID, Reason: "Rescinded"}))
+	assert.False(t, mt.IsSentOff("p3"))
+}
+
+func TestApplyEventSentOff(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	p1 := player.Player{ID: "p1", Name: "Player 1"}
+	p2 := player.Player{ID: "p2", Name: "Player 2"}
+
+	mt := Match{
+		ID:       "1",
+		HomeTeam: home,
+		AwayTeam: away,
+		Status:   model.MatchStatusInProgress,
+	}
+
+	assert.Nil(t, mt.ApplyEvent(event.New("1", "1", event.Warning{Team: home, Player: p1, Warning: model.WarningRedCard, Minute: 10})))
+
+	testCases := []struct {
+		Name          string
+		Event         event.Event
+		ExpectedError bool
+	}{
+		{
+			Name:          "Goal by a sent off player",
+			Event:         event.New("1", "1", event.Goal{Team: home, Player: p1, Minute: 20}),
+			ExpectedError: true,
+		}, {
+			Name:          "Goal assisted by a sent off player",
+			Event:         event.New("1", "1", event.Goal{Team: home, Player: p2, Minute: 20, Assist: &p1}),
+			ExpectedError: true,
+		}, {
+			Name:          "Substitution of a sent off player",
+			Event:         event.New("1", "1", event.Substitution{Team: home, PlayerOut: p1, PlayerIn: p2, Minute: 20}),
+			ExpectedError: true,
+		}, {
+			Name:          "Warning to a sent off player",
+			Event:         event.New("1", "1", event.Warning{Team: home, Player: p1, Warning: model.WarningYellowCard, Minute: 20}),
+			ExpectedError: true,
+		}, {
+			Name:          "Goal by another player",
+			Event:         event.New("1", "1", event.Goal{Team: home, Player: p2, Minute: 20}),
+			ExpectedError: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		err := mt.ApplyEvent(tc.Event)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package match
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+// Schedule plays the rounds DaysBetweenRounds apart from the StartDate, the
+// matches of a round take the KickoffSlots in turn. The slots are local to the
+// TimeZone, or to the home venue when there is none.
+type Schedule struct {
+	StartDate         time.Time
+	DaysBetweenRounds int
+	KickoffSlots      []time.Duration
+	TimeZone          string
+}
+
+type FixtureOptions struct {
+	Schedule
+	Double bool
+}
+
+// NewID returns the id of a match that isn't stored yet.
+func NewID() string {
+	return primitive.NewObjectID().Hex()
+}
+
+// CheckFixtures rejects generating the fixtures again once the tournament has
+// round-robin matches, the knockout matches have no round.
+func CheckFixtures(t tournament.Tournament, matches []Match) errs.AppError {
+	for _, mt := range matches {
+		if mt.Round > 0 && mt.Bracket == nil && mt.IsTheMatchForTournament(t.ID) {
+			return errs.ErrFixturesAlreadyExist.Throwf(applog.Log, errs.ErrFmt, t.ID)
+		}
+	}
+	return nil
+}
+
+// GenerateFixtures builds the round-robin of the tournament teams, a double
+// round-robin plays the second half with the home and away teams swapped. A
+// tournament with groups plays a round-robin in each group, the groups play
+// their rounds on the same days.
+func GenerateFixtures(t tournament.Tournament, opts FixtureOptions) ([]Match, errs.AppError) {
+	groups := t.Groups
+	if len(groups) == 0 {
+		groups = []tournament.Group{{Teams: t.Teams}}
+	}
+
+	for _, g := range groups {
+		if len(g.Teams) < 2 {
+			return nil, errs.ErrFixturesNotEnoughTeams.Throwf(applog.Log, errs.ErrFmt, len(g.Teams))
+		}
+	}
+
+	err := opts.Schedule.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	rounds := make([][][][2]int, len(groups))
+	maxRounds := 0
+	for i, g := range groups {
+		rounds[i] = fixtureRounds(len(g.Teams), opts.Double)
+		if len(rounds[i]) > maxRounds {
+			maxRounds = len(rounds[i])
+		}
+	}
+
+	matches := []Match{}
+	for r := 0; r < maxRounds; r++ {
+		i := 0
+		for gi, g := range groups {
+			if r >= len(rounds[gi]) {
+				continue
+			}
+
+			for _, pair := range rounds[gi][r] {
+				mt, err := newFixture(t, g.Teams[pair[0]], g.Teams[pair[1]], opts.Schedule, r, i)
+				if err != nil {
+					return nil, err
+				}
+
+				mt.Group = g.Name
+				matches = append(matches, mt)
+				i++
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func fixtureRounds(n int, double bool) [][][2]int {
+	rounds := RoundRobin(n)
+	if double {
+		for _, round := range RoundRobin(n) {
+			second := [][2]int{}
+			for _, pair := range round {
+				second = append(second, [2]int{pair[1], pair[0]})
+			}
+			rounds = append(rounds, second)
+		}
+	}
+	return rounds
+}
+
+// RoundRobin pairs n teams by their index with the circle method, the first
+// position stays while the others rotate. An odd number of teams keeps the
+// bye in the first position so every team is at home as often as away.
+func RoundRobin(n int) [][][2]int {
+	size := n
+	if size%2 == 1 {
+		size++
+	}
+
+	positions := make([]int, size)
+	for i := range positions {
+		positions[i] = i - (size - n)
+	}
+
+	rounds := [][][2]int{}
+	for r := 0; r < size-1; r++ {
+		round := [][2]int{}
+		for i := 0; i < size/2; i++ {
+			home, away := positions[i], positions[size-1-i]
+			if (i == 0 && r%2 == 1) || i%2 == 1 {
+				home, away = away, home
+			}
+
+			if home < 0 || away < 0 {
+				continue
+			}
+
+			round = append(round, [2]int{home, away})
+		}
+		rounds = append(rounds, round)
+
+		last := positions[size-1]
+		copy(positions[2:], positions[1:size-1])
+		positions[1] = last
+	}
+
+	return rounds
+}
+
+func (s Schedule) validate() errs.AppError {
+	if s.DaysBetweenRounds < 1 || len(s.KickoffSlots) == 0 {
+		return errs.ErrValidation.Throwf(applog.Log, "fixtures need the days between rounds and the kickoff slots: [%v - %v]", s.DaysBetweenRounds, s.KickoffSlots)
+	}
+	return nil
+}
+
+func newFixture(t tournament.Tournament, home, away team.Team, s Schedule, round, i int) (Match, errs.AppError) {
+	timeZone := s.TimeZone
+	if timeZone == "" && home.Venue != nil {
+		timeZone = home.Venue.TimeZone
+	}
+
+	loc := time.UTC
+	if timeZone != "" {
+		var err_ error
+		loc, err_ = time.LoadLocation(timeZone)
+		if err_ != nil {
+			return Match{}, errs.ErrLoadingTimeZone.Throwf(applog.Log, errs.ErrFmt, err_.Error())
+		}
+	}
+
+	slot := s.KickoffSlots[i%len(s.KickoffSlots)]
+	year, month, day := s.StartDate.Date()
+	kickoff := time.Date(year, month, day+round*s.DaysBetweenRounds, int(slot/time.Hour), int(slot%time.Hour/time.Minute), 0, 0, loc)
+
+	mt := Match{
+		ID:         NewID(),
+		Tournament: t,
+		HomeTeam:   home,
+		AwayTeam:   away,
+		Kickoff:    kickoff.UTC(),
+		TimeZone:   timeZone,
+		Venue:      home.Venue,
+		Round:      round + 1,
+		Status:     model.MatchStatusNotStart,
+	}
+	mt.Score = mt.ComputeScore()
+
+	return mt, nil
+}
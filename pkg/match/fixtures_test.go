@@ -0,0 +1,201 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func TestRoundRobin(t *testing.T) {
+	for n := 2; n <= 10; n++ {
+		t.Logf("Round-robin of %d teams", n)
+
+		rounds := RoundRobin(n)
+
+		expectedRounds := n - 1
+		if n%2 == 1 {
+			expectedRounds = n
+		}
+		assert.Len(t, rounds, expectedRounds)
+
+		pairs := map[[2]int]bool{}
+		homes := make([]int, n)
+		for _, round := range rounds {
+			playing := map[int]bool{}
+			for _, pair := range round {
+				assert.False(t, playing[pair[0]] || playing[pair[1]])
+				playing[pair[0]] = true
+				playing[pair[1]] = true
+
+				key := [2]int{pair[0], pair[1]}
+				if key[0] > key[1] {
+					key = [2]int{pair[1], pair[0]}
+				}
+				assert.False(t, pairs[key])
+				pairs[key] = true
+
+				homes[pair[0]]++
+			}
+		}
+		assert.Len(t, pairs, n*(n-1)/2)
+
+		for _, h := range homes {
+			assert.LessOrEqual(t, h, n/2)
+			assert.GreaterOrEqual(t, h, (n-1)/2)
+		}
+	}
+}
+
+func TestGenerateFixtures(t *testing.T) {
+	london := &venue.Venue{ID: "1", TimeZone: "Europe/London"}
+
+	teams := []team.Team{{ID: "a", Venue: london}, {ID: "b"}, {ID: "c"}, {ID: "d"}}
+	league := tournament.Tournament{ID: "1", Teams: teams}
+
+	opts := FixtureOptions{
+		Schedule: Schedule{
+			StartDate:         time.Date(2024, 8, 10, 0, 0, 0, 0, time.UTC),
+			DaysBetweenRounds: 7,
+			KickoffSlots:      []time.Duration{15 * time.Hour, 17*time.Hour + 30*time.Minute},
+			TimeZone:          "America/Sao_Paulo",
+		},
+		Double: true,
+	}
+
+	matches, err := GenerateFixtures(league, opts)
+	assert.Nil(t, err)
+	assert.Len(t, matches, 12)
+
+	ids := map[string]bool{}
+	legs := map[[2]string]int{}
+	for _, mt := range matches {
+		assert.NotEmpty(t, mt.ID)
+		ids[mt.ID] = true
+		legs[[2]string{mt.HomeTeam.ID, mt.AwayTeam.ID}]++
+
+		assert.Equal(t, "1", mt.Tournament.ID)
+		assert.Equal(t, model.MatchStatusNotStart, mt.Status)
+		assert.Equal(t, model.MatchStatusNotStart, mt.Score.Status)
+		assert.Equal(t, "America/Sao_Paulo", mt.TimeZone)
+	}
+	assert.Len(t, ids, 12)
+	assert.Len(t, legs, 12)
+
+	assert.Equal(t, 1, matches[0].Round)
+	assert.Equal(t, time.Date(2024, 8, 10, 18, 0, 0, 0, time.UTC), matches[0].Kickoff)
+	assert.Equal(t, time.Date(2024, 8, 10, 20, 30, 0, 0, time.UTC), matches[1].Kickoff)
+	assert.Equal(t, 2, matches[2].Round)
+	assert.Equal(t, time.Date(2024, 8, 17, 18, 0, 0, 0, time.UTC), matches[2].Kickoff)
+
+	assert.Equal(t, 4, matches[6].Round)
+	assert.Equal(t, matches[0].HomeTeam.ID, matches[6].AwayTeam.ID)
+	assert.Equal(t, matches[0].AwayTeam.ID, matches[6].HomeTeam.ID)
+
+	opts.Double = false
+	opts.TimeZone = ""
+	matches, err = GenerateFixtures(league, opts)
+	assert.Nil(t, err)
+	assert.Len(t, matches, 6)
+
+	for _, mt := range matches {
+		if mt.HomeTeam.ID == "a" {
+			assert.Equal(t, "Europe/London", mt.TimeZone)
+			assert.Equal(t, london, mt.Venue)
+			assert.Contains(t, []string{"14:00", "16:30"}, mt.Kickoff.Format("15:04"))
+		} else {
+			assert.Equal(t, "", mt.TimeZone)
+			assert.Contains(t, []string{"15:00", "17:30"}, mt.Kickoff.Format("15:04"))
+		}
+	}
+}
+
+func TestGenerateFixturesInvalid(t *testing.T) {
+	teams := []team.Team{{ID: "a"}, {ID: "b"}}
+
+	opts := FixtureOptions{
+		Schedule: Schedule{
+			StartDate:         time.Date(2024, 8, 10, 0, 0, 0, 0, time.UTC),
+			DaysBetweenRounds: 7,
+			KickoffSlots:      []time.Duration{15 * time.Hour},
+		},
+	}
+
+	_, err := GenerateFixtures(tournament.Tournament{Teams: teams[:1]}, opts)
+	assert.NotNil(t, err)
+
+	noSlots := opts
+	noSlots.KickoffSlots = nil
+	_, err = GenerateFixtures(tournament.Tournament{Teams: teams}, noSlots)
+	assert.NotNil(t, err)
+
+	noDays := opts
+	noDays.DaysBetweenRounds = 0
+	_, err = GenerateFixtures(tournament.Tournament{Teams: teams}, noDays)
+	assert.NotNil(t, err)
+
+	badTimeZone := opts
+	badTimeZone.TimeZone = "Mars/Olympus"
+	_, err = GenerateFixtures(tournament.Tournament{Teams: teams}, badTimeZone)
+	assert.NotNil(t, err)
+}
+
+func TestCheckFixtures(t *testing.T) {
+	league := tournament.Tournament{ID: "1"}
+
+	roundRobin := Match{Tournament: league, Round: 1}
+	knockout := Match{Tournament: league, Bracket: &Bracket{}}
+
+	assert.Nil(t, CheckFixtures(league, nil))
+	assert.Nil(t, CheckFixtures(league, []Match{knockout, {Tournament: league}}))
+	assert.Nil(t, CheckFixtures(tournament.Tournament{ID: "2"}, []Match{roundRobin}))
+	assert.NotNil(t, CheckFixtures(league, []Match{knockout, roundRobin}))
+}
+
+func TestGenerateFixturesGroups(t *testing.T) {
+	teams := []team.Team{{ID: "a"}, {ID: "b"}, {ID: "c"}, {ID: "d"}, {ID: "e"}, {ID: "f"}, {ID: "g"}}
+	cup := tournament.Tournament{
+		ID:    "1",
+		Teams: teams,
+		Groups: []tournament.Group{
+			{Name: "A", Teams: teams[:4]},
+			{Name: "B", Teams: teams[4:]},
+		},
+	}
+
+	opts := FixtureOptions{
+		Schedule: Schedule{
+			StartDate:         time.Date(2024, 8, 10, 0, 0, 0, 0, time.UTC),
+			DaysBetweenRounds: 4,
+			KickoffSlots:      []time.Duration{15 * time.Hour, 18 * time.Hour},
+		},
+	}
+
+	matches, err := GenerateFixtures(cup, opts)
+	assert.Nil(t, err)
+	assert.Len(t, matches, 9)
+
+	groupOf := map[string]string{"a": "A", "b": "A", "c": "A", "d": "A", "e": "B", "f": "B", "g": "B"}
+	for _, mt := range matches {
+		assert.Equal(t, groupOf[mt.HomeTeam.ID], mt.Group)
+		assert.Equal(t, groupOf[mt.AwayTeam.ID], mt.Group)
+		assert.Equal(t, "1", mt.Tournament.ID)
+	}
+
+	// the groups play their rounds on the same days and share the slots
+	first := matches[:3]
+	for i, mt := range first {
+		assert.Equal(t, 1, mt.Round)
+		assert.Equal(t, time.Date(2024, 8, 10, []int{15, 18, 15}[i], 0, 0, 0, time.UTC), mt.Kickoff)
+	}
+	assert.Equal(t, "B", first[2].Group)
+
+	cup.Groups[1].Teams = teams[4:5]
+	_, err = GenerateFixtures(cup, opts)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,151 @@
+package match
+
+import (
+	"sort"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+type GroupStandings struct {
+	Group     string
+	Standings []Standing
+}
+
+// ComputeGroupStandings ranks the teams of each group by the matches of the
+// group only.
+func ComputeGroupStandings(t tournament.Tournament, matches []Match) []GroupStandings {
+	groups := []GroupStandings{}
+	for _, g := range t.Groups {
+		gt := t
+		gt.Teams = g.Teams
+
+		groupMatches := []Match{}
+		for _, mt := range matches {
+			if mt.Group == g.Name {
+				groupMatches = append(groupMatches, mt)
+			}
+		}
+
+		groups = append(groups, GroupStandings{Group: g.Name, Standings: ComputeStandings(gt, groupMatches)})
+	}
+
+	return groups
+}
+
+// CheckRedraw rejects drawing the groups again once the tournament has group
+// matches, they would be left in groups that no longer exist.
+func CheckRedraw(t tournament.Tournament, matches []Match) errs.AppError {
+	for _, mt := range matches {
+		if mt.Group != "" && mt.IsTheMatchForTournament(t.ID) {
+			return errs.ErrGroupMatchesExist.Throwf(applog.Log, errs.ErrFmt, t.ID)
+		}
+	}
+	return nil
+}
+
+// Qualified returns the teams that go on from the group stage in the order
+// they are seeded in the knockout: the group winners, then the runners-up and
+// so on, and the best third-placed teams last.
+func Qualified(t tournament.Tournament, matches []Match) ([]team.Team, errs.AppError) {
+	if len(t.Groups) == 0 || t.Qualification == nil {
+		return nil, errs.ErrQualificationRules.Throwf(applog.Log, errs.ErrFmt, t.ID)
+	}
+
+	q := *t.Qualification
+	err := t.CheckQualification(q)
+	if err != nil {
+		return nil, err
+	}
+
+	played := 0
+	for _, mt := range matches {
+		if mt.Group == "" || !mt.IsTheMatchForTournament(t.ID) {
+			continue
+		}
+
+		if mt.Status != model.MatchStatusFinished {
+			return nil, errs.ErrGroupStageNotFinished.Throwf(applog.Log, errs.ErrFmt, mt.ID)
+		}
+		played++
+	}
+
+	if played == 0 {
+		return nil, errs.ErrGroupStageNotFinished.Throwf(applog.Log, errs.ErrFmt, t.ID)
+	}
+
+	teams := map[string]team.Team{}
+	for _, g := range t.Groups {
+		for _, tm := range g.Teams {
+			teams[tm.ID] = tm
+		}
+	}
+
+	groups := ComputeGroupStandings(t, matches)
+
+	qualified := []team.Team{}
+	for pos := 0; pos < q.PerGroup; pos++ {
+		for _, g := range groups {
+			qualified = append(qualified, teams[g.Standings[pos].TeamID])
+		}
+	}
+
+	thirds := []Standing{}
+	for _, g := range groups {
+		if len(g.Standings) > q.PerGroup {
+			thirds = append(thirds, g.Standings[q.PerGroup])
+		}
+	}
+
+	sort.SliceStable(thirds, func(i, j int) bool {
+		return thirds[i].Team < thirds[j].Team
+	})
+
+	// the third-placed teams never met, head-to-head can't rank them
+	criteria := []model.TieBreaker{tieBreakerPoints}
+	for _, tb := range t.GetTieBreakers() {
+		if tb != model.TieBreakerHeadToHead {
+			criteria = append(criteria, tb)
+		}
+	}
+	thirds = rankStandings(thirds, criteria, nil)
+
+	for i := 0; i < q.BestThirds && i < len(thirds); i++ {
+		qualified = append(qualified, teams[thirds[i].TeamID])
+	}
+
+	return qualified, nil
+}
+
+// GenerateKnockout builds the knockout bracket of the teams qualified from the
+// group stage once all the group matches are finished.
+func GenerateKnockout(t tournament.Tournament, matches []Match, opts BracketOptions) ([]Match, errs.AppError) {
+	for _, mt := range matches {
+		if mt.Bracket != nil && mt.IsTheMatchForTournament(t.ID) {
+			return nil, errs.ErrKnockoutAlreadyExists.Throwf(applog.Log, errs.ErrFmt, t.ID)
+		}
+	}
+
+	qualified, err := Qualified(t, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	knockout := t
+	knockout.Teams = qualified
+	opts.Seeds = nil
+
+	bracket, err := GenerateBracket(knockout, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range bracket {
+		bracket[i].Tournament = t
+	}
+
+	return bracket, nil
+}
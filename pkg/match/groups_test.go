@@ -0,0 +1,148 @@
+package match
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+// groupStage plays the group matches of the teams t1 to t9, the stronger
+// team, the one with the higher number, wins by the difference.
+func groupStage(t *testing.T) (tournament.Tournament, []Match) {
+	teams := map[int]team.Team{}
+	all := []team.Team{}
+	for i := 1; i <= 9; i++ {
+		teams[i] = team.Team{ID: fmt.Sprintf("t%d", i), Name: fmt.Sprintf("Team %d", i)}
+		all = append(all, teams[i])
+	}
+
+	cup := tournament.Tournament{
+		ID:    "1",
+		Teams: all,
+		Groups: []tournament.Group{
+			{Name: "A", Teams: []team.Team{teams[1], teams[5], teams[9]}},
+			{Name: "B", Teams: []team.Team{teams[2], teams[7], teams[8]}},
+			{Name: "C", Teams: []team.Team{teams[3], teams[4], teams[6]}},
+		},
+		Qualification: &tournament.Qualification{PerGroup: 1, BestThirds: 1},
+	}
+
+	matches, err := GenerateFixtures(cup, FixtureOptions{
+		Schedule: Schedule{
+			StartDate:         time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC),
+			DaysBetweenRounds: 4,
+			KickoffSlots:      []time.Duration{15 * time.Hour, 18 * time.Hour, 21 * time.Hour},
+		},
+	})
+	assert.Nil(t, err)
+
+	strength := func(tm team.Team) int {
+		var n int
+		_, _ = fmt.Sscanf(tm.ID, "t%d", &n)
+		return n
+	}
+
+	for i := range matches {
+		home, away := strength(matches[i].HomeTeam), strength(matches[i].AwayTeam)
+		matches[i].Status = model.MatchStatusFinished
+		if home > away {
+			matches[i].Score = Score{Home: home - away}
+		} else {
+			matches[i].Score = Score{Away: away - home}
+		}
+	}
+
+	return cup, matches
+}
+
+func TestComputeGroupStandings(t *testing.T) {
+	cup, matches := groupStage(t)
+
+	groups := ComputeGroupStandings(cup, matches)
+	assert.Len(t, groups, 3)
+
+	expected := map[string][]string{
+		"A": {"t9", "t5", "t1"},
+		"B": {"t8", "t7", "t2"},
+		"C": {"t6", "t4", "t3"},
+	}
+	for _, g := range groups {
+		ids := []string{}
+		for _, s := range g.Standings {
+			assert.Equal(t, 2, s.Played)
+			ids = append(ids, s.TeamID)
+		}
+		assert.Equal(t, expected[g.Group], ids)
+	}
+}
+
+func TestCheckRedraw(t *testing.T) {
+	cup, matches := groupStage(t)
+
+	assert.Nil(t, CheckRedraw(cup, nil))
+	assert.Nil(t, CheckRedraw(tournament.Tournament{ID: "2"}, matches))
+
+	assert.NotNil(t, CheckRedraw(cup, matches))
+}
+
+func TestQualified(t *testing.T) {
+	cup, matches := groupStage(t)
+
+	qualified, err := Qualified(cup, matches)
+	assert.Nil(t, err)
+
+	ids := []string{}
+	for _, tm := range qualified {
+		ids = append(ids, tm.ID)
+	}
+	// every runner-up has 3 points, t7 has the best goal difference
+	assert.Equal(t, []string{"t9", "t8", "t6", "t7"}, ids)
+
+	cup.Qualification = &tournament.Qualification{PerGroup: 2}
+	qualified, err = Qualified(cup, matches)
+	assert.Nil(t, err)
+	assert.Len(t, qualified, 6)
+
+	unfinished := append([]Match{}, matches...)
+	unfinished[0].Status = model.MatchStatusInProgress
+	_, err = Qualified(cup, unfinished)
+	assert.NotNil(t, err)
+
+	_, err = Qualified(cup, []Match{})
+	assert.NotNil(t, err)
+
+	cup.Qualification = &tournament.Qualification{PerGroup: 3, BestThirds: 1}
+	_, err = Qualified(cup, matches)
+	assert.NotNil(t, err)
+
+	cup.Qualification = nil
+	_, err = Qualified(cup, matches)
+	assert.NotNil(t, err)
+}
+
+func TestGenerateKnockout(t *testing.T) {
+	cup, matches := groupStage(t)
+
+	knockout, err := GenerateKnockout(cup, matches, bracketOptions())
+	assert.Nil(t, err)
+	assert.Len(t, knockout, 3)
+
+	assert.Equal(t, "t9", knockout[0].HomeTeam.ID)
+	assert.Equal(t, "t7", knockout[0].AwayTeam.ID)
+	assert.Equal(t, "t8", knockout[1].HomeTeam.ID)
+	assert.Equal(t, "t6", knockout[1].AwayTeam.ID)
+	for _, mt := range knockout {
+		assert.NotNil(t, mt.Bracket)
+		assert.Empty(t, mt.Group)
+		assert.Len(t, mt.Tournament.Teams, 9)
+	}
+
+	_, err = GenerateKnockout(cup, append(matches, knockout...), bracketOptions())
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,91 @@
+package match
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+)
+
+// layout of the legacy DateOfMatch and TimeOfMatch strings joined by a space
+const legacyKickoffLayout = "2006-01-02 15:04"
+
+// Location returns the venue time zone of the match, UTC when it has none.
+func (mt Match) Location() *time.Location {
+	if mt.TimeZone == "" {
+		return time.UTC
+	}
+
+	loc, err_ := time.LoadLocation(mt.TimeZone)
+	if err_ != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// InLocation renders the match times in loc, or in the venue time zone when
+// loc is nil.
+func (mt *Match) InLocation(loc *time.Location) {
+	if loc == nil {
+		loc = mt.Location()
+	}
+
+	mt.Kickoff = mt.Kickoff.In(loc)
+	mt.Created = mt.Created.In(loc)
+}
+
+// MigrateKickoff turns the legacy DateOfMatch and TimeOfMatch strings, read as
+// a local time in loc, into the UTC kickoff and reports whether the match
+// changed. Matches that already have a kickoff are left untouched.
+func (mt *Match) MigrateKickoff(loc *time.Location) (bool, errs.AppError) {
+	if !mt.Kickoff.IsZero() || mt.DateOfMatch == "" {
+		return false, nil
+	}
+
+	kickoff, err_ := time.ParseInLocation(legacyKickoffLayout, mt.DateOfMatch+" "+mt.TimeOfMatch, loc)
+	if err_ != nil {
+		return false, errs.ErrParsingTime.Throwf(applog.Log, errs.ErrFmtMore, mt.ID, err_)
+	}
+
+	mt.Kickoff = kickoff.UTC()
+	if mt.TimeZone == "" {
+		mt.TimeZone = loc.String()
+	}
+
+	mt.DateOfMatch = ""
+	mt.TimeOfMatch = ""
+
+	return true, nil
+}
+
+// MigrateKickoffs migrates the kickoff of every stored match and returns how
+// many were updated. The legacy fields are removed from the stored matches.
+func MigrateKickoffs(ctx context.Context, r MatchRepo, loc *time.Location) (int, errs.AppError) {
+	matches, err := r.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	for _, mt := range matches {
+		changed, err := mt.MigrateKickoff(loc)
+		if err != nil {
+			return migrated, err
+		}
+
+		if !changed {
+			continue
+		}
+
+		err = r.UpdateKickoff(ctx, mt.ID, mt.Kickoff, mt.TimeZone)
+		if err != nil {
+			return migrated, err
+		}
+
+		migrated++
+	}
+
+	return migrated, nil
+}
@@ -0,0 +1,140 @@
+package match
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+)
+
+type fakeMatchRepo struct {
+	MatchRepo
+	matches []Match
+	updated []Match
+	kickoff []Match
+}
+
+func (r *fakeMatchRepo) List(ctx context.Context) ([]Match, errs.AppError) {
+	return r.matches, nil
+}
+
+func (r *fakeMatchRepo) ListByTournament(ctx context.Context, tournamentID string) ([]Match, errs.AppError) {
+	matches := []Match{}
+	for _, mt := range r.matches {
+		if mt.IsTheMatchForTournament(tournamentID) {
+			matches = append(matches, mt)
+		}
+	}
+	return matches, nil
+}
+
+func (r *fakeMatchRepo) Get(ctx context.Context, id string) (*Match, errs.AppError) {
+	for _, mt := range r.matches {
+		if mt.ID == id {
+			return &mt, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeMatchRepo) Update(ctx context.Context, mt Match) (*Match, errs.AppError) {
+	r.updated = append(r.updated, mt)
+	return &mt, nil
+}
+
+func (r *fakeMatchRepo) UpdateKickoff(ctx context.Context, id string, kickoff time.Time, timeZone string) errs.AppError {
+	r.kickoff = append(r.kickoff, Match{ID: id, Kickoff: kickoff, TimeZone: timeZone})
+	return nil
+}
+
+func TestMigrateKickoff(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		Name             string
+		Match            Match
+		Location         *time.Location
+		ExpectedChanged  bool
+		ExpectedKickoff  time.Time
+		ExpectedTimeZone string
+		ExpectError      bool
+	}{
+		{
+			Name:             "Legacy date and time in UTC",
+			Match:            Match{DateOfMatch: "2022-02-01", TimeOfMatch: "16:00"},
+			Location:         time.UTC,
+			ExpectedChanged:  true,
+			ExpectedKickoff:  time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC),
+			ExpectedTimeZone: "UTC",
+		}, {
+			Name:             "Legacy date and time in the venue time zone",
+			Match:            Match{DateOfMatch: "2022-02-01", TimeOfMatch: "16:00"},
+			Location:         saoPaulo,
+			ExpectedChanged:  true,
+			ExpectedKickoff:  time.Date(2022, 2, 1, 19, 0, 0, 0, time.UTC),
+			ExpectedTimeZone: "America/Sao_Paulo",
+		}, {
+			Name:             "Kickoff already migrated",
+			Match:            Match{Kickoff: time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC), TimeZone: "UTC"},
+			Location:         saoPaulo,
+			ExpectedChanged:  false,
+			ExpectedKickoff:  time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC),
+			ExpectedTimeZone: "UTC",
+		}, {
+			Name:        "Invalid legacy time",
+			Match:       Match{DateOfMatch: "2022-02-01", TimeOfMatch: "4pm"},
+			Location:    time.UTC,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		mt := tc.Match
+		changed, err := mt.MigrateKickoff(tc.Location)
+		if tc.ExpectError {
+			assert.NotNil(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.ExpectedChanged, changed)
+		assert.True(t, tc.ExpectedKickoff.Equal(mt.Kickoff))
+		assert.Equal(t, time.UTC, mt.Kickoff.Location())
+		assert.Equal(t, tc.ExpectedTimeZone, mt.TimeZone)
+		assert.Empty(t, mt.DateOfMatch)
+		assert.Empty(t, mt.TimeOfMatch)
+	}
+}
+
+func TestMigrateKickoffs(t *testing.T) {
+	r := &fakeMatchRepo{matches: []Match{
+		{ID: "1", DateOfMatch: "2022-02-01", TimeOfMatch: "16:00"},
+		{ID: "2", Kickoff: time.Date(2022, 2, 2, 16, 0, 0, 0, time.UTC)},
+		{ID: "3", DateOfMatch: "2022-02-03", TimeOfMatch: "20:30"},
+	}}
+
+	migrated, err := MigrateKickoffs(context.Background(), r, time.UTC)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, migrated)
+	assert.Empty(t, r.updated)
+	assert.Equal(t, 2, len(r.kickoff))
+	assert.Equal(t, "3", r.kickoff[1].ID)
+	assert.True(t, time.Date(2022, 2, 3, 20, 30, 0, 0, time.UTC).Equal(r.kickoff[1].Kickoff))
+	assert.Equal(t, "UTC", r.kickoff[1].TimeZone)
+}
+
+func TestInLocation(t *testing.T) {
+	mt := Match{Kickoff: time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC), TimeZone: "Asia/Tokyo"}
+
+	mt.InLocation(nil)
+	assert.Equal(t, "2022-02-02T01:00:00+09:00", mt.Kickoff.Format(time.RFC3339))
+
+	mt.InLocation(time.UTC)
+	assert.Equal(t, "2022-02-01T16:00:00Z", mt.Kickoff.Format(time.RFC3339))
+}
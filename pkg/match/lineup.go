@@ -0,0 +1,127 @@
+package match
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+)
+
+// ComputeLineups keeps the last lineup posted by each team.
+func (mt *Match) ComputeLineups() []event.Lineup {
+	lineups := []event.Lineup{}
+
+	for _, e := range mt.Events {
+		lineup, ok := e.Value.(event.Lineup)
+		if !ok {
+			continue
+		}
+
+		replaced := false
+		for i, l := range lineups {
+			if l.Team.ID == lineup.Team.ID {
+				lineups[i] = lineup
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			lineups = append(lineups, lineup)
+		}
+	}
+
+	return lineups
+}
+
+func (mt Match) Lineup(teamID string) *event.Lineup {
+	for _, l := range mt.Lineups {
+		if l.Team.ID == teamID {
+			return &l
+		}
+	}
+	return nil
+}
+
+// OnPitch returns the ids of the team players on the pitch, the starting XI
+// updated by the substitutions made so far.
+func (mt Match) OnPitch(teamID string) []string {
+	lineup := mt.Lineup(teamID)
+	if lineup == nil {
+		return nil
+	}
+
+	onPitch := []string{}
+	for _, p := range lineup.StartingXI {
+		onPitch = append(onPitch, p.ID)
+	}
+
+	for _, e := range mt.Events {
+		sub, ok := e.Value.(event.Substitution)
+		if !ok || sub.Team.ID != teamID {
+			continue
+		}
+
+		onPitch = removeID(onPitch, sub.PlayerOut.ID)
+		onPitch = append(onPitch, sub.PlayerIn.ID)
+	}
+
+	return onPitch
+}
+
+func (mt Match) substitutedIn(teamID string) []string {
+	ids := []string{}
+	for _, e := range mt.Events {
+		sub, ok := e.Value.(event.Substitution)
+		if ok && sub.Team.ID == teamID {
+			ids = append(ids, sub.PlayerIn.ID)
+		}
+	}
+	return ids
+}
+
+// CheckSubstitution requires the player out to be on the pitch and the player
+// in to be an unused substitute, only for teams with a lineup.
+func (mt Match) CheckSubstitution(e event.Event) errs.AppError {
+	sub, ok := e.Value.(event.Substitution)
+	if !ok {
+		return nil
+	}
+
+	lineup := mt.Lineup(sub.Team.ID)
+	if lineup == nil {
+		return nil
+	}
+
+	if !containsID(mt.OnPitch(sub.Team.ID), sub.PlayerOut.ID) {
+		return errs.ErrPlayerIsNotOnThePitch.Throwf(applog.Log, errs.ErrFmt, sub.PlayerOut.ID)
+	}
+
+	bench := []string{}
+	for _, p := range lineup.Bench {
+		bench = append(bench, p.ID)
+	}
+
+	if !containsID(bench, sub.PlayerIn.ID) || containsID(mt.substitutedIn(sub.Team.ID), sub.PlayerIn.ID) {
+		return errs.ErrPlayerIsNotOnTheBench.Throwf(applog.Log, errs.ErrFmt, sub.PlayerIn.ID)
+	}
+
+	return nil
+}
+
+func containsID(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func removeID(ids []string, id string) []string {
+	kept := []string{}
+	for _, i := range ids {
+		if i != id {
+			kept = append(kept, i)
+		}
+	}
+	return kept
+}
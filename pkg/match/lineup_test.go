@@ -0,0 +1,104 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+func TestComputeLineups(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	p1 := player.Player{ID: "p1"}
+	p2 := player.Player{ID: "p2"}
+	p3 := player.Player{ID: "p3"}
+
+	mt := Match{
+		ID:       "1",
+		HomeTeam: home,
+		AwayTeam: away,
+		Status:   model.MatchStatusNotStart,
+	}
+
+	assert.Nil(t, mt.ApplyEvent(event.New("1", "1", event.Lineup{Team: home, StartingXI: []player.Player{p1}, Bench: []player.Player{p2}, Captain: p1})))
+	assert.Nil(t, mt.ApplyEvent(event.New("1", "1", event.Lineup{Team: away, StartingXI: []player.Player{p3}, Captain: p3})))
+	assert.Nil(t, mt.ApplyEvent(event.New("1", "1", event.Lineup{Team: home, StartingXI: []player.Player{p2}, Bench: []player.Player{p1}, Captain: p2})))
+
+	assert.Equal(t, []event.Lineup{
+		{Team: home, StartingXI: []player.Player{p2}, Bench: []player.Player{p1}, Captain: p2},
+		{Team: away, StartingXI: []player.Player{p3}, Captain: p3},
+	}, mt.Lineups)
+	assert.Equal(t, []string{"p2"}, mt.OnPitch("home"))
+	assert.Nil(t, mt.Lineup("other"))
+
+	mt.Status = model.MatchStatusInProgress
+	assert.NotNil(t, mt.ApplyEvent(event.New("1", "1", event.Lineup{Team: home, StartingXI: []player.Player{p1}, Captain: p1})))
+}
+
+func TestCheckSubstitution(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	p1 := player.Player{ID: "p1"}
+	p2 := player.Player{ID: "p2"}
+	p3 := player.Player{ID: "p3"}
+	p4 := player.Player{ID: "p4"}
+
+	mt := Match{
+		ID:       "1",
+		HomeTeam: home,
+		AwayTeam: away,
+		Status:   model.MatchStatusNotStart,
+	}
+
+	assert.Nil(t, mt.ApplyEvent(event.New("1", "1", event.Lineup{Team: home, StartingXI: []player.Player{p1, p2}, Bench: []player.Player{p3, p4}, Captain: p1})))
+	mt.Status = model.MatchStatusInProgress
+
+	assert.Nil(t, mt.ApplyEvent(event.New("1", "1", event.Substitution{Team: home, PlayerOut: p1, PlayerIn: p3, Minute: 60})))
+	assert.Equal(t, []string{"p2", "p3"}, mt.OnPitch("home"))
+
+	testCases := []struct {
+		Name          string
+		Substitution  event.Substitution
+		ExpectedError bool
+	}{
+		{
+			Name:          "Player out already substituted",
+			Substitution:  event.Substitution{Team: home, PlayerOut: p1, PlayerIn: p4, Minute: 70},
+			ExpectedError: true,
+		}, {
+			Name:          "Player in already used",
+			Substitution:  event.Substitution{Team: home, PlayerOut: p2, PlayerIn: p3, Minute: 70},
+			ExpectedError: true,
+		}, {
+			Name:          "Player in is not on the bench",
+			Substitution:  event.Substitution{Team: home, PlayerOut: p2, PlayerIn: p1, Minute: 70},
+			ExpectedError: true,
+		}, {
+			Name:          "Team without a lineup",
+			Substitution:  event.Substitution{Team: away, PlayerOut: p1, PlayerIn: p2, Minute: 70},
+			ExpectedError: false,
+		}, {
+			Name:          "Player on the pitch for an unused substitute",
+			Substitution:  event.Substitution{Team: home, PlayerOut: p3, PlayerIn: p4, Minute: 70},
+			ExpectedError: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		err := mt.CheckSubstitution(event.New("1", "1", tc.Substitution))
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+		}
+	}
+}
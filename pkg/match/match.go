@@ -5,16 +5,24 @@ import (
 	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/team"
 	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
 )
 
 type MatchRepo interface {
 	Insert(ctx context.Context, m Match) errs.AppError
+	InsertMany(ctx context.Context, matches []Match) errs.AppError
 	Get(ctx context.Context, id string) (*Match, errs.AppError)
 	List(ctx context.Context) ([]Match, errs.AppError)
+	ListByKickoff(ctx context.Context, from, to time.Time) ([]Match, errs.AppError)
+	ListByOfficial(ctx context.Context, officialID string) ([]Match, errs.AppError)
+	ListByTournament(ctx context.Context, tournamentID string) ([]Match, errs.AppError)
 	Update(ctx context.Context, m Match) (*Match, errs.AppError)
+	UpdateOfficials(ctx context.Context, id string, officials []MatchOfficial) errs.AppError
+	UpdateKickoff(ctx context.Context, id string, kickoff time.Time, timeZone string) errs.AppError
 	Delete(ctx context.Context, id string) errs.AppError
 
 	FindMatchForTournament(ctx context.Context, id, tournamentID string) (*Match, errs.AppError)
@@ -25,10 +33,24 @@ type Match struct {
 	Tournament  tournament.Tournament
 	HomeTeam    team.Team
 	AwayTeam    team.Team
-	DateOfMatch string
-	TimeOfMatch string
+	Kickoff     time.Time
+	TimeZone    string       `json:",omitempty" bson:",omitempty"`
+	Venue       *venue.Venue `json:",omitempty" bson:",omitempty"`
+	Round       int          `json:",omitempty" bson:",omitempty"`
+	Group       string       `json:",omitempty" bson:",omitempty"`
+	Bracket     *Bracket     `json:",omitempty" bson:",omitempty"`
+	DateOfMatch string       `json:"-" bson:",omitempty"`
+	TimeOfMatch string       `json:"-" bson:",omitempty"`
 	Status      model.MatchStatus
-	Events      []interface{}
+	Reason      string            `json:",omitempty"`
+	Period      model.MatchPeriod `json:",omitempty"`
+	Events      []event.Event
+	Score       Score
+	Attendance  int             `json:",omitempty"`
+	Cards       []PlayerCards   `json:",omitempty"`
+	Lineups     []event.Lineup  `json:",omitempty"`
+	Officials   []MatchOfficial `json:",omitempty"`
+	Clock       *Clock          `json:",omitempty" bson:"-"`
 	Created     time.Time
 }
 
@@ -47,6 +69,85 @@ func (mt *Match) FindTeamInMatch(teamID string) bool {
 	return false
 }
 
+func (mt *Match) OpposingTeam(teamID string) team.Team {
+	if teamID == mt.HomeTeam.ID {
+		return mt.AwayTeam
+	}
+	return mt.HomeTeam
+}
+
+// HasEvent reports whether the event was applied to the match, a retracted
+// event is no longer in the timeline but was applied too.
+func (mt Match) HasEvent(eventID string) bool {
+	if eventID == "" {
+		return false
+	}
+
+	for _, e := range mt.Events {
+		if e.ID == eventID {
+			return true
+		}
+	}
+
+	return event.IsRetracted(mt.Events, eventID)
+}
+
 func (mt *Match) IsTheMatchForTournament(tournamentID string) bool {
 	return mt.Tournament.ID == tournamentID
 }
+
+func (mt *Match) AddEvent(e event.Event) {
+	switch v := e.Value.(type) {
+	case event.Retraction:
+		events := []event.Event{}
+		for _, me := range mt.Events {
+			if me.ID != v.EventID {
+				events = append(events, me)
+			}
+		}
+		mt.Events = events
+	case event.Amendment:
+		for i, me := range mt.Events {
+			if me.ID == v.EventID && me.Type == v.Type {
+				mt.Events[i].Value = v.Value
+			}
+		}
+	case event.Postpone:
+		if v.Kickoff != nil {
+			mt.Kickoff = *v.Kickoff
+		}
+	case event.Finish:
+		mt.Attendance = v.Attendance
+	}
+
+	mt.Events = append(mt.Events, e)
+	mt.Score = mt.ComputeScore()
+	mt.Cards = mt.ComputeCards()
+	mt.Lineups = mt.ComputeLineups()
+	mt.Reason = mt.ComputeReason()
+}
+
+// ComputeReason returns why the match was last postponed, suspended,
+// abandoned or cancelled, any later status change clears it.
+func (mt Match) ComputeReason() string {
+	reason := ""
+
+	for _, e := range mt.Events {
+		switch v := e.Value.(type) {
+		case event.Postpone:
+			reason = v.Reason
+		case event.Suspend:
+			reason = v.Reason
+		case event.Abandon:
+			reason = v.Reason
+		case event.Cancel:
+			reason = v.Reason
+		default:
+			if _, ok := eventTransitions[e.Type]; ok {
+				reason = ""
+			}
+		}
+	}
+
+	return reason
+}
@@ -0,0 +1,103 @@
+package match
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+type periodMinutes struct {
+	From int
+	To   int
+}
+
+// minutes played in each period, stoppage time is written as To+stoppage (45+2)
+var periodsMinutes = map[model.MatchPeriod]periodMinutes{
+	model.PeriodFirstHalf:           {From: 1, To: 45},
+	model.PeriodSecondHalf:          {From: 46, To: 90},
+	model.PeriodExtraTimeFirstHalf:  {From: 91, To: 105},
+	model.PeriodExtraTimeSecondHalf: {From: 106, To: 120},
+}
+
+// walkPeriods calls fn for every event that keeps the match status, with the
+// period it happened in and whether the ball was in play
+func (mt Match) walkPeriods(fn func(e event.Event, period model.MatchPeriod, inPlay bool)) {
+	inPlay := false
+	period := model.PeriodFirstHalf
+
+	for _, e := range mt.Events {
+		if tr, ok := eventTransitions[e.Type]; ok {
+			inPlay = tr.To == model.MatchStatusInProgress
+			if tr.Period != "" {
+				period = tr.Period
+			}
+			continue
+		}
+
+		fn(e, period, inPlay)
+	}
+}
+
+// AnnouncedExtratime returns the last extratime announced in the period.
+func (mt Match) AnnouncedExtratime(period model.MatchPeriod) int {
+	extratime := 0
+
+	mt.walkPeriods(func(e event.Event, p model.MatchPeriod, inPlay bool) {
+		if v, ok := e.Value.(event.Extratime); ok && p == period {
+			extratime = v.Extratime
+		}
+	})
+
+	return extratime
+}
+
+func PeriodOfMinute(minute int) model.MatchPeriod {
+	for period, minutes := range periodsMinutes {
+		if minute >= minutes.From && minute <= minutes.To {
+			return period
+		}
+	}
+	return ""
+}
+
+// CheckMinute checks the minute of an event happening now and returns the
+// period it belongs to, during halftime only the end of the period is valid.
+func (mt Match) CheckMinute(minute, stoppage int) (model.MatchPeriod, errs.AppError) {
+	period := mt.CurrentPeriod()
+
+	if mt.Status == model.MatchStatusHalftime && minute != periodsMinutes[period].To {
+		return "", errs.ErrMinuteOutOfPeriod.Throwf(applog.Log, errs.ErrFmtMore, period, minute)
+	}
+
+	return mt.CheckMinuteInPeriod(period, minute, stoppage)
+}
+
+// CheckMinuteInPeriod checks a minute against the period bounds and the
+// extratime announced in it. Events stored without a period take the one of
+// their minute.
+func (mt Match) CheckMinuteInPeriod(period model.MatchPeriod, minute, stoppage int) (model.MatchPeriod, errs.AppError) {
+	if period == "" {
+		period = PeriodOfMinute(minute)
+	}
+
+	minutes, ok := periodsMinutes[period]
+	if !ok || minute < minutes.From || minute > minutes.To {
+		return "", errs.ErrMinuteOutOfPeriod.Throwf(applog.Log, errs.ErrFmtMore, period, minute)
+	}
+
+	if stoppage == 0 {
+		return period, nil
+	}
+
+	if stoppage < 0 || minute != minutes.To {
+		return "", errs.ErrStoppageNotAllowed.Throwf(applog.Log, errs.ErrFmtMore, minute, stoppage)
+	}
+
+	extratime := mt.AnnouncedExtratime(period)
+	if stoppage > extratime {
+		return "", errs.ErrStoppageOverExtratime.Throwf(applog.Log, errs.ErrFmtMore, stoppage, extratime)
+	}
+
+	return period, nil
+}
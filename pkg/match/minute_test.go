@@ -0,0 +1,129 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+func TestCheckMinute(t *testing.T) {
+	newMatch := func(events ...event.Event) Match {
+		mt := Match{ID: "1", Status: model.MatchStatusNotStart}
+		for _, e := range events {
+			assert.Nil(t, mt.ApplyEvent(e))
+		}
+		return mt
+	}
+
+	start := event.New("1", "1", event.Start{TimeStarted: "16:00"})
+	extratime := event.New("1", "1", event.Extratime{Period: model.PeriodFirstHalf, Extratime: 3})
+	halftime := event.New("1", "1", event.Halftime{Halftime: "16:48"})
+	secondHalf := event.New("1", "1", event.SecondHalf{TimeStarted: "17:03"})
+	fullTime := event.New("1", "1", event.Halftime{Halftime: "17:50"})
+	extraTime := event.New("1", "1", event.ExtraTimeFirstHalf{TimeStarted: "17:55"})
+
+	testCases := []struct {
+		Name           string
+		Match          Match
+		Minute         int
+		Stoppage       int
+		ExpectedPeriod model.MatchPeriod
+		ExpectedError  bool
+	}{
+		{
+			Name:           "Minute in the first half",
+			Match:          newMatch(start),
+			Minute:         30,
+			ExpectedPeriod: model.PeriodFirstHalf,
+		}, {
+			Name:          "Minute of the second half during the first half",
+			Match:         newMatch(start),
+			Minute:        60,
+			ExpectedError: true,
+		}, {
+			Name:          "Minute zero",
+			Match:         newMatch(start),
+			Minute:        0,
+			ExpectedError: true,
+		}, {
+			Name:          "Stoppage time without announced extratime",
+			Match:         newMatch(start),
+			Minute:        45,
+			Stoppage:      2,
+			ExpectedError: true,
+		}, {
+			Name:           "Stoppage time within the announced extratime",
+			Match:          newMatch(start, extratime),
+			Minute:         45,
+			Stoppage:       3,
+			ExpectedPeriod: model.PeriodFirstHalf,
+		}, {
+			Name:          "Stoppage time over the announced extratime",
+			Match:         newMatch(start, extratime),
+			Minute:        45,
+			Stoppage:      4,
+			ExpectedError: true,
+		}, {
+			Name:          "Stoppage time before the end of the period",
+			Match:         newMatch(start, extratime),
+			Minute:        40,
+			Stoppage:      2,
+			ExpectedError: true,
+		}, {
+			Name:           "Halftime at the end of the period",
+			Match:          newMatch(start, extratime, halftime),
+			Minute:         45,
+			ExpectedPeriod: model.PeriodFirstHalf,
+		}, {
+			Name:          "Halftime before the end of the period",
+			Match:         newMatch(start, extratime, halftime),
+			Minute:        40,
+			ExpectedError: true,
+		}, {
+			Name:          "Extratime announced in another period",
+			Match:         newMatch(start, extratime, halftime, secondHalf),
+			Minute:        90,
+			Stoppage:      2,
+			ExpectedError: true,
+		}, {
+			Name:           "Minute in extra time",
+			Match:          newMatch(start, halftime, secondHalf, fullTime, extraTime),
+			Minute:         95,
+			ExpectedPeriod: model.PeriodExtraTimeFirstHalf,
+		}, {
+			Name:          "Minute of regular time during extra time",
+			Match:         newMatch(start, halftime, secondHalf, fullTime, extraTime),
+			Minute:        90,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		period, err := tc.Match.CheckMinute(tc.Minute, tc.Stoppage)
+		assert.Equal(t, tc.ExpectedError, err != nil)
+		assert.Equal(t, tc.ExpectedPeriod, period)
+	}
+}
+
+func TestCheckMinuteInPeriod(t *testing.T) {
+	mt := Match{ID: "1", Status: model.MatchStatusFinished}
+
+	period, err := mt.CheckMinuteInPeriod(model.PeriodSecondHalf, 70, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, model.PeriodSecondHalf, period)
+
+	_, err = mt.CheckMinuteInPeriod(model.PeriodSecondHalf, 30, 0)
+	assert.NotNil(t, err)
+
+	period, err = mt.CheckMinuteInPeriod("", 110, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, model.PeriodExtraTimeSecondHalf, period)
+
+	_, err = mt.CheckMinuteInPeriod("", 130, 0)
+	assert.NotNil(t, err)
+}
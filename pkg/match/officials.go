@@ -0,0 +1,146 @@
+package match
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+)
+
+// OfficialWindow is how long an official is kept busy by a match, two matches
+// whose kickoffs are closer than that overlap.
+const OfficialWindow = 3 * time.Hour
+
+type MatchOfficial struct {
+	Official official.Official
+	Role     model.OfficialRole
+}
+
+type OfficialDiscipline struct {
+	OfficialID          string
+	Official            string
+	Matches             int
+	YellowCards         int
+	SecondYellowCards   int
+	RedCards            int
+	YellowCardsPerMatch float64
+	RedCardsPerMatch    float64
+}
+
+func (mt Match) HasOfficial(officialID string) bool {
+	for _, o := range mt.Officials {
+		if o.Official.ID == officialID {
+			return true
+		}
+	}
+	return false
+}
+
+// AssignOfficials replaces the officials of the match, an official can only
+// be assigned once.
+func (mt *Match) AssignOfficials(officials []MatchOfficial) errs.AppError {
+	assigned := map[string]bool{}
+	for _, o := range officials {
+		if assigned[o.Official.ID] {
+			return errs.ErrOfficialDuplicated.Throwf(applog.Log, errs.ErrFmt, o.Official.ID)
+		}
+		assigned[o.Official.ID] = true
+	}
+
+	mt.Officials = officials
+	return nil
+}
+
+// Overlaps tells whether both matches keep their officials busy at the same
+// time, cancelled matches and matches without a kickoff never overlap.
+func (mt Match) Overlaps(other Match) bool {
+	if mt.Kickoff.IsZero() || other.Kickoff.IsZero() {
+		return false
+	}
+
+	if mt.Status == model.MatchStatusCancelled || other.Status == model.MatchStatusCancelled {
+		return false
+	}
+
+	diff := mt.Kickoff.Sub(other.Kickoff)
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return diff < OfficialWindow
+}
+
+// CheckOfficialConflicts fails when an official of the match is already
+// assigned to another match with an overlapping kickoff.
+func CheckOfficialConflicts(ctx context.Context, r MatchRepo, mt Match) errs.AppError {
+	if len(mt.Officials) == 0 || mt.Kickoff.IsZero() {
+		return nil
+	}
+
+	matches, err := r.ListByKickoff(ctx, mt.Kickoff.Add(-OfficialWindow), mt.Kickoff.Add(OfficialWindow))
+	if err != nil {
+		return err
+	}
+
+	for _, other := range matches {
+		if other.ID == mt.ID || !mt.Overlaps(other) {
+			continue
+		}
+
+		for _, o := range mt.Officials {
+			if other.HasOfficial(o.Official.ID) {
+				return errs.ErrOfficialConflict.Throwf(applog.Log, errs.ErrFmtMore, o.Official.ID, other.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ComputeDiscipline sums the warnings shown in the started matches the
+// official was assigned to, a second yellow card is counted apart from the
+// straight red cards.
+func ComputeDiscipline(o official.Official, matches []Match) OfficialDiscipline {
+	discipline := OfficialDiscipline{
+		OfficialID: o.ID,
+		Official:   o.Name,
+	}
+
+	for _, mt := range matches {
+		if !mt.HasOfficial(o.ID) || !mt.Started() {
+			continue
+		}
+
+		discipline.Matches++
+
+		yellows := map[string]int{}
+		for _, e := range mt.Events {
+			warning, ok := e.Value.(event.Warning)
+			if !ok {
+				continue
+			}
+
+			switch warning.Warning {
+			case model.WarningYellowCard:
+				yellows[warning.Player.ID]++
+				discipline.YellowCards++
+				if yellows[warning.Player.ID] == 2 {
+					discipline.SecondYellowCards++
+				}
+			case model.WarningRedCard:
+				discipline.RedCards++
+			}
+		}
+	}
+
+	if discipline.Matches > 0 {
+		discipline.YellowCardsPerMatch = float64(discipline.YellowCards) / float64(discipline.Matches)
+		discipline.RedCardsPerMatch = float64(discipline.RedCards+discipline.SecondYellowCards) / float64(discipline.Matches)
+	}
+
+	return discipline
+}
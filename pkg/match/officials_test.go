@@ -0,0 +1,170 @@
+package match
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+type fakeKickoffMatchRepo struct {
+	MatchRepo
+	matches []Match
+	from    time.Time
+	to      time.Time
+}
+
+func (r *fakeKickoffMatchRepo) ListByKickoff(ctx context.Context, from, to time.Time) ([]Match, errs.AppError) {
+	r.from = from
+	r.to = to
+	return r.matches, nil
+}
+
+func TestAssignOfficials(t *testing.T) {
+	referee := official.Official{ID: "o1", Role: model.OfficialReferee}
+	assistant := official.Official{ID: "o2", Role: model.OfficialAssistantReferee}
+
+	testCases := []struct {
+		Name        string
+		Officials   []MatchOfficial
+		ExpectError bool
+	}{
+		{
+			Name: "Should assign the officials",
+			Officials: []MatchOfficial{
+				{Official: referee, Role: model.OfficialReferee},
+				{Official: assistant, Role: model.OfficialAssistantReferee},
+			},
+		}, {
+			Name: "Should not assign the same official twice",
+			Officials: []MatchOfficial{
+				{Official: referee, Role: model.OfficialReferee},
+				{Official: referee, Role: model.OfficialVideoAssistant},
+			},
+			ExpectError: true,
+		}, {
+			Name: "Should clear the officials",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		mt := Match{ID: "1", Officials: []MatchOfficial{{Official: assistant, Role: model.OfficialFourthOfficial}}}
+
+		err := mt.AssignOfficials(tc.Officials)
+		if tc.ExpectError {
+			assert.NotNil(t, err)
+			assert.Equal(t, errs.ErrOfficialDuplicated.Code(), err.Code())
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, tc.Officials, mt.Officials)
+	}
+}
+
+func TestCheckOfficialConflicts(t *testing.T) {
+	kickoff := time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC)
+	referee := MatchOfficial{Official: official.Official{ID: "o1"}, Role: model.OfficialReferee}
+	assistant := MatchOfficial{Official: official.Official{ID: "o2"}, Role: model.OfficialAssistantReferee}
+
+	mt := Match{ID: "1", Kickoff: kickoff, Status: model.MatchStatusNotStart, Officials: []MatchOfficial{referee}}
+
+	testCases := []struct {
+		Name        string
+		Match       Match
+		Other       Match
+		ExpectError bool
+	}{
+		{
+			Name:        "Should fail with the official on an overlapping match",
+			Match:       mt,
+			Other:       Match{ID: "2", Kickoff: kickoff.Add(2 * time.Hour), Officials: []MatchOfficial{referee}},
+			ExpectError: true,
+		}, {
+			Name:  "Should pass with other officials on an overlapping match",
+			Match: mt,
+			Other: Match{ID: "2", Kickoff: kickoff.Add(-time.Hour), Officials: []MatchOfficial{assistant}},
+		}, {
+			Name:  "Should pass with the official on a match out of the window",
+			Match: mt,
+			Other: Match{ID: "2", Kickoff: kickoff.Add(OfficialWindow), Officials: []MatchOfficial{referee}},
+		}, {
+			Name:  "Should pass with the official on a cancelled match",
+			Match: mt,
+			Other: Match{ID: "2", Kickoff: kickoff, Status: model.MatchStatusCancelled, Officials: []MatchOfficial{referee}},
+		}, {
+			Name:  "Should pass with the same match already stored",
+			Match: mt,
+			Other: mt,
+		}, {
+			Name:  "Should pass without a kickoff",
+			Match: Match{ID: "1", Officials: []MatchOfficial{referee}},
+			Other: Match{ID: "2", Officials: []MatchOfficial{referee}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		r := &fakeKickoffMatchRepo{matches: []Match{tc.Other}}
+
+		err := CheckOfficialConflicts(context.Background(), r, tc.Match)
+		if tc.ExpectError {
+			assert.NotNil(t, err)
+			assert.Equal(t, errs.ErrOfficialConflict.Code(), err.Code())
+			assert.Equal(t, kickoff.Add(-OfficialWindow), r.from)
+			assert.Equal(t, kickoff.Add(OfficialWindow), r.to)
+			continue
+		}
+
+		assert.Nil(t, err)
+	}
+}
+
+func TestComputeDiscipline(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	p1 := player.Player{ID: "p1"}
+	p2 := player.Player{ID: "p2"}
+
+	referee := official.Official{ID: "o1", Name: "Referee"}
+	officials := []MatchOfficial{{Official: referee, Role: model.OfficialReferee}}
+
+	first := Match{ID: "1", HomeTeam: home, AwayTeam: away, Status: model.MatchStatusInProgress, Officials: officials}
+	first.AddEvent(event.New("1", "1", event.Warning{Team: home, Player: p1, Warning: model.WarningYellowCard, Minute: 10}))
+	first.AddEvent(event.New("1", "1", event.Warning{Team: home, Player: p1, Warning: model.WarningYellowCard, Minute: 20}))
+	first.AddEvent(event.New("1", "1", event.Warning{Team: away, Player: p2, Warning: model.WarningYellowCard, Minute: 30}))
+
+	second := Match{ID: "2", HomeTeam: home, AwayTeam: away, Status: model.MatchStatusFinished, Officials: officials}
+	second.AddEvent(event.New("1", "2", event.Warning{Team: away, Player: p2, Warning: model.WarningRedCard, Minute: 40}))
+
+	notStarted := Match{ID: "3", Status: model.MatchStatusNotStart, Officials: officials}
+	otherOfficial := Match{ID: "4", Status: model.MatchStatusFinished}
+	otherOfficial.AddEvent(event.New("1", "4", event.Warning{Team: away, Player: p2, Warning: model.WarningRedCard, Minute: 40}))
+
+	discipline := ComputeDiscipline(referee, []Match{first, second, notStarted, otherOfficial})
+
+	assert.Equal(t, OfficialDiscipline{
+		OfficialID:          "o1",
+		Official:            "Referee",
+		Matches:             2,
+		YellowCards:         3,
+		SecondYellowCards:   1,
+		RedCards:            1,
+		YellowCardsPerMatch: 1.5,
+		RedCardsPerMatch:    1,
+	}, discipline)
+
+	assert.Equal(t, OfficialDiscipline{OfficialID: "o2"}, ComputeDiscipline(official.Official{ID: "o2"}, []Match{first}))
+}
@@ -0,0 +1,147 @@
+package match
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+// Rebuild is the outcome of replaying the stored events of a match, Skipped
+// has the events the match could not apply.
+type Rebuild struct {
+	MatchID       string
+	Discrepancies []Discrepancy
+	Skipped       []string `json:",omitempty"`
+	Updated       bool
+}
+
+type Discrepancy struct {
+	Field   string
+	Stored  interface{}
+	Rebuilt interface{}
+}
+
+// Rebuild replays the events on the match as it was before kickoff, an event
+// the match cannot apply is skipped like the consumer would do.
+func (mt Match) Rebuild(events []event.Event) (Match, []string) {
+	rebuilt := mt
+	rebuilt.Status = model.MatchStatusNotStart
+	rebuilt.Period = ""
+	rebuilt.Events = []event.Event{}
+	rebuilt.Attendance = 0
+	rebuilt.Score = rebuilt.ComputeScore()
+	rebuilt.Cards = rebuilt.ComputeCards()
+	rebuilt.Lineups = rebuilt.ComputeLineups()
+	rebuilt.Reason = rebuilt.ComputeReason()
+
+	skipped := []string{}
+	for _, e := range events {
+		err := rebuilt.ApplyEvent(e)
+		if err != nil {
+			skipped = append(skipped, e.ID)
+		}
+	}
+
+	return rebuilt, skipped
+}
+
+// Diff lists the fields of the stored match projection that differ from the
+// rebuilt one, the events are compared by id.
+func Diff(stored, rebuilt Match) []Discrepancy {
+	fields := []Discrepancy{
+		{Field: "Status", Stored: stored.Status, Rebuilt: rebuilt.Status},
+		{Field: "Period", Stored: stored.Period, Rebuilt: rebuilt.Period},
+		{Field: "Reason", Stored: stored.Reason, Rebuilt: rebuilt.Reason},
+		{Field: "Kickoff", Stored: stored.Kickoff.UTC(), Rebuilt: rebuilt.Kickoff.UTC()},
+		{Field: "Events", Stored: eventIDs(stored.Events), Rebuilt: eventIDs(rebuilt.Events)},
+		{Field: "Score", Stored: stored.Score, Rebuilt: rebuilt.Score},
+		{Field: "Attendance", Stored: stored.Attendance, Rebuilt: rebuilt.Attendance},
+		{Field: "Cards", Stored: stored.Cards, Rebuilt: rebuilt.Cards},
+		{Field: "Lineups", Stored: stored.Lineups, Rebuilt: rebuilt.Lineups},
+	}
+
+	discrepancies := []Discrepancy{}
+	for _, f := range fields {
+		if !sameValue(f.Stored, f.Rebuilt) {
+			discrepancies = append(discrepancies, f)
+		}
+	}
+
+	return discrepancies
+}
+
+// RebuildMatches rebuilds the matches from the event store and updates the
+// ones that drifted, a dry run only reports the discrepancies. The update
+// writes the empty projections too, so a stale card or lineup is cleared.
+func RebuildMatches(ctx context.Context, r MatchRepo, er event.EventRepo, matches []Match, dryRun bool) ([]Rebuild, errs.AppError) {
+	rebuilds := []Rebuild{}
+	for _, mt := range matches {
+		events, err := er.ListEventsFromMatch(ctx, mt.ID)
+		if err != nil {
+			return rebuilds, err
+		}
+
+		rebuilt, skipped := mt.Rebuild(events)
+
+		rb := Rebuild{
+			MatchID:       mt.ID,
+			Discrepancies: Diff(mt, rebuilt),
+			Skipped:       skipped,
+		}
+
+		if !dryRun && len(rb.Discrepancies) > 0 {
+			_, err = r.Update(ctx, rebuilt)
+			if err != nil {
+				return rebuilds, err
+			}
+			rb.Updated = true
+		}
+
+		rebuilds = append(rebuilds, rb)
+	}
+
+	return rebuilds, nil
+}
+
+// FindMatches returns the match, the matches of the tournament or every
+// match when neither is given.
+func FindMatches(ctx context.Context, r MatchRepo, tournamentID, matchID string) ([]Match, errs.AppError) {
+	if matchID != "" {
+		mt, err := r.Get(ctx, matchID)
+		if err != nil {
+			return nil, err
+		}
+
+		if mt == nil || (tournamentID != "" && !mt.IsTheMatchForTournament(tournamentID)) {
+			return []Match{}, nil
+		}
+
+		return []Match{*mt}, nil
+	}
+
+	if tournamentID != "" {
+		return r.ListByTournament(ctx, tournamentID)
+	}
+
+	return r.List(ctx)
+}
+
+func eventIDs(events []event.Event) []string {
+	ids := []string{}
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+// a missing slice and an empty one are the same projection
+func sameValue(a, b interface{}) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() == reflect.Slice && vb.Kind() == reflect.Slice && va.Len() == 0 && vb.Len() == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,150 @@
+package match
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+type fakeEventRepo struct {
+	event.EventRepo
+	events map[string][]event.Event
+}
+
+func (r fakeEventRepo) ListEventsFromMatch(ctx context.Context, matchID string) ([]event.Event, errs.AppError) {
+	return r.events[matchID], nil
+}
+
+func TestRebuild(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	start := event.New("1", "1", event.Start{TimeStarted: "16:00"})
+	goal := event.New("1", "1", event.Goal{Team: home, Player: player.Player{ID: "p1"}, Minute: 10})
+	ownGoal := event.New("1", "1", event.Goal{Team: away, Player: player.Player{ID: "p2"}, Minute: 20})
+	retraction := event.New("1", "1", event.Retraction{EventID: ownGoal.ID, Reason: "Offside"})
+	lineup := event.New("1", "1", event.Lineup{Team: home})
+
+	stored := Match{ID: "1", HomeTeam: home, AwayTeam: away, Status: model.MatchStatusNotStart}
+	assert.Nil(t, stored.ApplyEvent(start))
+	assert.Nil(t, stored.ApplyEvent(goal))
+	assert.Nil(t, stored.ApplyEvent(ownGoal))
+	assert.Nil(t, stored.ApplyEvent(retraction))
+
+	rebuilt, skipped := stored.Rebuild([]event.Event{start, goal, goal, ownGoal, retraction, lineup})
+	assert.Equal(t, []string{lineup.ID}, skipped)
+	assert.Equal(t, model.MatchStatusInProgress, rebuilt.Status)
+	assert.Equal(t, 1, rebuilt.Score.Home)
+	assert.Equal(t, 0, rebuilt.Score.Away)
+	assert.Len(t, rebuilt.Events, 3)
+	assert.Empty(t, Diff(stored, rebuilt))
+
+	rebuilt, skipped = stored.Rebuild([]event.Event{start})
+	assert.Empty(t, skipped)
+
+	discrepancies := Diff(stored, rebuilt)
+	fields := []string{}
+	for _, d := range discrepancies {
+		fields = append(fields, d.Field)
+	}
+	assert.Equal(t, []string{"Events", "Score"}, fields)
+	assert.Equal(t, []string{start.ID, goal.ID, retraction.ID}, discrepancies[0].Stored)
+	assert.Equal(t, []string{start.ID}, discrepancies[0].Rebuilt)
+
+	notStarted := Match{ID: "2", Status: model.MatchStatusNotStart, Score: Score{Status: model.MatchStatusNotStart}}
+	rebuilt, _ = notStarted.Rebuild(nil)
+	assert.Empty(t, Diff(notStarted, rebuilt))
+}
+
+func TestRebuildMatches(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	start := event.New("1", "1", event.Start{TimeStarted: "16:00"})
+	goal := event.New("1", "1", event.Goal{Team: home, Player: player.Player{ID: "p1"}, Minute: 10})
+
+	drifted := Match{ID: "1", HomeTeam: home, AwayTeam: away, Status: model.MatchStatusNotStart}
+	assert.Nil(t, drifted.ApplyEvent(start))
+
+	synced := Match{ID: "2", HomeTeam: home, AwayTeam: away, Status: model.MatchStatusNotStart, Score: Score{Status: model.MatchStatusNotStart}}
+
+	er := fakeEventRepo{events: map[string][]event.Event{"1": {start, goal}}}
+
+	testCases := []struct {
+		Name            string
+		DryRun          bool
+		ExpectedUpdated int
+	}{
+		{
+			Name:            "Dry run only reports the discrepancies",
+			DryRun:          true,
+			ExpectedUpdated: 0,
+		}, {
+			Name:            "Updates the matches that drifted",
+			DryRun:          false,
+			ExpectedUpdated: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		r := &fakeMatchRepo{}
+		rebuilds, err := RebuildMatches(context.Background(), r, er, []Match{drifted, synced}, tc.DryRun)
+		assert.Nil(t, err)
+		assert.Len(t, rebuilds, 2)
+		assert.Len(t, r.updated, tc.ExpectedUpdated)
+
+		assert.Equal(t, "1", rebuilds[0].MatchID)
+		assert.NotEmpty(t, rebuilds[0].Discrepancies)
+		assert.Equal(t, !tc.DryRun, rebuilds[0].Updated)
+		assert.Empty(t, rebuilds[1].Discrepancies)
+		assert.False(t, rebuilds[1].Updated)
+
+		if tc.ExpectedUpdated > 0 {
+			assert.Equal(t, 1, r.updated[0].Score.Home)
+			assert.Len(t, r.updated[0].Events, 2)
+		}
+	}
+}
+
+func TestFindMatches(t *testing.T) {
+	r := &fakeMatchRepo{matches: []Match{
+		{ID: "1", Tournament: tournament.Tournament{ID: "t1"}},
+		{ID: "2", Tournament: tournament.Tournament{ID: "t2"}},
+		{ID: "3", Tournament: tournament.Tournament{ID: "t1"}},
+	}}
+
+	testCases := []struct {
+		Name         string
+		TournamentID string
+		MatchID      string
+		ExpectedIDs  []string
+	}{
+		{Name: "Every match", ExpectedIDs: []string{"1", "2", "3"}},
+		{Name: "Matches of a tournament", TournamentID: "t1", ExpectedIDs: []string{"1", "3"}},
+		{Name: "One match", MatchID: "2", ExpectedIDs: []string{"2"}},
+		{Name: "One match of another tournament", TournamentID: "t1", MatchID: "2", ExpectedIDs: []string{}},
+		{Name: "Unknown match", MatchID: "4", ExpectedIDs: []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		matches, err := FindMatches(context.Background(), r, tc.TournamentID, tc.MatchID)
+		assert.Nil(t, err)
+		ids := []string{}
+		for _, mt := range matches {
+			ids = append(ids, mt.ID)
+		}
+		assert.Equal(t, tc.ExpectedIDs, ids)
+	}
+}
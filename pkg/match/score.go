@@ -0,0 +1,138 @@
+package match
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+type Score struct {
+	Home      int
+	Away      int
+	Scorers   []Scorer
+	Penalties *Penalties `json:",omitempty" bson:",omitempty"`
+	Status    model.MatchStatus
+	DecidedBy model.MatchDecision `json:",omitempty" bson:",omitempty"`
+	Winner    string              `json:",omitempty" bson:",omitempty"`
+}
+
+type Scorer struct {
+	TeamID   string
+	PlayerID string
+	Player   string
+	Minute   int
+	Stoppage int            `json:",omitempty" bson:",omitempty"`
+	Type     model.GoalType `json:",omitempty" bson:",omitempty"`
+	AssistID string         `json:",omitempty" bson:",omitempty"`
+	Assist   string         `json:",omitempty" bson:",omitempty"`
+}
+
+type Penalties struct {
+	Home  int
+	Away  int
+	Kicks []PenaltyKick
+}
+
+type PenaltyKick struct {
+	TeamID   string
+	PlayerID string
+	Player   string
+	Scored   bool
+}
+
+func (mt *Match) ComputeScore() Score {
+	score := Score{
+		Status: mt.Status,
+	}
+
+	for _, e := range mt.Events {
+		switch v := e.Value.(type) {
+		case event.Goal:
+			score.addGoal(mt, v)
+		case event.PenaltyKick:
+			score.addPenaltyKick(mt, v)
+		case event.Finish:
+			score.DecidedBy = v.DecidedBy
+		}
+	}
+
+	if mt.Status == model.MatchStatusFinished {
+		score.Winner = score.winner(mt)
+	}
+
+	return score
+}
+
+func (s *Score) addGoal(mt *Match, goal event.Goal) {
+	switch goal.Team.ID {
+	case mt.HomeTeam.ID:
+		s.Home++
+	case mt.AwayTeam.ID:
+		s.Away++
+	default:
+		return
+	}
+
+	scorer := Scorer{
+		TeamID:   goal.Team.ID,
+		PlayerID: goal.Player.ID,
+		Player:   goal.Player.Name,
+		Minute:   goal.Minute,
+		Stoppage: goal.Stoppage,
+		Type:     goal.Type,
+	}
+
+	if goal.Assist != nil {
+		scorer.AssistID = goal.Assist.ID
+		scorer.Assist = goal.Assist.Name
+	}
+
+	s.Scorers = append(s.Scorers, scorer)
+}
+
+func (s *Score) addPenaltyKick(mt *Match, kick event.PenaltyKick) {
+	if !mt.FindTeamInMatch(kick.Team.ID) {
+		return
+	}
+
+	if s.Penalties == nil {
+		s.Penalties = &Penalties{}
+	}
+
+	if kick.Scored {
+		if kick.Team.ID == mt.HomeTeam.ID {
+			s.Penalties.Home++
+		} else {
+			s.Penalties.Away++
+		}
+	}
+
+	s.Penalties.Kicks = append(s.Penalties.Kicks, PenaltyKick{
+		TeamID:   kick.Team.ID,
+		PlayerID: kick.Player.ID,
+		Player:   kick.Player.Name,
+		Scored:   kick.Scored,
+	})
+}
+
+func (s Score) winner(mt *Match) string {
+	home, away := s.Home, s.Away
+	if s.DecidedBy == model.DecisionPenalties && s.Penalties != nil {
+		home, away = s.Penalties.Home, s.Penalties.Away
+	}
+
+	switch {
+	case home > away:
+		return mt.HomeTeam.ID
+	case away > home:
+		return mt.AwayTeam.ID
+	}
+	return ""
+}
+
+func (s Score) IsLevel() bool {
+	return s.Home == s.Away
+}
+
+func (s Score) PenaltiesDecided() bool {
+	return s.Penalties != nil && s.Penalties.Home != s.Penalties.Away
+}
@@ -0,0 +1,100 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+func TestComputeScore(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+	other := team.Team{ID: "other"}
+
+	mt := Match{
+		ID:       "1",
+		HomeTeam: home,
+		AwayTeam: away,
+		Status:   model.MatchStatusInProgress,
+	}
+
+	mt.AddEvent(event.New("1", "1", event.Start{TimeStarted: "16:00"}))
+	mt.AddEvent(event.New("1", "1", event.Goal{Team: home, Player: player.Player{ID: "p1", Name: "Player 1"}, Minute: 10}))
+	mt.AddEvent(event.New("1", "1", event.Goal{Team: away, Player: player.Player{ID: "p2", Name: "Player 2"}, Minute: 20}))
+	mt.AddEvent(event.New("1", "1", event.Goal{Team: home, Player: player.Player{ID: "p1", Name: "Player 1"}, Minute: 30}))
+	mt.AddEvent(event.New("1", "1", event.Goal{Team: other, Player: player.Player{ID: "p3", Name: "Player 3"}, Minute: 40}))
+
+	assert.Equal(t, 2, mt.Score.Home)
+	assert.Equal(t, 1, mt.Score.Away)
+	assert.Equal(t, model.MatchStatusInProgress, mt.Score.Status)
+	assert.Equal(t, []Scorer{
+		{TeamID: "home", PlayerID: "p1", Player: "Player 1", Minute: 10},
+		{TeamID: "away", PlayerID: "p2", Player: "Player 2", Minute: 20},
+		{TeamID: "home", PlayerID: "p1", Player: "Player 1", Minute: 30},
+	}, mt.Score.Scorers)
+}
+
+func TestComputeScoreGoalTypes(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	mt := Match{
+		ID:       "1",
+		HomeTeam: home,
+		AwayTeam: away,
+		Status:   model.MatchStatusInProgress,
+	}
+
+	assist := player.Player{ID: "p4", Name: "Player 4"}
+
+	mt.AddEvent(event.New("1", "1", event.Goal{Team: home, Player: player.Player{ID: "p2", Name: "Player 2"}, Minute: 10, Type: model.GoalOwnGoal}))
+	mt.AddEvent(event.New("1", "1", event.Goal{Team: home, Player: player.Player{ID: "p1", Name: "Player 1"}, Minute: 20, Type: model.GoalHeader, Assist: &assist}))
+
+	assert.Equal(t, 2, mt.Score.Home)
+	assert.Equal(t, 0, mt.Score.Away)
+	assert.Equal(t, []Scorer{
+		{TeamID: "home", PlayerID: "p2", Player: "Player 2", Minute: 10, Type: model.GoalOwnGoal},
+		{TeamID: "home", PlayerID: "p1", Player: "Player 1", Minute: 20, Type: model.GoalHeader, AssistID: "p4", Assist: "Player 4"},
+	}, mt.Score.Scorers)
+}
+
+func TestComputeScoreWithCorrections(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	mt := Match{
+		ID:       "1",
+		HomeTeam: home,
+		AwayTeam: away,
+		Status:   model.MatchStatusInProgress,
+	}
+
+	goal := event.New("1", "1", event.Goal{Team: home, Player: player.Player{ID: "p1", Name: "Player 1"}, Minute: 10})
+	mt.AddEvent(goal)
+
+	disallowed := event.New("1", "1", event.Goal{Team: away, Player: player.Player{ID: "p2", Name: "Player 2"}, Minute: 20})
+	mt.AddEvent(disallowed)
+
+	assert.Equal(t, 1, mt.Score.Home)
+	assert.Equal(t, 1, mt.Score.Away)
+
+	mt.AddEvent(event.New("1", "1", event.Retraction{EventID: disallowed.ID, Reason: "Offside"}))
+	mt.AddEvent(event.New("1", "1", event.Amendment{
+		EventID: goal.ID,
+		Reason:  "Wrong scorer",
+		Type:    model.EventGoal,
+		Value:   event.Goal{Team: home, Player: player.Player{ID: "p3", Name: "Player 3"}, Minute: 11},
+	}))
+
+	assert.Equal(t, 1, mt.Score.Home)
+	assert.Equal(t, 0, mt.Score.Away)
+	assert.Equal(t, []Scorer{
+		{TeamID: "home", PlayerID: "p3", Player: "Player 3", Minute: 11},
+	}, mt.Score.Scorers)
+	assert.Equal(t, 3, len(mt.Events))
+}
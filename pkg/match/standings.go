@@ -0,0 +1,258 @@
+package match
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+const (
+	pointsForWin  = 3
+	pointsForDraw = 1
+
+	fairPlayYellow = 1
+	fairPlayRed    = 3
+)
+
+// teams are always ranked by points first
+var tieBreakerPoints = model.TieBreaker("Points")
+
+// Standing is the row of a team in the tournament table, FairPlay adds the
+// disciplinary points of the team cards so the lowest ranks higher.
+type Standing struct {
+	Position       int
+	TeamID         string
+	Team           string
+	Played         int
+	Won            int
+	Drawn          int
+	Lost           int
+	GoalsFor       int
+	GoalsAgainst   int
+	GoalDifference int
+	Points         int
+	FairPlay       int
+}
+
+// TournamentStandings is the table of a tournament kept by the consumer each
+// time a match of the tournament finishes or a finished match is corrected,
+// its ID is the tournament one.
+type TournamentStandings struct {
+	ID        string `bson:"_id"`
+	Standings []Standing
+	Groups    []GroupStandings
+	Updated   time.Time
+}
+
+type StandingsRepo interface {
+	Save(ctx context.Context, s TournamentStandings) errs.AppError
+}
+
+func (s TournamentStandings) GetID() string {
+	return s.ID
+}
+
+func (s *TournamentStandings) SetID(id string) {
+	s.ID = id
+}
+
+// NewTournamentStandings computes the table of the tournament, and the group
+// tables of a tournament with groups.
+func NewTournamentStandings(t tournament.Tournament, matches []Match) TournamentStandings {
+	s := TournamentStandings{
+		ID:        t.ID,
+		Standings: ComputeStandings(t, matches),
+		Groups:    []GroupStandings{},
+		Updated:   time.Now(),
+	}
+
+	if len(t.Groups) > 0 {
+		s.Groups = ComputeGroupStandings(t, matches)
+	}
+
+	return s
+}
+
+type standingsTable struct {
+	rows  []Standing
+	index map[string]int
+}
+
+// ComputeStandings ranks the teams of the tournament by the finished matches,
+// teams level on points are ranked by the tournament tie-breakers and then
+// by name. Knockout matches do not count for the table.
+func ComputeStandings(t tournament.Tournament, matches []Match) []Standing {
+	finished := []Match{}
+	for _, mt := range matches {
+		if mt.Bracket != nil {
+			continue
+		}
+
+		if mt.Status == model.MatchStatusFinished && mt.IsTheMatchForTournament(t.ID) {
+			finished = append(finished, mt)
+		}
+	}
+
+	table := newStandingsTable(t.Teams)
+	for _, mt := range finished {
+		table.addMatch(mt)
+	}
+
+	rows := table.rows
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].Team < rows[j].Team
+	})
+
+	criteria := append([]model.TieBreaker{tieBreakerPoints}, t.GetTieBreakers()...)
+	rows = rankStandings(rows, criteria, finished)
+
+	for i := range rows {
+		rows[i].Position = i + 1
+	}
+
+	return rows
+}
+
+func newStandingsTable(teams []team.Team) *standingsTable {
+	table := &standingsTable{
+		rows:  []Standing{},
+		index: map[string]int{},
+	}
+
+	for _, tm := range teams {
+		table.row(tm)
+	}
+
+	return table
+}
+
+func (st *standingsTable) row(tm team.Team) *Standing {
+	i, ok := st.index[tm.ID]
+	if !ok {
+		st.rows = append(st.rows, Standing{TeamID: tm.ID, Team: tm.Name})
+		i = len(st.rows) - 1
+		st.index[tm.ID] = i
+	}
+	return &st.rows[i]
+}
+
+func (st *standingsTable) addMatch(mt Match) {
+	st.row(mt.HomeTeam).addResult(mt.Score.Home, mt.Score.Away)
+	st.row(mt.AwayTeam).addResult(mt.Score.Away, mt.Score.Home)
+
+	for _, c := range mt.Cards {
+		i, ok := st.index[c.TeamID]
+		if !ok {
+			continue
+		}
+
+		if c.Red {
+			st.rows[i].FairPlay += fairPlayRed
+		} else {
+			st.rows[i].FairPlay += c.Yellow * fairPlayYellow
+		}
+	}
+}
+
+func (s *Standing) addResult(goalsFor, goalsAgainst int) {
+	s.Played++
+	s.GoalsFor += goalsFor
+	s.GoalsAgainst += goalsAgainst
+	s.GoalDifference = s.GoalsFor - s.GoalsAgainst
+
+	switch {
+	case goalsFor > goalsAgainst:
+		s.Won++
+		s.Points += pointsForWin
+	case goalsFor == goalsAgainst:
+		s.Drawn++
+		s.Points += pointsForDraw
+	default:
+		s.Lost++
+	}
+}
+
+// rankStandings sorts the rows by the first criterion and ranks the rows
+// still level by the next ones.
+func rankStandings(rows []Standing, criteria []model.TieBreaker, matches []Match) []Standing {
+	if len(rows) < 2 || len(criteria) == 0 {
+		return rows
+	}
+
+	keys := standingKeys(criteria[0], rows, matches)
+	sort.SliceStable(rows, func(i, j int) bool {
+		return compareKeys(keys[rows[i].TeamID], keys[rows[j].TeamID]) > 0
+	})
+
+	ranked := []Standing{}
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && compareKeys(keys[rows[start].TeamID], keys[rows[end].TeamID]) == 0 {
+			end++
+		}
+
+		ranked = append(ranked, rankStandings(rows[start:end], criteria[1:], matches)...)
+		start = end
+	}
+
+	return ranked
+}
+
+// standingKeys returns for each team what it is ranked by, the higher the
+// better. Head-to-head ranks by a table of the matches among the level teams.
+func standingKeys(tb model.TieBreaker, rows []Standing, matches []Match) map[string][]int {
+	keys := map[string][]int{}
+
+	if tb == model.TieBreakerHeadToHead {
+		teams := map[string]bool{}
+		for _, s := range rows {
+			teams[s.TeamID] = true
+		}
+
+		table := newStandingsTable(nil)
+		for _, mt := range matches {
+			if teams[mt.HomeTeam.ID] && teams[mt.AwayTeam.ID] {
+				table.addMatch(mt)
+			}
+		}
+
+		for _, s := range rows {
+			h2h := Standing{}
+			if i, ok := table.index[s.TeamID]; ok {
+				h2h = table.rows[i]
+			}
+			keys[s.TeamID] = []int{h2h.Points, h2h.GoalDifference, h2h.GoalsFor}
+		}
+
+		return keys
+	}
+
+	for _, s := range rows {
+		switch tb {
+		case tieBreakerPoints:
+			keys[s.TeamID] = []int{s.Points}
+		case model.TieBreakerGoalDifference:
+			keys[s.TeamID] = []int{s.GoalDifference}
+		case model.TieBreakerGoalsScored:
+			keys[s.TeamID] = []int{s.GoalsFor}
+		case model.TieBreakerFairPlay:
+			keys[s.TeamID] = []int{-s.FairPlay}
+		}
+	}
+
+	return keys
+}
+
+func compareKeys(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+	return 0
+}
@@ -0,0 +1,136 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func finishedMatch(t tournament.Tournament, home, away team.Team, homeGoals, awayGoals int, cards ...PlayerCards) Match {
+	return Match{
+		Tournament: t,
+		HomeTeam:   home,
+		AwayTeam:   away,
+		Status:     model.MatchStatusFinished,
+		Score:      Score{Home: homeGoals, Away: awayGoals},
+		Cards:      cards,
+	}
+}
+
+func TestComputeStandings(t *testing.T) {
+	a := team.Team{ID: "a", Name: "Alpha"}
+	b := team.Team{ID: "b", Name: "Bravo"}
+	c := team.Team{ID: "c", Name: "Charlie"}
+	d := team.Team{ID: "d", Name: "Delta"}
+	e := team.Team{ID: "e", Name: "Echo"}
+
+	league := tournament.Tournament{ID: "1", Teams: []team.Team{a, b, c, d}}
+	other := tournament.Tournament{ID: "2"}
+
+	inProgress := finishedMatch(league, c, a, 4, 0)
+	inProgress.Status = model.MatchStatusInProgress
+
+	knockout := finishedMatch(league, c, b, 6, 0)
+	knockout.Bracket = &Bracket{}
+
+	matches := []Match{
+		finishedMatch(league, a, b, 1, 0),
+		finishedMatch(league, b, c, 5, 0),
+		finishedMatch(league, d, a, 1, 0, PlayerCards{TeamID: "d", Yellow: 2, Red: true}),
+		finishedMatch(league, c, d, 0, 0),
+		finishedMatch(other, c, d, 3, 0),
+		inProgress,
+		knockout,
+	}
+
+	testCases := []struct {
+		Name          string
+		TieBreakers   []model.TieBreaker
+		Matches       []Match
+		ExpectedOrder []string
+	}{
+		{
+			Name:          "Default tie-breakers rank by goal difference",
+			Matches:       matches,
+			ExpectedOrder: []string{"d", "b", "a", "c"},
+		}, {
+			Name:          "Head-to-head before goal difference",
+			TieBreakers:   []model.TieBreaker{model.TieBreakerHeadToHead, model.TieBreakerGoalDifference},
+			Matches:       matches,
+			ExpectedOrder: []string{"d", "a", "b", "c"},
+		}, {
+			Name:        "Fair play ranks the team with less cards",
+			TieBreakers: []model.TieBreaker{model.TieBreakerFairPlay},
+			Matches: []Match{
+				finishedMatch(league, a, b, 1, 1, PlayerCards{TeamID: "a", Yellow: 1}),
+				finishedMatch(league, c, d, 1, 1, PlayerCards{TeamID: "c", Yellow: 1}, PlayerCards{TeamID: "c", Red: true}),
+			},
+			ExpectedOrder: []string{"b", "d", "a", "c"},
+		}, {
+			Name:          "Teams level on everything are ranked by name",
+			Matches:       []Match{},
+			ExpectedOrder: []string{"a", "b", "c", "d"},
+		}, {
+			Name:          "Team of a match not in the tournament teams",
+			Matches:       []Match{finishedMatch(league, e, a, 2, 0)},
+			ExpectedOrder: []string{"e", "b", "c", "d", "a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		league.TieBreakers = tc.TieBreakers
+		standings := ComputeStandings(league, tc.Matches)
+
+		order := []string{}
+		for i, s := range standings {
+			assert.Equal(t, i+1, s.Position)
+			order = append(order, s.TeamID)
+		}
+		assert.Equal(t, tc.ExpectedOrder, order)
+	}
+}
+
+func TestComputeStandingsRow(t *testing.T) {
+	a := team.Team{ID: "a", Name: "Alpha"}
+	b := team.Team{ID: "b", Name: "Bravo"}
+
+	league := tournament.Tournament{ID: "1", Teams: []team.Team{a, b}}
+
+	standings := ComputeStandings(league, []Match{
+		finishedMatch(league, a, b, 3, 1, PlayerCards{TeamID: "b", Yellow: 2}),
+		finishedMatch(league, b, a, 2, 2),
+	})
+
+	assert.Equal(t, Standing{
+		Position:       1,
+		TeamID:         "a",
+		Team:           "Alpha",
+		Played:         2,
+		Won:            1,
+		Drawn:          1,
+		GoalsFor:       5,
+		GoalsAgainst:   3,
+		GoalDifference: 2,
+		Points:         4,
+	}, standings[0])
+
+	assert.Equal(t, Standing{
+		Position:       2,
+		TeamID:         "b",
+		Team:           "Bravo",
+		Played:         2,
+		Drawn:          1,
+		Lost:           1,
+		GoalsFor:       3,
+		GoalsAgainst:   5,
+		GoalDifference: -2,
+		Points:         1,
+		FairPlay:       2,
+	}, standings[1])
+}
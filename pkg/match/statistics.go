@@ -0,0 +1,117 @@
+package match
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+type Statistics struct {
+	MatchID string
+	Home    TeamStatistics
+	Away    TeamStatistics
+}
+
+type TeamStatistics struct {
+	TeamID        string
+	Team          string
+	Goals         int
+	Shots         int
+	ShotsOnTarget int
+	Corners       int
+	Fouls         int
+	Offsides      int
+	Saves         int
+	YellowCards   int
+	RedCards      int
+	Possession    int
+}
+
+// ComputeStatistics aggregates the events of each team, goals other than own
+// goals count as shots on target and possession is the last snapshot sent.
+func (mt *Match) ComputeStatistics() Statistics {
+	stats := Statistics{
+		MatchID: mt.ID,
+		Home:    TeamStatistics{TeamID: mt.HomeTeam.ID, Team: mt.HomeTeam.Name, Goals: mt.Score.Home},
+		Away:    TeamStatistics{TeamID: mt.AwayTeam.ID, Team: mt.AwayTeam.Name, Goals: mt.Score.Away},
+	}
+
+	for _, e := range mt.Events {
+		switch v := e.Value.(type) {
+		case event.Possession:
+			stats.Home.Possession = v.Home
+			stats.Away.Possession = v.Away
+			continue
+		case event.Goal:
+			if v.Type == model.GoalOwnGoal {
+				continue
+			}
+		}
+
+		ts := stats.team(e.TeamID())
+		if ts == nil {
+			continue
+		}
+
+		switch v := e.Value.(type) {
+		case event.Goal:
+			ts.Shots++
+			ts.ShotsOnTarget++
+		case event.Shot:
+			ts.Shots++
+			if v.OnTarget {
+				ts.ShotsOnTarget++
+			}
+		case event.Corner:
+			ts.Corners++
+		case event.Foul:
+			ts.Fouls++
+		case event.Offside:
+			ts.Offsides++
+		case event.Save:
+			ts.Saves++
+		}
+	}
+
+	for _, c := range mt.ComputeCards() {
+		ts := stats.team(c.TeamID)
+		if ts == nil {
+			continue
+		}
+
+		ts.YellowCards += c.Yellow
+		if c.Red {
+			ts.RedCards++
+		}
+	}
+
+	return stats
+}
+
+func (s *Statistics) team(teamID string) *TeamStatistics {
+	switch teamID {
+	case "":
+		return nil
+	case s.Home.TeamID:
+		return &s.Home
+	case s.Away.TeamID:
+		return &s.Away
+	}
+	return nil
+}
+
+// CheckPossession fails when a possession snapshot does not split the ball
+// between both teams.
+func CheckPossession(e event.Event) errs.AppError {
+	possession, ok := e.Value.(event.Possession)
+	if !ok {
+		return nil
+	}
+
+	if possession.Home < 0 || possession.Away < 0 || possession.Home+possession.Away != 100 {
+		return errs.ErrPossessionInvalid.Throwf(applog.Log, errs.ErrFmtMore, possession.Home, possession.Away)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+func TestComputeStatistics(t *testing.T) {
+	home := team.Team{ID: "home", Name: "Home"}
+	away := team.Team{ID: "away", Name: "Away"}
+	other := team.Team{ID: "other"}
+
+	p1 := player.Player{ID: "p1"}
+	p2 := player.Player{ID: "p2"}
+
+	mt := Match{ID: "1", HomeTeam: home, AwayTeam: away, Status: model.MatchStatusInProgress}
+	mt.AddEvent(event.New("1", "1", event.Shot{Team: home, Player: p1, OnTarget: true, Minute: 5}))
+	mt.AddEvent(event.New("1", "1", event.Save{Team: away, Player: p2, Minute: 5}))
+	mt.AddEvent(event.New("1", "1", event.Shot{Team: home, Player: p1, Minute: 8}))
+	mt.AddEvent(event.New("1", "1", event.Corner{Team: home, Minute: 9}))
+	mt.AddEvent(event.New("1", "1", event.Goal{Team: home, Player: p1, Minute: 10}))
+	mt.AddEvent(event.New("1", "1", event.Goal{Team: away, Player: p1, Minute: 12, Type: model.GoalOwnGoal}))
+	mt.AddEvent(event.New("1", "1", event.Foul{Team: away, Player: p2, Minute: 14}))
+	mt.AddEvent(event.New("1", "1", event.Warning{Team: away, Player: p2, Warning: model.WarningYellowCard, Minute: 14}))
+	mt.AddEvent(event.New("1", "1", event.Offside{Team: away, Player: p2, Minute: 20}))
+	mt.AddEvent(event.New("1", "1", event.Possession{Home: 55, Away: 45, Minute: 15}))
+	mt.AddEvent(event.New("1", "1", event.Possession{Home: 61, Away: 39, Minute: 30}))
+	mt.AddEvent(event.New("1", "1", event.Foul{Team: other, Player: p2, Minute: 31}))
+
+	assert.Equal(t, Statistics{
+		MatchID: "1",
+		Home: TeamStatistics{
+			TeamID:        "home",
+			Team:          "Home",
+			Goals:         1,
+			Shots:         3,
+			ShotsOnTarget: 2,
+			Corners:       1,
+			Possession:    61,
+		},
+		Away: TeamStatistics{
+			TeamID:      "away",
+			Team:        "Away",
+			Goals:       1,
+			Fouls:       1,
+			Offsides:    1,
+			Saves:       1,
+			YellowCards: 1,
+			Possession:  39,
+		},
+	}, mt.ComputeStatistics())
+
+	empty := Match{ID: "2", HomeTeam: home, AwayTeam: away}
+	assert.Equal(t, Statistics{
+		MatchID: "2",
+		Home:    TeamStatistics{TeamID: "home", Team: "Home"},
+		Away:    TeamStatistics{TeamID: "away", Team: "Away"},
+	}, empty.ComputeStatistics())
+}
+
+func TestApplyEventPossession(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Possession  event.Possession
+		ExpectError bool
+	}{
+		{
+			Name:       "Should record the possession",
+			Possession: event.Possession{Home: 58, Away: 42, Minute: 30},
+		}, {
+			Name:       "Should record a possession for a single team",
+			Possession: event.Possession{Home: 100, Away: 0, Minute: 1},
+		}, {
+			Name:        "Should not record a possession not adding up to 100",
+			Possession:  event.Possession{Home: 58, Away: 40, Minute: 30},
+			ExpectError: true,
+		}, {
+			Name:        "Should not record a negative possession",
+			Possession:  event.Possession{Home: 110, Away: -10, Minute: 30},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		mt := Match{ID: "1", Status: model.MatchStatusInProgress, Period: model.PeriodFirstHalf}
+
+		err := mt.ApplyEvent(event.New("1", "1", tc.Possession))
+		if tc.ExpectError {
+			assert.NotNil(t, err)
+			assert.Equal(t, errs.ErrPossessionInvalid.Code(), err.Code())
+			assert.Empty(t, mt.Events)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Len(t, mt.Events, 1)
+	}
+}
@@ -0,0 +1,256 @@
+package match
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+var statusTransitions = map[model.MatchStatus][]model.MatchStatus{
+	model.MatchStatusNotStart:   {model.MatchStatusInProgress, model.MatchStatusPostponed, model.MatchStatusCancelled},
+	model.MatchStatusInProgress: {model.MatchStatusHalftime, model.MatchStatusFinished, model.MatchStatusSuspended, model.MatchStatusAbandoned},
+	model.MatchStatusHalftime:   {model.MatchStatusInProgress, model.MatchStatusPenalties, model.MatchStatusAbandoned},
+	model.MatchStatusPenalties:  {model.MatchStatusFinished, model.MatchStatusAbandoned},
+	model.MatchStatusPostponed:  {model.MatchStatusInProgress, model.MatchStatusPostponed, model.MatchStatusCancelled},
+	model.MatchStatusSuspended:  {model.MatchStatusInProgress, model.MatchStatusAbandoned},
+	model.MatchStatusFinished:   {},
+	model.MatchStatusAbandoned:  {},
+	model.MatchStatusCancelled:  {},
+}
+
+type eventTransition struct {
+	From    []model.MatchStatus
+	Periods []model.MatchPeriod
+	To      model.MatchStatus
+	Period  model.MatchPeriod
+	Level   bool
+}
+
+// events that move the match to another status, Periods restricts the
+// period the match must be in and Level requires a draw
+var eventTransitions = map[model.EventsMatchType]eventTransition{
+	model.EventStart: {
+		From:   []model.MatchStatus{model.MatchStatusNotStart, model.MatchStatusPostponed},
+		To:     model.MatchStatusInProgress,
+		Period: model.PeriodFirstHalf,
+	},
+	model.EventHalftime: {
+		From: []model.MatchStatus{model.MatchStatusInProgress},
+		To:   model.MatchStatusHalftime,
+	},
+	model.EventSecondHalf: {
+		From:    []model.MatchStatus{model.MatchStatusHalftime},
+		Periods: []model.MatchPeriod{model.PeriodFirstHalf},
+		To:      model.MatchStatusInProgress,
+		Period:  model.PeriodSecondHalf,
+	},
+	model.EventExtraTimeFirstHalf: {
+		From:    []model.MatchStatus{model.MatchStatusHalftime},
+		Periods: []model.MatchPeriod{model.PeriodSecondHalf},
+		To:      model.MatchStatusInProgress,
+		Period:  model.PeriodExtraTimeFirstHalf,
+		Level:   true,
+	},
+	model.EventExtraTimeSecondHalf: {
+		From:    []model.MatchStatus{model.MatchStatusHalftime},
+		Periods: []model.MatchPeriod{model.PeriodExtraTimeFirstHalf},
+		To:      model.MatchStatusInProgress,
+		Period:  model.PeriodExtraTimeSecondHalf,
+	},
+	model.EventPenaltyShootout: {
+		From:    []model.MatchStatus{model.MatchStatusHalftime},
+		Periods: []model.MatchPeriod{model.PeriodSecondHalf, model.PeriodExtraTimeSecondHalf},
+		To:      model.MatchStatusPenalties,
+		Period:  model.PeriodPenalties,
+		Level:   true,
+	},
+	model.EventFinish: {
+		From: []model.MatchStatus{model.MatchStatusInProgress, model.MatchStatusPenalties},
+		To:   model.MatchStatusFinished,
+	},
+	model.EventPostpone: {
+		From: []model.MatchStatus{model.MatchStatusNotStart, model.MatchStatusPostponed},
+		To:   model.MatchStatusPostponed,
+	},
+	model.EventSuspend: {
+		From: []model.MatchStatus{model.MatchStatusInProgress},
+		To:   model.MatchStatusSuspended,
+	},
+	model.EventResume: {
+		From: []model.MatchStatus{model.MatchStatusSuspended},
+		To:   model.MatchStatusInProgress,
+	},
+	model.EventAbandon: {
+		From: []model.MatchStatus{model.MatchStatusInProgress, model.MatchStatusHalftime, model.MatchStatusPenalties, model.MatchStatusSuspended},
+		To:   model.MatchStatusAbandoned,
+	},
+	model.EventCancel: {
+		From: []model.MatchStatus{model.MatchStatusNotStart, model.MatchStatusPostponed},
+		To:   model.MatchStatusCancelled,
+	},
+}
+
+// events that keep the match status, allowed only in these statuses
+var eventStatuses = map[model.EventsMatchType][]model.MatchStatus{
+	model.EventGoal:         {model.MatchStatusInProgress},
+	model.EventWarning:      {model.MatchStatusInProgress},
+	model.EventExtratime:    {model.MatchStatusInProgress},
+	model.EventSubstitution: {model.MatchStatusInProgress, model.MatchStatusHalftime},
+	model.EventPenaltyKick:  {model.MatchStatusPenalties},
+	model.EventLineup:       {model.MatchStatusNotStart, model.MatchStatusPostponed},
+	model.EventShot:         {model.MatchStatusInProgress},
+	model.EventCorner:       {model.MatchStatusInProgress},
+	model.EventFoul:         {model.MatchStatusInProgress},
+	model.EventOffside:      {model.MatchStatusInProgress},
+	model.EventSave:         {model.MatchStatusInProgress},
+	model.EventPossession:   {model.MatchStatusInProgress},
+	model.EventRetraction:   {model.MatchStatusInProgress, model.MatchStatusHalftime, model.MatchStatusPenalties, model.MatchStatusSuspended, model.MatchStatusFinished, model.MatchStatusAbandoned},
+	model.EventAmendment:    {model.MatchStatusInProgress, model.MatchStatusHalftime, model.MatchStatusPenalties, model.MatchStatusSuspended, model.MatchStatusFinished, model.MatchStatusAbandoned},
+}
+
+func CanTransition(from, to model.MatchStatus) bool {
+	return containsStatus(statusTransitions[from], to)
+}
+
+func (mt *Match) Transition(to model.MatchStatus) errs.AppError {
+	if !CanTransition(mt.Status, to) {
+		return errs.ErrMatchInvalidTransition.Throwf(applog.Log, errs.ErrFmtMore, mt.Status, to)
+	}
+
+	mt.Status = to
+	return nil
+}
+
+func (mt Match) CanApply(t model.EventsMatchType) errs.AppError {
+	if t == model.EventStart && mt.Bracket != nil && (mt.HomeTeam.ID == "" || mt.AwayTeam.ID == "") {
+		return errs.ErrMatchTeamsNotDecided.Throwf(applog.Log, errs.ErrFmt, mt.ID)
+	}
+
+	if tr, ok := eventTransitions[t]; ok {
+		if !containsStatus(tr.From, mt.Status) || !CanTransition(mt.Status, tr.To) {
+			return errs.ErrMatchInvalidTransition.Throwf(applog.Log, errs.ErrFmtMore, mt.Status, tr.To)
+		}
+
+		if tr.Periods != nil && !containsPeriod(tr.Periods, mt.CurrentPeriod()) {
+			return errs.ErrMatchInvalidTransition.Throwf(applog.Log, errs.ErrFmtMore, mt.CurrentPeriod(), tr.Period)
+		}
+
+		if tr.Level && !mt.Score.IsLevel() {
+			return errs.ErrMatchIsNotLevel.Throwf(applog.Log, errs.ErrFmtMore, mt.Score.Home, mt.Score.Away)
+		}
+
+		if t == model.EventFinish && mt.Status == model.MatchStatusPenalties && !mt.Score.PenaltiesDecided() {
+			return errs.ErrMatchPenaltiesNotDecided.Throwf(applog.Log, errs.ErrFmt, mt.ID)
+		}
+
+		if t == model.EventFinish && mt.Status == model.MatchStatusInProgress && mt.Bracket != nil && mt.Score.IsLevel() {
+			return errs.ErrMatchNeedsWinner.Throwf(applog.Log, errs.ErrFmtMore, mt.Score.Home, mt.Score.Away)
+		}
+
+		return nil
+	}
+
+	if !containsStatus(eventStatuses[t], mt.Status) {
+		return errs.ErrMatchEventNotAllowed.Throwf(applog.Log, errs.ErrFmtMore, t, mt.Status)
+	}
+
+	return nil
+}
+
+func (mt *Match) Apply(t model.EventsMatchType) errs.AppError {
+	err := mt.CanApply(t)
+	if err != nil {
+		return err
+	}
+
+	if tr, ok := eventTransitions[t]; ok {
+		mt.Status = tr.To
+		if tr.Period != "" {
+			mt.Period = tr.Period
+		}
+	}
+
+	return nil
+}
+
+func containsStatus(statuses []model.MatchStatus, status model.MatchStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPeriod(periods []model.MatchPeriod, period model.MatchPeriod) bool {
+	for _, p := range periods {
+		if p == period {
+			return true
+		}
+	}
+	return false
+}
+
+// Started reports whether the ball was ever put in play
+func (mt Match) Started() bool {
+	switch mt.Status {
+	case model.MatchStatusNotStart, model.MatchStatusPostponed, model.MatchStatusCancelled:
+		return false
+	}
+	return true
+}
+
+// matches started before periods were tracked have no Period stored
+func (mt Match) CurrentPeriod() model.MatchPeriod {
+	if mt.Period == "" && mt.Started() {
+		return model.PeriodFirstHalf
+	}
+	return mt.Period
+}
+
+func (mt Match) Decision() model.MatchDecision {
+	switch mt.CurrentPeriod() {
+	case model.PeriodPenalties:
+		return model.DecisionPenalties
+	case model.PeriodExtraTimeFirstHalf, model.PeriodExtraTimeSecondHalf:
+		return model.DecisionExtraTime
+	}
+	return model.DecisionRegularTime
+}
+
+// ApplyEvent ignores an event the match already has, so a message delivered
+// again leaves the match as it was.
+func (mt *Match) ApplyEvent(e event.Event) errs.AppError {
+	if mt.HasEvent(e.ID) {
+		return nil
+	}
+
+	err := mt.CheckSentOff(e)
+	if err != nil {
+		return err
+	}
+
+	err = mt.CheckSubstitution(e)
+	if err != nil {
+		return err
+	}
+
+	err = mt.CheckAttendance(e)
+	if err != nil {
+		return err
+	}
+
+	err = CheckPossession(e)
+	if err != nil {
+		return err
+	}
+
+	err = mt.Apply(e.Type)
+	if err != nil {
+		return err
+	}
+
+	mt.AddEvent(e)
+	return nil
+}
@@ -0,0 +1,374 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+func TestTransition(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		From          model.MatchStatus
+		To            model.MatchStatus
+		ExpectedError bool
+	}{
+		{
+			Name: "Should start a not started match",
+			From: model.MatchStatusNotStart,
+			To:   model.MatchStatusInProgress,
+		}, {
+			Name: "Should go to halftime a match in progress",
+			From: model.MatchStatusInProgress,
+			To:   model.MatchStatusHalftime,
+		}, {
+			Name: "Should resume a match after halftime",
+			From: model.MatchStatusHalftime,
+			To:   model.MatchStatusInProgress,
+		}, {
+			Name: "Should finish a match in progress",
+			From: model.MatchStatusInProgress,
+			To:   model.MatchStatusFinished,
+		}, {
+			Name:          "Should not finish a not started match",
+			From:          model.MatchStatusNotStart,
+			To:            model.MatchStatusFinished,
+			ExpectedError: true,
+		}, {
+			Name:          "Should not finish a match in halftime",
+			From:          model.MatchStatusHalftime,
+			To:            model.MatchStatusFinished,
+			ExpectedError: true,
+		}, {
+			Name: "Should postpone a not started match",
+			From: model.MatchStatusNotStart,
+			To:   model.MatchStatusPostponed,
+		}, {
+			Name: "Should suspend a match in progress",
+			From: model.MatchStatusInProgress,
+			To:   model.MatchStatusSuspended,
+		}, {
+			Name:          "Should not cancel a match in progress",
+			From:          model.MatchStatusInProgress,
+			To:            model.MatchStatusCancelled,
+			ExpectedError: true,
+		}, {
+			Name:          "Should not restart an abandoned match",
+			From:          model.MatchStatusAbandoned,
+			To:            model.MatchStatusInProgress,
+			ExpectedError: true,
+		}, {
+			Name:          "Should not restart a finished match",
+			From:          model.MatchStatusFinished,
+			To:            model.MatchStatusInProgress,
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		mt := Match{Status: tc.From}
+
+		err := mt.Transition(tc.To)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+			assert.Equal(t, tc.From, mt.Status)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, tc.To, mt.Status)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Status         model.MatchStatus
+		Type           model.EventsMatchType
+		ExpectedStatus model.MatchStatus
+		ExpectedError  bool
+	}{
+		{
+			Name:           "Should start a not started match",
+			Status:         model.MatchStatusNotStart,
+			Type:           model.EventStart,
+			ExpectedStatus: model.MatchStatusInProgress,
+		}, {
+			Name:           "Should keep status on a goal in progress",
+			Status:         model.MatchStatusInProgress,
+			Type:           model.EventGoal,
+			ExpectedStatus: model.MatchStatusInProgress,
+		}, {
+			Name:           "Should accept a substitution in halftime",
+			Status:         model.MatchStatusHalftime,
+			Type:           model.EventSubstitution,
+			ExpectedStatus: model.MatchStatusHalftime,
+		}, {
+			Name:           "Should accept a retraction after the match is finished",
+			Status:         model.MatchStatusFinished,
+			Type:           model.EventRetraction,
+			ExpectedStatus: model.MatchStatusFinished,
+		}, {
+			Name:           "Should not accept a goal in halftime",
+			Status:         model.MatchStatusHalftime,
+			Type:           model.EventGoal,
+			ExpectedStatus: model.MatchStatusHalftime,
+			ExpectedError:  true,
+		}, {
+			Name:           "Should not accept a warning before the match starts",
+			Status:         model.MatchStatusNotStart,
+			Type:           model.EventWarning,
+			ExpectedStatus: model.MatchStatusNotStart,
+			ExpectedError:  true,
+		}, {
+			Name:           "Should resume a match after halftime with the second half",
+			Status:         model.MatchStatusHalftime,
+			Type:           model.EventSecondHalf,
+			ExpectedStatus: model.MatchStatusInProgress,
+		}, {
+			Name:           "Should not start again a match in halftime",
+			Status:         model.MatchStatusHalftime,
+			Type:           model.EventStart,
+			ExpectedStatus: model.MatchStatusHalftime,
+			ExpectedError:  true,
+		}, {
+			Name:           "Should not start the second half of a match in progress",
+			Status:         model.MatchStatusInProgress,
+			Type:           model.EventSecondHalf,
+			ExpectedStatus: model.MatchStatusInProgress,
+			ExpectedError:  true,
+		}, {
+			Name:           "Should start a postponed match",
+			Status:         model.MatchStatusPostponed,
+			Type:           model.EventStart,
+			ExpectedStatus: model.MatchStatusInProgress,
+		}, {
+			Name:           "Should postpone a postponed match again",
+			Status:         model.MatchStatusPostponed,
+			Type:           model.EventPostpone,
+			ExpectedStatus: model.MatchStatusPostponed,
+		}, {
+			Name:           "Should cancel a postponed match",
+			Status:         model.MatchStatusPostponed,
+			Type:           model.EventCancel,
+			ExpectedStatus: model.MatchStatusCancelled,
+		}, {
+			Name:           "Should resume a suspended match",
+			Status:         model.MatchStatusSuspended,
+			Type:           model.EventResume,
+			ExpectedStatus: model.MatchStatusInProgress,
+		}, {
+			Name:           "Should abandon a suspended match",
+			Status:         model.MatchStatusSuspended,
+			Type:           model.EventAbandon,
+			ExpectedStatus: model.MatchStatusAbandoned,
+		}, {
+			Name:           "Should abandon a match in halftime",
+			Status:         model.MatchStatusHalftime,
+			Type:           model.EventAbandon,
+			ExpectedStatus: model.MatchStatusAbandoned,
+		}, {
+			Name:           "Should accept a retraction in a suspended match",
+			Status:         model.MatchStatusSuspended,
+			Type:           model.EventRetraction,
+			ExpectedStatus: model.MatchStatusSuspended,
+		}, {
+			Name:           "Should not accept a goal in a suspended match",
+			Status:         model.MatchStatusSuspended,
+			Type:           model.EventGoal,
+			ExpectedStatus: model.MatchStatusSuspended,
+			ExpectedError:  true,
+		}, {
+			Name:           "Should not suspend a not started match",
+			Status:         model.MatchStatusNotStart,
+			Type:           model.EventSuspend,
+			ExpectedStatus: model.MatchStatusNotStart,
+			ExpectedError:  true,
+		}, {
+			Name:           "Should not resume a match in progress",
+			Status:         model.MatchStatusInProgress,
+			Type:           model.EventResume,
+			ExpectedStatus: model.MatchStatusInProgress,
+			ExpectedError:  true,
+		}, {
+			Name:           "Should not cancel a match in progress",
+			Status:         model.MatchStatusInProgress,
+			Type:           model.EventCancel,
+			ExpectedStatus: model.MatchStatusInProgress,
+			ExpectedError:  true,
+		}, {
+			Name:           "Should not start a cancelled match",
+			Status:         model.MatchStatusCancelled,
+			Type:           model.EventStart,
+			ExpectedStatus: model.MatchStatusCancelled,
+			ExpectedError:  true,
+		}, {
+			Name:           "Should not start a match twice",
+			Status:         model.MatchStatusInProgress,
+			Type:           model.EventStart,
+			ExpectedStatus: model.MatchStatusInProgress,
+			ExpectedError:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		mt := Match{Status: tc.Status}
+
+		err := mt.Apply(tc.Type)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, tc.ExpectedStatus, mt.Status)
+	}
+}
+
+func TestApplyEvent(t *testing.T) {
+	mt := Match{ID: "1", Status: model.MatchStatusNotStart}
+
+	err := mt.ApplyEvent(event.New("1", "1", event.Halftime{Halftime: "16:45"}))
+	assert.NotNil(t, err)
+	assert.Len(t, mt.Events, 0)
+
+	err = mt.ApplyEvent(event.New("1", "1", event.Start{TimeStarted: "16:00"}))
+	assert.Nil(t, err)
+	assert.Len(t, mt.Events, 1)
+	assert.Equal(t, model.MatchStatusInProgress, mt.Status)
+	assert.Equal(t, model.MatchStatusInProgress, mt.Score.Status)
+	assert.Equal(t, model.PeriodFirstHalf, mt.Period)
+
+	err = mt.ApplyEvent(event.New("1", "1", event.Halftime{Halftime: "16:45"}))
+	assert.Nil(t, err)
+	assert.Equal(t, model.MatchStatusHalftime, mt.Status)
+
+	err = mt.ApplyEvent(event.New("1", "1", event.SecondHalf{TimeStarted: "17:00"}))
+	assert.Nil(t, err)
+	assert.Equal(t, model.MatchStatusInProgress, mt.Status)
+	assert.Equal(t, model.PeriodSecondHalf, mt.Period)
+	assert.Len(t, mt.Events, 3)
+}
+
+func TestApplyEventAlreadyApplied(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	mt := Match{ID: "1", HomeTeam: home, AwayTeam: away, Status: model.MatchStatusInProgress, Period: model.PeriodFirstHalf}
+
+	goal := event.New("1", "1", event.Goal{Team: home, Player: player.Player{ID: "p1"}, Minute: 10})
+	assert.Nil(t, mt.ApplyEvent(goal))
+	assert.Nil(t, mt.ApplyEvent(goal))
+	assert.Len(t, mt.Events, 1)
+	assert.Equal(t, 1, mt.Score.Home)
+
+	retraction := event.New("1", "1", event.Retraction{EventID: goal.ID, Reason: "Offside"})
+	assert.Nil(t, mt.ApplyEvent(retraction))
+	assert.Nil(t, mt.ApplyEvent(goal))
+	assert.Nil(t, mt.ApplyEvent(retraction))
+	assert.Len(t, mt.Events, 1)
+	assert.Equal(t, 0, mt.Score.Home)
+
+	assert.True(t, mt.HasEvent(goal.ID))
+	assert.True(t, mt.HasEvent(retraction.ID))
+	assert.False(t, mt.HasEvent(""))
+	assert.False(t, mt.HasEvent("unknown"))
+}
+
+func TestApplyEventStatusChanges(t *testing.T) {
+	mt := Match{ID: "1", Status: model.MatchStatusNotStart, Kickoff: time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC)}
+
+	apply := func(v event.Value) errs.AppError {
+		return mt.ApplyEvent(event.New("1", "1", v))
+	}
+
+	newKickoff := time.Date(2022, 2, 8, 19, 0, 0, 0, time.UTC)
+	assert.Nil(t, apply(event.Postpone{Reason: "Waterlogged pitch", Kickoff: &newKickoff}))
+	assert.Equal(t, model.MatchStatusPostponed, mt.Status)
+	assert.Equal(t, "Waterlogged pitch", mt.Reason)
+	assert.Equal(t, newKickoff, mt.Kickoff)
+	assert.Equal(t, model.MatchPeriod(""), mt.CurrentPeriod())
+
+	assert.Nil(t, apply(event.Start{TimeStarted: "19:00"}))
+	assert.Equal(t, "", mt.Reason)
+
+	assert.Nil(t, apply(event.Suspend{Reason: "Floodlight failure", TimeSuspended: "19:20"}))
+	assert.Equal(t, model.MatchStatusSuspended, mt.Status)
+	assert.Equal(t, "Floodlight failure", mt.Reason)
+	assert.Equal(t, model.PeriodFirstHalf, mt.CurrentPeriod())
+
+	assert.Nil(t, apply(event.Resume{TimeResumed: "19:40"}))
+	assert.Equal(t, model.MatchStatusInProgress, mt.Status)
+	assert.Equal(t, model.PeriodFirstHalf, mt.Period)
+	assert.Equal(t, "", mt.Reason)
+
+	assert.Nil(t, apply(event.Abandon{Reason: "Crowd trouble", TimeAbandoned: "19:50"}))
+	assert.Equal(t, model.MatchStatusAbandoned, mt.Status)
+	assert.Equal(t, "Crowd trouble", mt.Reason)
+	assert.NotNil(t, apply(event.Resume{TimeResumed: "20:00"}))
+}
+
+func TestApplyEventKnockout(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	mt := Match{ID: "1", HomeTeam: home, AwayTeam: away, Status: model.MatchStatusNotStart}
+
+	apply := func(v event.Value) errs.AppError {
+		return mt.ApplyEvent(event.New("1", "1", v))
+	}
+
+	assert.Nil(t, apply(event.Start{TimeStarted: "16:00"}))
+	assert.Nil(t, apply(event.Goal{Team: home, Player: player.Player{ID: "p1"}, Minute: 10}))
+	assert.Nil(t, apply(event.Halftime{Halftime: "16:45"}))
+
+	assert.NotNil(t, apply(event.ExtraTimeFirstHalf{TimeStarted: "17:00"}))
+	assert.Nil(t, apply(event.SecondHalf{TimeStarted: "17:00"}))
+	assert.Nil(t, apply(event.Goal{Team: away, Player: player.Player{ID: "p2"}, Minute: 80}))
+	assert.Nil(t, apply(event.Halftime{Halftime: "17:50"}))
+
+	assert.Nil(t, apply(event.ExtraTimeFirstHalf{TimeStarted: "17:55"}))
+	assert.Equal(t, model.PeriodExtraTimeFirstHalf, mt.Period)
+	assert.Equal(t, model.DecisionExtraTime, mt.Decision())
+	assert.Nil(t, apply(event.Halftime{Halftime: "18:10"}))
+	assert.NotNil(t, apply(event.PenaltyShootout{TimeStarted: "18:10"}))
+	assert.Nil(t, apply(event.ExtraTimeSecondHalf{TimeStarted: "18:12"}))
+	assert.Nil(t, apply(event.Halftime{Halftime: "18:28"}))
+
+	assert.Nil(t, apply(event.PenaltyShootout{TimeStarted: "18:30"}))
+	assert.Equal(t, model.MatchStatusPenalties, mt.Status)
+	assert.NotNil(t, apply(event.Goal{Team: home, Player: player.Player{ID: "p1"}, Minute: 121}))
+
+	assert.Nil(t, apply(event.PenaltyKick{Team: home, Player: player.Player{ID: "p1"}, Scored: true}))
+	assert.Nil(t, apply(event.PenaltyKick{Team: away, Player: player.Player{ID: "p2"}, Scored: true}))
+	assert.NotNil(t, apply(event.Finish{TimeFinished: "18:40", DecidedBy: mt.Decision()}))
+
+	assert.Nil(t, apply(event.PenaltyKick{Team: home, Player: player.Player{ID: "p3"}, Scored: true}))
+	assert.Nil(t, apply(event.PenaltyKick{Team: away, Player: player.Player{ID: "p4"}, Scored: false}))
+	assert.Nil(t, apply(event.Finish{TimeFinished: "18:45", DecidedBy: mt.Decision()}))
+
+	assert.Equal(t, model.MatchStatusFinished, mt.Status)
+	assert.Equal(t, 1, mt.Score.Home)
+	assert.Equal(t, 1, mt.Score.Away)
+	assert.Equal(t, &Penalties{
+		Home: 2,
+		Away: 1,
+		Kicks: []PenaltyKick{
+			{TeamID: "home", PlayerID: "p1", Scored: true},
+			{TeamID: "away", PlayerID: "p2", Scored: true},
+			{TeamID: "home", PlayerID: "p3", Scored: true},
+			{TeamID: "away", PlayerID: "p4", Scored: false},
+		},
+	}, mt.Score.Penalties)
+	assert.Equal(t, model.DecisionPenalties, mt.Score.DecidedBy)
+	assert.Equal(t, "home", mt.Score.Winner)
+}
@@ -0,0 +1,86 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+type substitutionCount struct {
+	Substitutions int
+	Concussion    int
+	Windows       map[string]bool
+}
+
+// windows are keyed by period and minute, the substitutions made together
+// while the ball is in play share a window
+func (mt Match) substitutionsUsed(teamID string) substitutionCount {
+	used := substitutionCount{Windows: map[string]bool{}}
+
+	mt.walkPeriods(func(e event.Event, period model.MatchPeriod, inPlay bool) {
+		sub, ok := e.Value.(event.Substitution)
+		if !ok || sub.Team.ID != teamID {
+			return
+		}
+
+		if sub.Concussion {
+			used.Concussion++
+			return
+		}
+
+		used.Substitutions++
+		if inPlay {
+			used.Windows[windowKey(period, sub.Minute, sub.Stoppage)] = true
+		}
+	})
+
+	return used
+}
+
+func windowKey(period model.MatchPeriod, minute, stoppage int) string {
+	return fmt.Sprintf("%s-%d+%d", period, minute, stoppage)
+}
+
+func (mt Match) inExtraTime() bool {
+	period := mt.CurrentPeriod()
+	return period == model.PeriodExtraTimeFirstHalf || period == model.PeriodExtraTimeSecondHalf
+}
+
+// CheckSubstitutionRules checks a substitution against the tournament rules,
+// extra time allowances only count once extra time started.
+func (mt Match) CheckSubstitutionRules(rules tournament.SubstitutionRules, e event.Event) errs.AppError {
+	sub, ok := e.Value.(event.Substitution)
+	if !ok {
+		return nil
+	}
+
+	used := mt.substitutionsUsed(sub.Team.ID)
+
+	if sub.Concussion {
+		if used.Concussion >= rules.ConcussionSubstitutions {
+			return errs.ErrConcussionSubstitution.Throwf(applog.Log, errs.ErrFmtMore, sub.Team.ID, used.Concussion)
+		}
+		return nil
+	}
+
+	maxSubstitutions, maxWindows := rules.MaxSubstitutions, rules.MaxWindows
+	if mt.inExtraTime() {
+		maxSubstitutions += rules.ExtraTimeSubstitutions
+		maxWindows += rules.ExtraTimeWindows
+	}
+
+	if rules.MaxSubstitutions > 0 && used.Substitutions >= maxSubstitutions {
+		return errs.ErrSubstitutionLimit.Throwf(applog.Log, errs.ErrFmtMore, sub.Team.ID, used.Substitutions)
+	}
+
+	newWindow := mt.Status == model.MatchStatusInProgress && !used.Windows[windowKey(mt.CurrentPeriod(), sub.Minute, sub.Stoppage)]
+	if rules.MaxWindows > 0 && newWindow && len(used.Windows) >= maxWindows {
+		return errs.ErrSubstitutionWindowLimit.Throwf(applog.Log, errs.ErrFmtMore, sub.Team.ID, len(used.Windows))
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+	"github.com/rafaelsanzio/go-flashscore/pkg/tournament"
+)
+
+func TestCheckSubstitutionRules(t *testing.T) {
+	home := team.Team{ID: "home"}
+	away := team.Team{ID: "away"}
+
+	rules := tournament.SubstitutionRules{
+		MaxSubstitutions:        3,
+		MaxWindows:              2,
+		ExtraTimeSubstitutions:  1,
+		ExtraTimeWindows:        1,
+		ConcussionSubstitutions: 1,
+	}
+
+	sub := func(teamMock team.Team, minute int, concussion bool) event.Event {
+		return event.New("1", "1", event.Substitution{
+			Team:       teamMock,
+			PlayerOut:  player.Player{ID: "out"},
+			PlayerIn:   player.Player{ID: "in"},
+			Minute:     minute,
+			Concussion: concussion,
+		})
+	}
+
+	newMatch := func(events ...event.Event) Match {
+		mt := Match{ID: "1", HomeTeam: home, AwayTeam: away, Status: model.MatchStatusNotStart}
+		for _, e := range events {
+			assert.Nil(t, mt.ApplyEvent(e))
+		}
+		return mt
+	}
+
+	start := event.New("1", "1", event.Start{TimeStarted: "16:00"})
+	halftime := event.New("1", "1", event.Halftime{Halftime: "16:45"})
+	secondHalf := event.New("1", "1", event.SecondHalf{TimeStarted: "17:00"})
+	fullTime := event.New("1", "1", event.Halftime{Halftime: "17:50"})
+	extraTime := event.New("1", "1", event.ExtraTimeFirstHalf{TimeStarted: "17:55"})
+
+	testCases := []struct {
+		Name          string
+		Match         Match
+		Rules         tournament.SubstitutionRules
+		Substitution  event.Event
+		ExpectedError bool
+	}{
+		{
+			Name:          "Substitution within the limits",
+			Match:         newMatch(start, sub(home, 20, false)),
+			Rules:         rules,
+			Substitution:  sub(home, 30, false),
+			ExpectedError: false,
+		}, {
+			Name:          "Substitution in a window already used",
+			Match:         newMatch(start, sub(home, 20, false), sub(home, 30, false)),
+			Rules:         rules,
+			Substitution:  sub(home, 30, false),
+			ExpectedError: false,
+		}, {
+			Name:          "No windows left",
+			Match:         newMatch(start, sub(home, 20, false), sub(home, 30, false)),
+			Rules:         rules,
+			Substitution:  sub(home, 40, false),
+			ExpectedError: true,
+		}, {
+			Name:          "Halftime substitution does not use a window",
+			Match:         newMatch(start, sub(home, 20, false), sub(home, 30, false), halftime),
+			Rules:         rules,
+			Substitution:  sub(home, 45, false),
+			ExpectedError: false,
+		}, {
+			Name:          "No substitutions left",
+			Match:         newMatch(start, sub(home, 20, false), sub(home, 20, false), sub(home, 20, false)),
+			Rules:         rules,
+			Substitution:  sub(home, 20, false),
+			ExpectedError: true,
+		}, {
+			Name:          "Other team substitutions are not counted",
+			Match:         newMatch(start, sub(away, 20, false), sub(away, 20, false), sub(away, 20, false)),
+			Rules:         rules,
+			Substitution:  sub(home, 20, false),
+			ExpectedError: false,
+		}, {
+			Name:          "Extra time allowance",
+			Match:         newMatch(start, sub(home, 20, false), halftime, secondHalf, sub(home, 60, false), sub(home, 60, false), fullTime, extraTime),
+			Rules:         rules,
+			Substitution:  sub(home, 95, false),
+			ExpectedError: false,
+		}, {
+			Name:          "Concussion substitution does not count",
+			Match:         newMatch(start, sub(home, 20, false), sub(home, 20, false), sub(home, 20, false)),
+			Rules:         rules,
+			Substitution:  sub(home, 30, true),
+			ExpectedError: false,
+		}, {
+			Name:          "No concussion substitutions left",
+			Match:         newMatch(start, sub(home, 20, true)),
+			Rules:         rules,
+			Substitution:  sub(home, 30, true),
+			ExpectedError: true,
+		}, {
+			Name:          "No limits",
+			Match:         newMatch(start, sub(home, 20, false), sub(home, 30, false), sub(home, 40, false)),
+			Rules:         tournament.SubstitutionRules{},
+			Substitution:  sub(home, 50, false),
+			ExpectedError: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		err := tc.Match.CheckSubstitutionRules(tc.Rules, tc.Substitution)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"crypto/subtle"
 	"strings"
 	"time"
 
@@ -59,3 +60,22 @@ func IsAuthorized(ctx context.Context, token string) errs.AppError {
 
 	return nil
 }
+
+// IsAdmin checks the admin key of the request against the configured one,
+// no admin key is accepted while none is configured.
+func IsAdmin(adminKey string) errs.AppError {
+	if adminKey == "" {
+		return errs.ErrAdminKeyIsEmpty.Throwf(applog.Log, errs.ErrFmt)
+	}
+
+	adminApiKey, err_ := configValue(key.AdminApiKey)
+	if err_ != nil {
+		return err_
+	}
+
+	if adminApiKey == "" || subtle.ConstantTimeCompare([]byte(adminKey), []byte(adminApiKey)) != 1 {
+		return errs.ErrAdminKeyInvalid.Throwf(applog.Log, errs.ErrFmt)
+	}
+
+	return nil
+}
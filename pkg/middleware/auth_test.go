@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/apikey"
+	"github.com/rafaelsanzio/go-flashscore/pkg/config"
 	"github.com/rafaelsanzio/go-flashscore/pkg/config/key"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
@@ -113,3 +114,53 @@ func TestIsAuthorized(t *testing.T) {
 		}
 	}
 }
+
+func TestIsAdmin(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		AdminKey        string
+		ConfigValueFunc func(k key.Key) (string, errs.AppError)
+		ExpectedError   bool
+	}{
+		{
+			Name:            "Should authorize the admin key",
+			AdminKey:        "any_key",
+			ConfigValueFunc: fakeConfigValueWithAnyKey,
+			ExpectedError:   false,
+		}, {
+			Name:            "Should unauthorized with admin key empty",
+			AdminKey:        "",
+			ConfigValueFunc: fakeConfigValueWithAnyKey,
+			ExpectedError:   true,
+		}, {
+			Name:            "Should unauthorized with wrong admin key",
+			AdminKey:        "wrong_key",
+			ConfigValueFunc: fakeConfigValueWithAnyKey,
+			ExpectedError:   true,
+		}, {
+			Name:            "Should unauthorized when no admin key is configured",
+			AdminKey:        "any_key",
+			ConfigValueFunc: config.Value,
+			ExpectedError:   true,
+		}, {
+			Name:            "Should return an error on config value function",
+			AdminKey:        "any_key",
+			ConfigValueFunc: fakeConfigValue,
+			ExpectedError:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		configValue = tc.ConfigValueFunc
+		defer restoreConfigValue(configValue)
+
+		err := IsAdmin(tc.AdminKey)
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -48,7 +48,7 @@ var (
 type EventsMatchType string
 
 var (
-	eventsMatchTypes = make(map[string]EventsMatchType, 7)
+	eventsMatchTypes = make(map[string]EventsMatchType, 15)
 )
 
 func eventsMatchType(name string) EventsMatchType {
@@ -75,16 +75,38 @@ var (
 	EventStart        = eventsMatchType("Start")
 	EventGoal         = eventsMatchType("Goal")
 	EventHalftime     = eventsMatchType("Halftime")
+	EventSecondHalf   = eventsMatchType("SecondHalf")
 	EventExtratime    = eventsMatchType("Extratime")
 	EventSubstitution = eventsMatchType("Substitution")
 	EventWarning      = eventsMatchType("Warning")
 	EventFinish       = eventsMatchType("Finish")
+	EventRetraction   = eventsMatchType("Retraction")
+	EventAmendment    = eventsMatchType("Amendment")
+
+	EventExtraTimeFirstHalf  = eventsMatchType("ExtraTimeFirstHalf")
+	EventExtraTimeSecondHalf = eventsMatchType("ExtraTimeSecondHalf")
+	EventPenaltyShootout     = eventsMatchType("PenaltyShootout")
+	EventPenaltyKick         = eventsMatchType("PenaltyKick")
+	EventLineup              = eventsMatchType("Lineup")
+
+	EventPostpone = eventsMatchType("Postpone")
+	EventSuspend  = eventsMatchType("Suspend")
+	EventResume   = eventsMatchType("Resume")
+	EventAbandon  = eventsMatchType("Abandon")
+	EventCancel   = eventsMatchType("Cancel")
+
+	EventShot       = eventsMatchType("Shot")
+	EventCorner     = eventsMatchType("Corner")
+	EventFoul       = eventsMatchType("Foul")
+	EventOffside    = eventsMatchType("Offside")
+	EventSave       = eventsMatchType("Save")
+	EventPossession = eventsMatchType("Possession")
 )
 
 type MatchStatus string
 
 var (
-	matchStatusTypes = make(map[string]MatchStatus, 4)
+	matchStatusTypes = make(map[string]MatchStatus, 5)
 )
 
 func matchStatusType(name string) MatchStatus {
@@ -112,6 +134,78 @@ var (
 	MatchStatusInProgress = matchStatusType("InProgress")
 	MatchStatusHalftime   = matchStatusType("MatchHalftime")
 	MatchStatusFinished   = matchStatusType("Finished")
+	MatchStatusPenalties  = matchStatusType("PenaltyShootout")
+	MatchStatusPostponed  = matchStatusType("Postponed")
+	MatchStatusSuspended  = matchStatusType("Suspended")
+	MatchStatusAbandoned  = matchStatusType("Abandoned")
+	MatchStatusCancelled  = matchStatusType("Cancelled")
+)
+
+type MatchPeriod string
+
+var (
+	matchPeriodTypes = make(map[string]MatchPeriod, 5)
+)
+
+func matchPeriodType(name string) MatchPeriod {
+	i := MatchPeriod(name)
+	matchPeriodTypes[name] = i
+	return i
+}
+
+func (i *MatchPeriod) UnmarshalText(data []byte) error {
+
+	str := string(data)
+
+	val, ok := matchPeriodTypes[str]
+
+	if ok {
+		*i = val
+		return nil
+	}
+
+	return errs.ErrInvalidActionType.Throwf(applog.Log, "type: %s", str)
+}
+
+var (
+	PeriodFirstHalf  = matchPeriodType("FirstHalf")
+	PeriodSecondHalf = matchPeriodType("SecondHalf")
+
+	PeriodExtraTimeFirstHalf  = matchPeriodType("ExtraTimeFirstHalf")
+	PeriodExtraTimeSecondHalf = matchPeriodType("ExtraTimeSecondHalf")
+	PeriodPenalties           = matchPeriodType("PenaltyShootout")
+)
+
+type MatchDecision string
+
+var (
+	matchDecisionTypes = make(map[string]MatchDecision, 3)
+)
+
+func matchDecisionType(name string) MatchDecision {
+	i := MatchDecision(name)
+	matchDecisionTypes[name] = i
+	return i
+}
+
+func (i *MatchDecision) UnmarshalText(data []byte) error {
+
+	str := string(data)
+
+	val, ok := matchDecisionTypes[str]
+
+	if ok {
+		*i = val
+		return nil
+	}
+
+	return errs.ErrInvalidActionType.Throwf(applog.Log, "type: %s", str)
+}
+
+var (
+	DecisionRegularTime = matchDecisionType("RegularTime")
+	DecisionExtraTime   = matchDecisionType("ExtraTime")
+	DecisionPenalties   = matchDecisionType("Penalties")
 )
 
 type Warnings string
@@ -144,3 +238,103 @@ var (
 	WarningRedCard    = warningsType("RedCard")
 	WarningYellowCard = warningsType("YellowCard")
 )
+
+type GoalType string
+
+var (
+	goalTypes = make(map[string]GoalType, 5)
+)
+
+func goalType(name string) GoalType {
+	i := GoalType(name)
+	goalTypes[name] = i
+	return i
+}
+
+func (i *GoalType) UnmarshalText(data []byte) error {
+
+	str := string(data)
+
+	val, ok := goalTypes[str]
+
+	if ok {
+		*i = val
+		return nil
+	}
+
+	return errs.ErrInvalidActionType.Throwf(applog.Log, "type: %s", str)
+}
+
+var (
+	GoalOpenPlay = goalType("OpenPlay")
+	GoalPenalty  = goalType("Penalty")
+	GoalOwnGoal  = goalType("OwnGoal")
+	GoalFreeKick = goalType("FreeKick")
+	GoalHeader   = goalType("Header")
+)
+
+type OfficialRole string
+
+var (
+	officialRoleTypes = make(map[string]OfficialRole, 4)
+)
+
+func officialRoleType(name string) OfficialRole {
+	i := OfficialRole(name)
+	officialRoleTypes[name] = i
+	return i
+}
+
+func (i *OfficialRole) UnmarshalText(data []byte) error {
+
+	str := string(data)
+
+	val, ok := officialRoleTypes[str]
+
+	if ok {
+		*i = val
+		return nil
+	}
+
+	return errs.ErrInvalidActionType.Throwf(applog.Log, "type: %s", str)
+}
+
+var (
+	OfficialReferee          = officialRoleType("Referee")
+	OfficialAssistantReferee = officialRoleType("AssistantReferee")
+	OfficialFourthOfficial   = officialRoleType("FourthOfficial")
+	OfficialVideoAssistant   = officialRoleType("VideoAssistantReferee")
+)
+
+type TieBreaker string
+
+var (
+	tieBreakerTypes = make(map[string]TieBreaker, 4)
+)
+
+func tieBreakerType(name string) TieBreaker {
+	i := TieBreaker(name)
+	tieBreakerTypes[name] = i
+	return i
+}
+
+func (i *TieBreaker) UnmarshalText(data []byte) error {
+
+	str := string(data)
+
+	val, ok := tieBreakerTypes[str]
+
+	if ok {
+		*i = val
+		return nil
+	}
+
+	return errs.ErrInvalidActionType.Throwf(applog.Log, "type: %s", str)
+}
+
+var (
+	TieBreakerHeadToHead     = tieBreakerType("HeadToHead")
+	TieBreakerGoalDifference = tieBreakerType("GoalDifference")
+	TieBreakerGoalsScored    = tieBreakerType("GoalsScored")
+	TieBreakerFairPlay       = tieBreakerType("FairPlay")
+)
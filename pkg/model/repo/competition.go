@@ -0,0 +1,128 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store/query"
+)
+
+const (
+	CompetitionCollection = "competition"
+)
+
+type competitionRepo struct {
+	store store.Store
+}
+
+var competitionRepoSingleton competition.CompetitionRepo
+
+func GetCompetitionRepo() competition.CompetitionRepo {
+	if competitionRepoSingleton == nil {
+		return getCompetitionRepo()
+	}
+	return competitionRepoSingleton
+}
+
+func getCompetitionRepo() *competitionRepo {
+	s := store.GetStore()
+	return &competitionRepo{s}
+}
+
+func SetCompetitionRepo(repo competition.CompetitionRepo) {
+	competitionRepoSingleton = repo
+}
+
+func (repo competitionRepo) Insert(ctx context.Context, c competition.Competition) errs.AppError {
+	c.Created = time.Now()
+	_, err := repo.store.InsertOne(ctx, CompetitionCollection, &c)
+	return err
+}
+
+func (repo competitionRepo) Get(ctx context.Context, id string) (*competition.Competition, errs.AppError) {
+	filter := query.Filter{
+		"_id": id,
+	}
+
+	opts := query.FindOneOptions{}
+
+	mCompetition := competition.Competition{}
+	err := repo.store.FindOne(ctx, CompetitionCollection, filter, &mCompetition, opts)
+	if err != nil {
+		return nil, errs.ErrMongoFindOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", CompetitionCollection, id, err)
+	}
+
+	if mCompetition.ID == "" {
+		return nil, nil
+	}
+
+	return &mCompetition, nil
+}
+
+func (repo competitionRepo) List(ctx context.Context) ([]competition.Competition, errs.AppError) {
+	filter := query.Filter{}
+
+	opts := query.FindOptions{}
+	mCompetition := []competition.Competition{}
+	competitions, err := repo.store.Find(ctx, CompetitionCollection, filter, opts)
+	if err != nil {
+		return mCompetition, errs.ErrMongoFind.Throwf(applog.Log, "for collection: %s, err: [%v]", CompetitionCollection, err)
+	}
+
+	defer func() {
+		_ = competitions.Close(ctx)
+	}()
+
+	for {
+		if competitions.Err() != nil {
+			return mCompetition, err
+		}
+
+		if ok := competitions.Next(ctx); !ok {
+			break
+		}
+
+		var u competition.Competition
+		if err_ := competitions.Decode(&u); err_ != nil {
+			return mCompetition, err
+		}
+
+		mCompetition = append(mCompetition, u)
+	}
+
+	return mCompetition, nil
+}
+
+func (repo competitionRepo) Update(ctx context.Context, c competition.Competition) (*competition.Competition, errs.AppError) {
+	res := competition.Competition{}
+	filter := query.Filter{
+		"_id": c.GetID(),
+	}
+
+	err := repo.store.FindOne(ctx, CompetitionCollection, filter, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.ID == "" {
+		return nil, errs.ErrMongoFindOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", CompetitionCollection, c.GetID(), err)
+	}
+
+	c.ID = res.ID
+	c.Created = res.Created
+	err = repo.store.UpdateOne(ctx, CompetitionCollection, &c)
+	if err != nil {
+		return nil, errs.ErrMongoUpdateOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", CompetitionCollection, c.GetID(), err)
+	}
+
+	return &c, nil
+}
+
+func (repo competitionRepo) Delete(ctx context.Context, id string) errs.AppError {
+	err := repo.store.DeleteOne(ctx, CompetitionCollection, id)
+	return err
+}
@@ -0,0 +1,52 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+)
+
+type MockCompetitionRepo struct {
+	competition.CompetitionRepo
+	InsertFunc func(ctx context.Context, c competition.Competition) errs.AppError
+	GetFunc    func(ctx context.Context, id string) (*competition.Competition, errs.AppError)
+	ListFunc   func(ctx context.Context) ([]competition.Competition, errs.AppError)
+	UpdateFunc func(ctx context.Context, c competition.Competition) (*competition.Competition, errs.AppError)
+	DeleteFunc func(ctx context.Context, id string) errs.AppError
+}
+
+func (m MockCompetitionRepo) Insert(ctx context.Context, c competition.Competition) errs.AppError {
+	if m.InsertFunc != nil {
+		return m.InsertFunc(ctx, c)
+	}
+	return m.CompetitionRepo.Insert(ctx, c)
+}
+
+func (m MockCompetitionRepo) Get(ctx context.Context, id string) (*competition.Competition, errs.AppError) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return m.CompetitionRepo.Get(ctx, id)
+}
+
+func (m MockCompetitionRepo) List(ctx context.Context) ([]competition.Competition, errs.AppError) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return m.CompetitionRepo.List(ctx)
+}
+
+func (m MockCompetitionRepo) Update(ctx context.Context, c competition.Competition) (*competition.Competition, errs.AppError) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, c)
+	}
+	return m.CompetitionRepo.Update(ctx, c)
+}
+
+func (m MockCompetitionRepo) Delete(ctx context.Context, id string) errs.AppError {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return m.CompetitionRepo.Delete(ctx, id)
+}
@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+)
+
+func TestCompetitionRepoInsert(t *testing.T) {
+	ctx := context.Background()
+
+	SetCompetitionRepo(MockCompetitionRepo{
+		InsertFunc: func(ctx context.Context, t competition.Competition) errs.AppError {
+			return nil
+		},
+	})
+	defer SetCompetitionRepo(nil)
+
+	newCompetition := prototype.PrototypeCompetition()
+
+	err := GetCompetitionRepo().Insert(ctx, newCompetition)
+	assert.NoError(t, err)
+}
+
+func TestCompetitionRepoGet(t *testing.T) {
+	ctx := context.Background()
+
+	SetCompetitionRepo(MockCompetitionRepo{
+		GetFunc: func(ctx context.Context, id string) (*competition.Competition, errs.AppError) {
+			competitionMock := prototype.PrototypeCompetition()
+			return &competitionMock, nil
+		},
+	})
+	defer SetCompetitionRepo(nil)
+
+	newCompetition := prototype.PrototypeCompetition()
+
+	result, err := GetCompetitionRepo().Get(ctx, "new-competition-id")
+	assert.NoError(t, err)
+
+	assert.Equal(t, newCompetition, *result)
+}
+
+func TestCompetitionRepoList(t *testing.T) {
+	ctx := context.Background()
+
+	SetCompetitionRepo(MockCompetitionRepo{
+		ListFunc: func(ctx context.Context) ([]competition.Competition, errs.AppError) {
+			competitionMock := prototype.PrototypeCompetition()
+			competitionMock2 := prototype.PrototypeCompetition()
+
+			return []competition.Competition{competitionMock, competitionMock2}, nil
+		},
+	})
+	defer SetCompetitionRepo(nil)
+
+	competitions, err := GetCompetitionRepo().List(ctx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(competitions))
+}
+
+func TestCompetitionRepoUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	SetCompetitionRepo(MockCompetitionRepo{
+		UpdateFunc: func(ctx context.Context, t competition.Competition) (*competition.Competition, errs.AppError) {
+			return &t, nil
+		},
+	})
+	defer SetCompetitionRepo(nil)
+
+	newCompetition := prototype.PrototypeCompetition()
+
+	competitionUpdated, err := GetCompetitionRepo().Update(ctx, newCompetition)
+	assert.NoError(t, err)
+
+	assert.Equal(t, newCompetition, *competitionUpdated)
+
+}
+
+func TestCompetitionRepoDelete(t *testing.T) {
+	ctx := context.Background()
+
+	SetCompetitionRepo(MockCompetitionRepo{
+		DeleteFunc: func(ctx context.Context, id string) errs.AppError {
+			return nil
+		},
+	})
+	defer SetCompetitionRepo(nil)
+
+	newCompetition := prototype.PrototypeCompetition()
+
+	err := GetCompetitionRepo().Delete(ctx, newCompetition.GetID())
+	assert.NoError(t, err)
+}
@@ -43,12 +43,34 @@ func (repo eventRepo) Insert(ctx context.Context, e event.Event) errs.AppError {
 	return err
 }
 
+func (repo eventRepo) Get(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	filter := query.Filter{
+		"_id": id,
+	}
+
+	opts := query.FindOneOptions{}
+
+	mEvent := event.Event{}
+	err := repo.store.FindOne(ctx, EventCollection, filter, &mEvent, opts)
+	if err != nil {
+		return nil, errs.ErrMongoFindOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", EventCollection, id, err)
+	}
+
+	if mEvent.ID == "" {
+		return nil, nil
+	}
+
+	return &mEvent, nil
+}
+
 func (repo eventRepo) ListEventsFromMatch(ctx context.Context, matchID string) ([]event.Event, errs.AppError) {
 	filter := query.Filter{
 		"matchid": matchID,
 	}
 
-	opts := query.FindOptions{}
+	opts := query.FindOptions{
+		Sort: query.SortOption{"created": 1},
+	}
 	mEvent := []event.Event{}
 	events, err := repo.store.Find(ctx, EventCollection, filter, opts)
 	if err != nil {
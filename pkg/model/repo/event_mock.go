@@ -10,6 +10,7 @@ import (
 type MockEventRepo struct {
 	event.EventRepo
 	InsertFunc              func(ctx context.Context, mt event.Event) errs.AppError
+	GetFunc                 func(ctx context.Context, id string) (*event.Event, errs.AppError)
 	ListEventsFromMatchFunc func(ctx context.Context, matchID string) ([]event.Event, errs.AppError)
 }
 
@@ -20,6 +21,13 @@ func (m MockEventRepo) Insert(ctx context.Context, mt event.Event) errs.AppError
 	return m.EventRepo.Insert(ctx, mt)
 }
 
+func (m MockEventRepo) Get(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return m.EventRepo.Get(ctx, id)
+}
+
 func (m MockEventRepo) ListEventsFromMatch(ctx context.Context, matchID string) ([]event.Event, errs.AppError) {
 	if m.ListEventsFromMatchFunc != nil {
 		return m.ListEventsFromMatchFunc(ctx, matchID)
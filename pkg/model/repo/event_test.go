@@ -27,6 +27,25 @@ func TestEventRepoInsert(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestEventRepoGet(t *testing.T) {
+	ctx := context.Background()
+
+	SetEventRepo(MockEventRepo{
+		GetFunc: func(ctx context.Context, id string) (*event.Event, errs.AppError) {
+			event := prototype.PrototypeEvent()
+			return &event, nil
+		},
+	})
+	defer SetEventRepo(nil)
+
+	newEvent := prototype.PrototypeEvent()
+
+	result, err := GetEventRepo().Get(ctx, "new-event-id")
+	assert.NoError(t, err)
+
+	assert.Equal(t, newEvent, *result)
+}
+
 func TestEventRepoListEventsFromMatch(t *testing.T) {
 	ctx := context.Background()
 
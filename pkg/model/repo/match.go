@@ -43,6 +43,19 @@ func (repo matchRepo) Insert(ctx context.Context, mt match.Match) errs.AppError
 	return err
 }
 
+func (repo matchRepo) InsertMany(ctx context.Context, matches []match.Match) errs.AppError {
+	created := time.Now()
+
+	docs := []interface{}{}
+	for i := range matches {
+		mt := matches[i]
+		mt.Created = created
+		docs = append(docs, &mt)
+	}
+
+	return repo.store.InsertMany(ctx, MatchCollection, docs)
+}
+
 func (repo matchRepo) Get(ctx context.Context, id string) (*match.Match, errs.AppError) {
 	filter := query.Filter{
 		"_id": id,
@@ -97,6 +110,129 @@ func (repo matchRepo) List(ctx context.Context) ([]match.Match, errs.AppError) {
 	return mMtach, nil
 }
 
+func (repo matchRepo) ListByKickoff(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError) {
+	kickoff := query.Filter{}
+	if !from.IsZero() {
+		kickoff[query.GTE] = from
+	}
+	if !to.IsZero() {
+		kickoff[query.LT] = to
+	}
+
+	filter := query.Filter{}
+	if len(kickoff) > 0 {
+		filter["kickoff"] = kickoff
+	}
+
+	opts := query.FindOptions{
+		Sort: query.SortOption{"kickoff": 1},
+	}
+	mMtach := []match.Match{}
+	matches, err := repo.store.Find(ctx, MatchCollection, filter, opts)
+	if err != nil {
+		return mMtach, errs.ErrMongoFind.Throwf(applog.Log, "for collection: %s, err: [%v]", MatchCollection, err)
+	}
+
+	defer func() {
+		_ = matches.Close(ctx)
+	}()
+
+	for {
+		if matches.Err() != nil {
+			return mMtach, err
+		}
+
+		if ok := matches.Next(ctx); !ok {
+			break
+		}
+
+		var p match.Match
+		if err_ := matches.Decode(&p); err_ != nil {
+			return mMtach, err
+		}
+
+		mMtach = append(mMtach, p)
+	}
+
+	return mMtach, nil
+}
+
+func (repo matchRepo) ListByOfficial(ctx context.Context, officialID string) ([]match.Match, errs.AppError) {
+	filter := query.Filter{
+		"officials.official._id": officialID,
+	}
+
+	opts := query.FindOptions{
+		Sort: query.SortOption{"kickoff": 1},
+	}
+	mMtach := []match.Match{}
+	matches, err := repo.store.Find(ctx, MatchCollection, filter, opts)
+	if err != nil {
+		return mMtach, errs.ErrMongoFind.Throwf(applog.Log, "for collection: %s, and official: %s, err: [%v]", MatchCollection, officialID, err)
+	}
+
+	defer func() {
+		_ = matches.Close(ctx)
+	}()
+
+	for {
+		if matches.Err() != nil {
+			return mMtach, err
+		}
+
+		if ok := matches.Next(ctx); !ok {
+			break
+		}
+
+		var p match.Match
+		if err_ := matches.Decode(&p); err_ != nil {
+			return mMtach, err
+		}
+
+		mMtach = append(mMtach, p)
+	}
+
+	return mMtach, nil
+}
+
+func (repo matchRepo) ListByTournament(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	filter := query.Filter{
+		"tournament._id": tournamentID,
+	}
+
+	opts := query.FindOptions{
+		Sort: query.SortOption{"kickoff": 1},
+	}
+	mMtach := []match.Match{}
+	matches, err := repo.store.Find(ctx, MatchCollection, filter, opts)
+	if err != nil {
+		return mMtach, errs.ErrMongoFind.Throwf(applog.Log, "for collection: %s, and tournament: %s, err: [%v]", MatchCollection, tournamentID, err)
+	}
+
+	defer func() {
+		_ = matches.Close(ctx)
+	}()
+
+	for {
+		if matches.Err() != nil {
+			return mMtach, err
+		}
+
+		if ok := matches.Next(ctx); !ok {
+			break
+		}
+
+		var p match.Match
+		if err_ := matches.Decode(&p); err_ != nil {
+			return mMtach, err
+		}
+
+		mMtach = append(mMtach, p)
+	}
+
+	return mMtach, nil
+}
+
 func (repo matchRepo) Update(ctx context.Context, p match.Match) (*match.Match, errs.AppError) {
 	res := match.Match{}
 	filter := query.Filter{
@@ -121,6 +257,35 @@ func (repo matchRepo) Update(ctx context.Context, p match.Match) (*match.Match,
 	return &p, nil
 }
 
+func (repo matchRepo) UpdateOfficials(ctx context.Context, id string, officials []match.MatchOfficial) errs.AppError {
+	filter := query.Filter{
+		"officials": officials,
+	}
+
+	err := repo.store.UpdateFields(ctx, MatchCollection, id, filter)
+	if err != nil {
+		return errs.ErrMongoUpdateOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", MatchCollection, id, err)
+	}
+
+	return nil
+}
+
+// UpdateKickoff sets the kickoff and time zone of the match and removes the
+// legacy date and time of match fields.
+func (repo matchRepo) UpdateKickoff(ctx context.Context, id string, kickoff time.Time, timeZone string) errs.AppError {
+	filter := query.Filter{
+		"kickoff":  kickoff,
+		"timezone": timeZone,
+	}
+
+	err := repo.store.UpdateFields(ctx, MatchCollection, id, filter, "dateofmatch", "timeofmatch")
+	if err != nil {
+		return errs.ErrMongoUpdateOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", MatchCollection, id, err)
+	}
+
+	return nil
+}
+
 func (repo matchRepo) Delete(ctx context.Context, id string) errs.AppError {
 	err := repo.store.DeleteOne(ctx, MatchCollection, id)
 	return err
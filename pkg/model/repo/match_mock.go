@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
@@ -9,11 +10,17 @@ import (
 
 type MockMatchRepo struct {
 	match.MatchRepo
-	InsertFunc func(ctx context.Context, mt match.Match) errs.AppError
-	GetFunc    func(ctx context.Context, id string) (*match.Match, errs.AppError)
-	ListFunc   func(ctx context.Context) ([]match.Match, errs.AppError)
-	UpdateFunc func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
-	DeleteFunc func(ctx context.Context, id string) errs.AppError
+	InsertFunc           func(ctx context.Context, mt match.Match) errs.AppError
+	InsertManyFunc       func(ctx context.Context, matches []match.Match) errs.AppError
+	GetFunc              func(ctx context.Context, id string) (*match.Match, errs.AppError)
+	ListFunc             func(ctx context.Context) ([]match.Match, errs.AppError)
+	ListByKickoffFunc    func(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError)
+	ListByOfficialFunc   func(ctx context.Context, officialID string) ([]match.Match, errs.AppError)
+	ListByTournamentFunc func(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError)
+	UpdateFunc           func(ctx context.Context, mt match.Match) (*match.Match, errs.AppError)
+	UpdateOfficialsFunc  func(ctx context.Context, id string, officials []match.MatchOfficial) errs.AppError
+	UpdateKickoffFunc    func(ctx context.Context, id string, kickoff time.Time, timeZone string) errs.AppError
+	DeleteFunc           func(ctx context.Context, id string) errs.AppError
 
 	FindMatchForTournamentFunc func(ctx context.Context, id, tournamentID string) (*match.Match, errs.AppError)
 	FindTeamInMatchFunc        func(ctx context.Context, teamID string) (bool, errs.AppError)
@@ -26,6 +33,13 @@ func (m MockMatchRepo) Insert(ctx context.Context, mt match.Match) errs.AppError
 	return m.MatchRepo.Insert(ctx, mt)
 }
 
+func (m MockMatchRepo) InsertMany(ctx context.Context, matches []match.Match) errs.AppError {
+	if m.InsertManyFunc != nil {
+		return m.InsertManyFunc(ctx, matches)
+	}
+	return m.MatchRepo.InsertMany(ctx, matches)
+}
+
 func (m MockMatchRepo) Get(ctx context.Context, id string) (*match.Match, errs.AppError) {
 	if m.GetFunc != nil {
 		return m.GetFunc(ctx, id)
@@ -40,6 +54,27 @@ func (m MockMatchRepo) List(ctx context.Context) ([]match.Match, errs.AppError)
 	return m.MatchRepo.List(ctx)
 }
 
+func (m MockMatchRepo) ListByKickoff(ctx context.Context, from, to time.Time) ([]match.Match, errs.AppError) {
+	if m.ListByKickoffFunc != nil {
+		return m.ListByKickoffFunc(ctx, from, to)
+	}
+	return m.MatchRepo.ListByKickoff(ctx, from, to)
+}
+
+func (m MockMatchRepo) ListByOfficial(ctx context.Context, officialID string) ([]match.Match, errs.AppError) {
+	if m.ListByOfficialFunc != nil {
+		return m.ListByOfficialFunc(ctx, officialID)
+	}
+	return m.MatchRepo.ListByOfficial(ctx, officialID)
+}
+
+func (m MockMatchRepo) ListByTournament(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	if m.ListByTournamentFunc != nil {
+		return m.ListByTournamentFunc(ctx, tournamentID)
+	}
+	return m.MatchRepo.ListByTournament(ctx, tournamentID)
+}
+
 func (m MockMatchRepo) Update(ctx context.Context, mt match.Match) (*match.Match, errs.AppError) {
 	if m.UpdateFunc != nil {
 		return m.UpdateFunc(ctx, mt)
@@ -47,6 +82,20 @@ func (m MockMatchRepo) Update(ctx context.Context, mt match.Match) (*match.Match
 	return m.MatchRepo.Update(ctx, mt)
 }
 
+func (m MockMatchRepo) UpdateOfficials(ctx context.Context, id string, officials []match.MatchOfficial) errs.AppError {
+	if m.UpdateOfficialsFunc != nil {
+		return m.UpdateOfficialsFunc(ctx, id, officials)
+	}
+	return m.MatchRepo.UpdateOfficials(ctx, id, officials)
+}
+
+func (m MockMatchRepo) UpdateKickoff(ctx context.Context, id string, kickoff time.Time, timeZone string) errs.AppError {
+	if m.UpdateKickoffFunc != nil {
+		return m.UpdateKickoffFunc(ctx, id, kickoff, timeZone)
+	}
+	return m.MatchRepo.UpdateKickoff(ctx, id, kickoff, timeZone)
+}
+
 func (m MockMatchRepo) Delete(ctx context.Context, id string) errs.AppError {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(ctx, id)
@@ -3,11 +3,15 @@ package repo
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/player"
 	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
 )
 
@@ -27,6 +31,22 @@ func TestMatchRepoInsert(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMatchRepoInsertMany(t *testing.T) {
+	ctx := context.Background()
+
+	SetMatchRepo(MockMatchRepo{
+		InsertManyFunc: func(ctx context.Context, matches []match.Match) errs.AppError {
+			return nil
+		},
+	})
+	defer SetMatchRepo(nil)
+
+	newMatches := []match.Match{prototype.PrototypeMatch(), prototype.PrototypeMatch()}
+
+	err := GetMatchRepo().InsertMany(ctx, newMatches)
+	assert.NoError(t, err)
+}
+
 func TestMatchRepoGet(t *testing.T) {
 	ctx := context.Background()
 
@@ -65,6 +85,43 @@ func TestMatchRepoList(t *testing.T) {
 	assert.Equal(t, 2, len(matches))
 }
 
+func TestMatchRepoListByOfficial(t *testing.T) {
+	ctx := context.Background()
+
+	SetMatchRepo(MockMatchRepo{
+		ListByOfficialFunc: func(ctx context.Context, officialID string) ([]match.Match, errs.AppError) {
+			matchMock := prototype.PrototypeMatch()
+			matchMock.Officials = []match.MatchOfficial{{Official: prototype.PrototypeOfficial(), Role: model.OfficialReferee}}
+
+			return []match.Match{matchMock}, nil
+		},
+	})
+	defer SetMatchRepo(nil)
+
+	matches, err := GetMatchRepo().ListByOfficial(ctx, "1")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(matches))
+	assert.True(t, matches[0].HasOfficial("1"))
+}
+
+func TestMatchRepoListByTournament(t *testing.T) {
+	ctx := context.Background()
+
+	SetMatchRepo(MockMatchRepo{
+		ListByTournamentFunc: func(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+			return []match.Match{prototype.PrototypeMatch()}, nil
+		},
+	})
+	defer SetMatchRepo(nil)
+
+	matches, err := GetMatchRepo().ListByTournament(ctx, "1")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(matches))
+	assert.True(t, matches[0].IsTheMatchForTournament("1"))
+}
+
 func TestMatchRepoUpdate(t *testing.T) {
 	ctx := context.Background()
 
@@ -84,6 +141,107 @@ func TestMatchRepoUpdate(t *testing.T) {
 
 }
 
+func TestMatchRepoUpdateClearsRetractedCards(t *testing.T) {
+	ctx := context.Background()
+	r := matchRepo{newMemStore()}
+
+	mt := prototype.PrototypeMatch()
+	mt.Status = model.MatchStatusInProgress
+	assert.NoError(t, r.Insert(ctx, mt))
+
+	redCard := event.New(mt.Tournament.ID, mt.ID, event.Warning{Team: mt.HomeTeam, Player: player.Player{ID: "p1"}, Warning: model.WarningRedCard, Minute: 10})
+	assert.Nil(t, mt.ApplyEvent(redCard))
+	_, err := r.Update(ctx, mt)
+	assert.NoError(t, err)
+
+	stored, err := r.Get(ctx, mt.ID)
+	assert.NoError(t, err)
+	assert.True(t, stored.IsSentOff("p1"))
+
+	assert.Nil(t, stored.ApplyEvent(event.New(mt.Tournament.ID, mt.ID, event.Retraction{EventID: redCard.ID, Reason: "Mistaken identity"})))
+	_, err = r.Update(ctx, *stored)
+	assert.NoError(t, err)
+
+	stored, err = r.Get(ctx, mt.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, stored.Cards)
+	assert.False(t, stored.IsSentOff("p1"))
+}
+
+func TestMatchRepoRebuildRepairsDrift(t *testing.T) {
+	ctx := context.Background()
+	r := matchRepo{newMemStore()}
+	er := MockEventRepo{
+		ListEventsFromMatchFunc: func(ctx context.Context, matchID string) ([]event.Event, errs.AppError) {
+			return []event.Event{}, nil
+		},
+	}
+
+	drifted := prototype.PrototypeMatch()
+	drifted.Attendance = 30000
+	drifted.Cards = []match.PlayerCards{{TeamID: drifted.HomeTeam.ID, PlayerID: "p1", Red: true}}
+	drifted.Lineups = []event.Lineup{{Team: drifted.HomeTeam}}
+	assert.NoError(t, r.Insert(ctx, drifted))
+
+	matches, err := r.List(ctx)
+	assert.NoError(t, err)
+
+	rebuilds, err := match.RebuildMatches(ctx, r, er, matches, false)
+	assert.NoError(t, err)
+	assert.True(t, rebuilds[0].Updated)
+
+	matches, err = r.List(ctx)
+	assert.NoError(t, err)
+	assert.Zero(t, matches[0].Attendance)
+	assert.Empty(t, matches[0].Cards)
+	assert.Empty(t, matches[0].Lineups)
+
+	rebuilds, err = match.RebuildMatches(ctx, r, er, matches, true)
+	assert.NoError(t, err)
+	assert.Empty(t, rebuilds[0].Discrepancies)
+}
+
+func TestMatchRepoUpdateOfficials(t *testing.T) {
+	ctx := context.Background()
+
+	SetMatchRepo(MockMatchRepo{
+		UpdateOfficialsFunc: func(ctx context.Context, id string, officials []match.MatchOfficial) errs.AppError {
+			return nil
+		},
+	})
+	defer SetMatchRepo(nil)
+
+	newMatch := prototype.PrototypeMatch()
+
+	err := GetMatchRepo().UpdateOfficials(ctx, newMatch.ID, newMatch.Officials)
+	assert.NoError(t, err)
+}
+
+func TestMatchRepoUpdateKickoff(t *testing.T) {
+	ctx := context.Background()
+	st := newMemStore()
+	r := matchRepo{st}
+
+	legacy := prototype.PrototypeMatch()
+	legacy.Kickoff = time.Time{}
+	legacy.DateOfMatch = "2022-02-01"
+	legacy.TimeOfMatch = "16:00"
+	assert.NoError(t, r.Insert(ctx, legacy))
+
+	migrated, err := match.MigrateKickoffs(ctx, r, time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, migrated)
+
+	doc := st.docs[legacy.ID]
+	assert.NotContains(t, doc, "dateofmatch")
+	assert.NotContains(t, doc, "timeofmatch")
+	assert.Equal(t, "UTC", doc["timezone"])
+
+	stored, err := r.Get(ctx, legacy.ID)
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC).Equal(stored.Kickoff))
+}
+
 func TestMatchRepoDelete(t *testing.T) {
 	ctx := context.Background()
 
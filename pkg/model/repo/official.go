@@ -0,0 +1,128 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store/query"
+)
+
+const (
+	OfficialCollection = "official"
+)
+
+type officialRepo struct {
+	store store.Store
+}
+
+var officialRepoSingleton official.OfficialRepo
+
+func GetOfficialRepo() official.OfficialRepo {
+	if officialRepoSingleton == nil {
+		return getOfficialRepo()
+	}
+	return officialRepoSingleton
+}
+
+func getOfficialRepo() *officialRepo {
+	s := store.GetStore()
+	return &officialRepo{s}
+}
+
+func SetOfficialRepo(repo official.OfficialRepo) {
+	officialRepoSingleton = repo
+}
+
+func (repo officialRepo) Insert(ctx context.Context, o official.Official) errs.AppError {
+	o.Created = time.Now()
+	_, err := repo.store.InsertOne(ctx, OfficialCollection, &o)
+	return err
+}
+
+func (repo officialRepo) Get(ctx context.Context, id string) (*official.Official, errs.AppError) {
+	filter := query.Filter{
+		"_id": id,
+	}
+
+	opts := query.FindOneOptions{}
+
+	mOfficial := official.Official{}
+	err := repo.store.FindOne(ctx, OfficialCollection, filter, &mOfficial, opts)
+	if err != nil {
+		return nil, errs.ErrMongoFindOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", OfficialCollection, id, err)
+	}
+
+	if mOfficial.ID == "" {
+		return nil, nil
+	}
+
+	return &mOfficial, nil
+}
+
+func (repo officialRepo) List(ctx context.Context) ([]official.Official, errs.AppError) {
+	filter := query.Filter{}
+
+	opts := query.FindOptions{}
+	mOfficial := []official.Official{}
+	officials, err := repo.store.Find(ctx, OfficialCollection, filter, opts)
+	if err != nil {
+		return mOfficial, errs.ErrMongoFind.Throwf(applog.Log, "for collection: %s, err: [%v]", OfficialCollection, err)
+	}
+
+	defer func() {
+		_ = officials.Close(ctx)
+	}()
+
+	for {
+		if officials.Err() != nil {
+			return mOfficial, err
+		}
+
+		if ok := officials.Next(ctx); !ok {
+			break
+		}
+
+		var u official.Official
+		if err_ := officials.Decode(&u); err_ != nil {
+			return mOfficial, err
+		}
+
+		mOfficial = append(mOfficial, u)
+	}
+
+	return mOfficial, nil
+}
+
+func (repo officialRepo) Update(ctx context.Context, o official.Official) (*official.Official, errs.AppError) {
+	res := official.Official{}
+	filter := query.Filter{
+		"_id": o.GetID(),
+	}
+
+	err := repo.store.FindOne(ctx, OfficialCollection, filter, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.ID == "" {
+		return nil, errs.ErrMongoFindOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", OfficialCollection, o.GetID(), err)
+	}
+
+	o.ID = res.ID
+	o.Created = res.Created
+	err = repo.store.UpdateOne(ctx, OfficialCollection, &o)
+	if err != nil {
+		return nil, errs.ErrMongoUpdateOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", OfficialCollection, o.GetID(), err)
+	}
+
+	return &o, nil
+}
+
+func (repo officialRepo) Delete(ctx context.Context, id string) errs.AppError {
+	err := repo.store.DeleteOne(ctx, OfficialCollection, id)
+	return err
+}
@@ -0,0 +1,52 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+)
+
+type MockOfficialRepo struct {
+	official.OfficialRepo
+	InsertFunc func(ctx context.Context, o official.Official) errs.AppError
+	GetFunc    func(ctx context.Context, id string) (*official.Official, errs.AppError)
+	ListFunc   func(ctx context.Context) ([]official.Official, errs.AppError)
+	UpdateFunc func(ctx context.Context, o official.Official) (*official.Official, errs.AppError)
+	DeleteFunc func(ctx context.Context, id string) errs.AppError
+}
+
+func (m MockOfficialRepo) Insert(ctx context.Context, o official.Official) errs.AppError {
+	if m.InsertFunc != nil {
+		return m.InsertFunc(ctx, o)
+	}
+	return m.OfficialRepo.Insert(ctx, o)
+}
+
+func (m MockOfficialRepo) Get(ctx context.Context, id string) (*official.Official, errs.AppError) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return m.OfficialRepo.Get(ctx, id)
+}
+
+func (m MockOfficialRepo) List(ctx context.Context) ([]official.Official, errs.AppError) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return m.OfficialRepo.List(ctx)
+}
+
+func (m MockOfficialRepo) Update(ctx context.Context, o official.Official) (*official.Official, errs.AppError) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, o)
+	}
+	return m.OfficialRepo.Update(ctx, o)
+}
+
+func (m MockOfficialRepo) Delete(ctx context.Context, id string) errs.AppError {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return m.OfficialRepo.Delete(ctx, id)
+}
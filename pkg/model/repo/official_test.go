@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+)
+
+func TestOfficialRepoInsert(t *testing.T) {
+	ctx := context.Background()
+
+	SetOfficialRepo(MockOfficialRepo{
+		InsertFunc: func(ctx context.Context, t official.Official) errs.AppError {
+			return nil
+		},
+	})
+	defer SetOfficialRepo(nil)
+
+	newOfficial := prototype.PrototypeOfficial()
+
+	err := GetOfficialRepo().Insert(ctx, newOfficial)
+	assert.NoError(t, err)
+}
+
+func TestOfficialRepoGet(t *testing.T) {
+	ctx := context.Background()
+
+	SetOfficialRepo(MockOfficialRepo{
+		GetFunc: func(ctx context.Context, id string) (*official.Official, errs.AppError) {
+			officialMock := prototype.PrototypeOfficial()
+			return &officialMock, nil
+		},
+	})
+	defer SetOfficialRepo(nil)
+
+	newOfficial := prototype.PrototypeOfficial()
+
+	result, err := GetOfficialRepo().Get(ctx, "new-official-id")
+	assert.NoError(t, err)
+
+	assert.Equal(t, newOfficial, *result)
+}
+
+func TestOfficialRepoList(t *testing.T) {
+	ctx := context.Background()
+
+	SetOfficialRepo(MockOfficialRepo{
+		ListFunc: func(ctx context.Context) ([]official.Official, errs.AppError) {
+			officialMock := prototype.PrototypeOfficial()
+			officialMock2 := prototype.PrototypeOfficial()
+
+			return []official.Official{officialMock, officialMock2}, nil
+		},
+	})
+	defer SetOfficialRepo(nil)
+
+	officials, err := GetOfficialRepo().List(ctx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(officials))
+}
+
+func TestOfficialRepoUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	SetOfficialRepo(MockOfficialRepo{
+		UpdateFunc: func(ctx context.Context, t official.Official) (*official.Official, errs.AppError) {
+			return &t, nil
+		},
+	})
+	defer SetOfficialRepo(nil)
+
+	newOfficial := prototype.PrototypeOfficial()
+
+	officialUpdated, err := GetOfficialRepo().Update(ctx, newOfficial)
+	assert.NoError(t, err)
+
+	assert.Equal(t, newOfficial, *officialUpdated)
+
+}
+
+func TestOfficialRepoDelete(t *testing.T) {
+	ctx := context.Background()
+
+	SetOfficialRepo(MockOfficialRepo{
+		DeleteFunc: func(ctx context.Context, id string) errs.AppError {
+			return nil
+		},
+	})
+	defer SetOfficialRepo(nil)
+
+	newOfficial := prototype.PrototypeOfficial()
+
+	err := GetOfficialRepo().Delete(ctx, newOfficial.GetID())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,65 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store/query"
+)
+
+const (
+	StandingsCollection = "standings"
+)
+
+type standingsRepo struct {
+	store store.Store
+}
+
+var standingsRepoSingleton match.StandingsRepo
+
+func GetStandingsRepo() match.StandingsRepo {
+	if standingsRepoSingleton == nil {
+		return getStandingsRepo()
+	}
+	return standingsRepoSingleton
+}
+
+func getStandingsRepo() *standingsRepo {
+	s := store.GetStore()
+	return &standingsRepo{s}
+}
+
+func SetStandingsRepo(repo match.StandingsRepo) {
+	standingsRepoSingleton = repo
+}
+
+// Save replaces the stored table of the tournament, or inserts it the first
+// time.
+func (repo standingsRepo) Save(ctx context.Context, s match.TournamentStandings) errs.AppError {
+	res := match.TournamentStandings{}
+	filter := query.Filter{
+		"_id": s.GetID(),
+	}
+
+	err := repo.store.FindOne(ctx, StandingsCollection, filter, &res)
+	if err != nil {
+		return errs.ErrMongoFindOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", StandingsCollection, s.GetID(), err)
+	}
+
+	if res.ID == "" {
+		_, err = repo.store.InsertOne(ctx, StandingsCollection, &s)
+		if err == nil || !errs.ErrMongoDuplicateKey.Is(err) {
+			return err
+		}
+	}
+
+	err = repo.store.UpdateOne(ctx, StandingsCollection, &s)
+	if err != nil {
+		return errs.ErrMongoUpdateOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", StandingsCollection, s.GetID(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+)
+
+type MockStandingsRepo struct {
+	match.StandingsRepo
+	SaveFunc func(ctx context.Context, s match.TournamentStandings) errs.AppError
+}
+
+func (m MockStandingsRepo) Save(ctx context.Context, s match.TournamentStandings) errs.AppError {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, s)
+	}
+	return m.StandingsRepo.Save(ctx, s)
+}
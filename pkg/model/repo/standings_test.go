@@ -0,0 +1,51 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+)
+
+func TestStandingsRepoSave(t *testing.T) {
+	ctx := context.Background()
+
+	SetStandingsRepo(MockStandingsRepo{
+		SaveFunc: func(ctx context.Context, s match.TournamentStandings) errs.AppError {
+			return nil
+		},
+	})
+	defer SetStandingsRepo(nil)
+
+	s := match.NewTournamentStandings(prototype.PrototypeTournament(), nil)
+
+	err := GetStandingsRepo().Save(ctx, s)
+	assert.NoError(t, err)
+}
+
+func TestStandingsRepoSaveReplacesTheTable(t *testing.T) {
+	ctx := context.Background()
+	st := newMemStore()
+	r := standingsRepo{st}
+
+	tournament := prototype.PrototypeTournament()
+	s := match.NewTournamentStandings(tournament, nil)
+	assert.NoError(t, r.Save(ctx, s))
+	assert.Len(t, st.docs, 1)
+
+	stored := match.TournamentStandings{}
+	assert.NoError(t, fromBsonM(st.docs[tournament.ID], &stored))
+	assert.NotEmpty(t, stored.Standings)
+
+	tournament.Teams = nil
+	assert.NoError(t, r.Save(ctx, match.NewTournamentStandings(tournament, nil)))
+	assert.Len(t, st.docs, 1)
+
+	stored = match.TournamentStandings{}
+	assert.NoError(t, fromBsonM(st.docs[tournament.ID], &stored))
+	assert.Empty(t, stored.Standings)
+}
@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store/cursor"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store/noop"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store/query"
+)
+
+// memStore keeps the documents as bson the way mongo does, so the tests see
+// what an update writes and what it leaves behind.
+type memStore struct {
+	noop.Store
+	docs map[string]bson.M
+}
+
+func newMemStore() *memStore {
+	return &memStore{docs: map[string]bson.M{}}
+}
+
+func toBsonM(v interface{}) bson.M {
+	b, err := bson.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	m := bson.M{}
+	if err = bson.Unmarshal(b, &m); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func fromBsonM(m bson.M, v interface{}) error {
+	b, err := bson.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return bson.Unmarshal(b, v)
+}
+
+func (s *memStore) FindOne(_ context.Context, _ string, filter query.Filter, v interface{}, _ ...query.FindOneOptions) errs.AppError {
+	id, _ := filter["_id"].(string)
+	doc, ok := s.docs[id]
+	if !ok {
+		return nil
+	}
+
+	if err := fromBsonM(doc, v); err != nil {
+		return errs.ErrUnmarshalingBson
+	}
+	return nil
+}
+
+func (s *memStore) Find(_ context.Context, _ string, _ query.Filter, _ ...query.FindOptions) (cursor.Cursor, errs.AppError) {
+	docs := []bson.M{}
+	for _, doc := range s.docs {
+		docs = append(docs, doc)
+	}
+	return &memCursor{docs: docs, i: -1}, nil
+}
+
+func (s *memStore) InsertOne(_ context.Context, _ string, data interface{}) (string, errs.AppError) {
+	doc := toBsonM(data)
+	id, _ := doc["_id"].(string)
+	s.docs[id] = doc
+	return id, nil
+}
+
+func (s *memStore) UpdateOne(_ context.Context, _ string, data interface{}) errs.AppError {
+	doc := toBsonM(data)
+	id, _ := doc["_id"].(string)
+	for k, v := range doc {
+		s.docs[id][k] = v
+	}
+	return nil
+}
+
+func (s *memStore) UpdateFields(_ context.Context, _ string, id string, fields query.Filter, unset ...string) errs.AppError {
+	for k, v := range toBsonM(fields) {
+		s.docs[id][k] = v
+	}
+	for _, k := range unset {
+		delete(s.docs[id], k)
+	}
+	return nil
+}
+
+type memCursor struct {
+	docs []bson.M
+	i    int
+}
+
+func (c *memCursor) Next(_ context.Context) bool {
+	c.i++
+	return c.i < len(c.docs)
+}
+
+func (c *memCursor) Decode(v interface{}) error {
+	return fromBsonM(c.docs[c.i], v)
+}
+
+func (c *memCursor) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *memCursor) Err() error {
+	return nil
+}
@@ -21,6 +21,16 @@ type tournamentRepo struct {
 
 var tournamentRepoSingleton tournament.TournamentRepo
 
+// EnsureTournamentIndexes keeps a single season of a competition, the
+// tournaments outside a competition are left out of the index.
+func EnsureTournamentIndexes(ctx context.Context) errs.AppError {
+	partial := query.Filter{
+		"competitionid": query.Filter{query.EXISTS: true},
+	}
+
+	return store.GetStore().EnsureUniqueIndex(ctx, TournamentCollection, []string{"competitionid", "season"}, partial)
+}
+
 func GetTournamentRepo() tournament.TournamentRepo {
 	if tournamentRepoSingleton == nil {
 		return getTournamentRepo()
@@ -97,6 +107,44 @@ func (repo tournamentRepo) List(ctx context.Context) ([]tournament.Tournament, e
 	return mTournament, nil
 }
 
+func (repo tournamentRepo) ListByCompetition(ctx context.Context, competitionID string) ([]tournament.Tournament, errs.AppError) {
+	filter := query.Filter{
+		"competitionid": competitionID,
+	}
+
+	opts := query.FindOptions{
+		Sort: query.SortOption{"season": 1},
+	}
+	mTournament := []tournament.Tournament{}
+	tournaments, err := repo.store.Find(ctx, TournamentCollection, filter, opts)
+	if err != nil {
+		return mTournament, errs.ErrMongoFind.Throwf(applog.Log, "for collection: %s, and competition: %s, err: [%v]", TournamentCollection, competitionID, err)
+	}
+
+	defer func() {
+		_ = tournaments.Close(ctx)
+	}()
+
+	for {
+		if tournaments.Err() != nil {
+			return mTournament, err
+		}
+
+		if ok := tournaments.Next(ctx); !ok {
+			break
+		}
+
+		var t tournament.Tournament
+		if err_ := tournaments.Decode(&t); err_ != nil {
+			return mTournament, err
+		}
+
+		mTournament = append(mTournament, t)
+	}
+
+	return mTournament, nil
+}
+
 func (repo tournamentRepo) Update(ctx context.Context, t tournament.Tournament) (*tournament.Tournament, errs.AppError) {
 	res := tournament.Tournament{}
 	filter := query.Filter{
@@ -116,6 +164,9 @@ func (repo tournamentRepo) Update(ctx context.Context, t tournament.Tournament)
 	t.Created = res.Created
 	err = repo.store.UpdateOne(ctx, TournamentCollection, &t)
 	if err != nil {
+		if errs.ErrMongoDuplicateKey.Is(err) {
+			return nil, err
+		}
 		return nil, errs.ErrMongoUpdateOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", TournamentCollection, t.GetID(), err)
 	}
 
@@ -9,11 +9,12 @@ import (
 
 type MockTournamentRepo struct {
 	tournament.TournamentRepo
-	InsertFunc func(ctx context.Context, t tournament.Tournament) errs.AppError
-	GetFunc    func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
-	ListFunc   func(ctx context.Context) ([]tournament.Tournament, errs.AppError)
-	UpdateFunc func(ctx context.Context, t tournament.Tournament) (*tournament.Tournament, errs.AppError)
-	DeleteFunc func(ctx context.Context, id string) errs.AppError
+	InsertFunc            func(ctx context.Context, t tournament.Tournament) errs.AppError
+	GetFunc               func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+	ListFunc              func(ctx context.Context) ([]tournament.Tournament, errs.AppError)
+	ListByCompetitionFunc func(ctx context.Context, competitionID string) ([]tournament.Tournament, errs.AppError)
+	UpdateFunc            func(ctx context.Context, t tournament.Tournament) (*tournament.Tournament, errs.AppError)
+	DeleteFunc            func(ctx context.Context, id string) errs.AppError
 }
 
 func (m MockTournamentRepo) Insert(ctx context.Context, t tournament.Tournament) errs.AppError {
@@ -37,6 +38,13 @@ func (m MockTournamentRepo) List(ctx context.Context) ([]tournament.Tournament,
 	return m.TournamentRepo.List(ctx)
 }
 
+func (m MockTournamentRepo) ListByCompetition(ctx context.Context, competitionID string) ([]tournament.Tournament, errs.AppError) {
+	if m.ListByCompetitionFunc != nil {
+		return m.ListByCompetitionFunc(ctx, competitionID)
+	}
+	return m.TournamentRepo.ListByCompetition(ctx, competitionID)
+}
+
 func (m MockTournamentRepo) Update(ctx context.Context, t tournament.Tournament) (*tournament.Tournament, errs.AppError) {
 	if m.UpdateFunc != nil {
 		return m.UpdateFunc(ctx, t)
@@ -65,6 +65,27 @@ func TestTournamentRepoList(t *testing.T) {
 	assert.Equal(t, 2, len(teams))
 }
 
+func TestTournamentRepoListByCompetition(t *testing.T) {
+	ctx := context.Background()
+
+	SetTournamentRepo(MockTournamentRepo{
+		ListByCompetitionFunc: func(ctx context.Context, competitionID string) ([]tournament.Tournament, errs.AppError) {
+			season := prototype.PrototypeTournament()
+			season.CompetitionID = competitionID
+			season.Season = "2026/27"
+
+			return []tournament.Tournament{season}, nil
+		},
+	})
+	defer SetTournamentRepo(nil)
+
+	seasons, err := GetTournamentRepo().ListByCompetition(ctx, "1")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(seasons))
+	assert.True(t, seasons[0].IsSeasonOf("1"))
+}
+
 func TestTournamentRepoUpdate(t *testing.T) {
 	ctx := context.Background()
 
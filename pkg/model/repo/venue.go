@@ -0,0 +1,128 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store/query"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+const (
+	VenueCollection = "venue"
+)
+
+type venueRepo struct {
+	store store.Store
+}
+
+var venueRepoSingleton venue.VenueRepo
+
+func GetVenueRepo() venue.VenueRepo {
+	if venueRepoSingleton == nil {
+		return getVenueRepo()
+	}
+	return venueRepoSingleton
+}
+
+func getVenueRepo() *venueRepo {
+	s := store.GetStore()
+	return &venueRepo{s}
+}
+
+func SetVenueRepo(repo venue.VenueRepo) {
+	venueRepoSingleton = repo
+}
+
+func (repo venueRepo) Insert(ctx context.Context, v venue.Venue) errs.AppError {
+	v.Created = time.Now()
+	_, err := repo.store.InsertOne(ctx, VenueCollection, &v)
+	return err
+}
+
+func (repo venueRepo) Get(ctx context.Context, id string) (*venue.Venue, errs.AppError) {
+	filter := query.Filter{
+		"_id": id,
+	}
+
+	opts := query.FindOneOptions{}
+
+	mVenue := venue.Venue{}
+	err := repo.store.FindOne(ctx, VenueCollection, filter, &mVenue, opts)
+	if err != nil {
+		return nil, errs.ErrMongoFindOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", VenueCollection, id, err)
+	}
+
+	if mVenue.ID == "" {
+		return nil, nil
+	}
+
+	return &mVenue, nil
+}
+
+func (repo venueRepo) List(ctx context.Context) ([]venue.Venue, errs.AppError) {
+	filter := query.Filter{}
+
+	opts := query.FindOptions{}
+	mVenue := []venue.Venue{}
+	venues, err := repo.store.Find(ctx, VenueCollection, filter, opts)
+	if err != nil {
+		return mVenue, errs.ErrMongoFind.Throwf(applog.Log, "for collection: %s, err: [%v]", VenueCollection, err)
+	}
+
+	defer func() {
+		_ = venues.Close(ctx)
+	}()
+
+	for {
+		if venues.Err() != nil {
+			return mVenue, err
+		}
+
+		if ok := venues.Next(ctx); !ok {
+			break
+		}
+
+		var u venue.Venue
+		if err_ := venues.Decode(&u); err_ != nil {
+			return mVenue, err
+		}
+
+		mVenue = append(mVenue, u)
+	}
+
+	return mVenue, nil
+}
+
+func (repo venueRepo) Update(ctx context.Context, v venue.Venue) (*venue.Venue, errs.AppError) {
+	res := venue.Venue{}
+	filter := query.Filter{
+		"_id": v.GetID(),
+	}
+
+	err := repo.store.FindOne(ctx, VenueCollection, filter, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.ID == "" {
+		return nil, errs.ErrMongoFindOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", VenueCollection, v.GetID(), err)
+	}
+
+	v.ID = res.ID
+	v.Created = res.Created
+	err = repo.store.UpdateOne(ctx, VenueCollection, &v)
+	if err != nil {
+		return nil, errs.ErrMongoUpdateOne.Throwf(applog.Log, "for collection: %s, and ID: %s, err: [%v]", VenueCollection, v.GetID(), err)
+	}
+
+	return &v, nil
+}
+
+func (repo venueRepo) Delete(ctx context.Context, id string) errs.AppError {
+	err := repo.store.DeleteOne(ctx, VenueCollection, id)
+	return err
+}
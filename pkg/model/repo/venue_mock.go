@@ -0,0 +1,52 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+type MockVenueRepo struct {
+	venue.VenueRepo
+	InsertFunc func(ctx context.Context, v venue.Venue) errs.AppError
+	GetFunc    func(ctx context.Context, id string) (*venue.Venue, errs.AppError)
+	ListFunc   func(ctx context.Context) ([]venue.Venue, errs.AppError)
+	UpdateFunc func(ctx context.Context, v venue.Venue) (*venue.Venue, errs.AppError)
+	DeleteFunc func(ctx context.Context, id string) errs.AppError
+}
+
+func (m MockVenueRepo) Insert(ctx context.Context, v venue.Venue) errs.AppError {
+	if m.InsertFunc != nil {
+		return m.InsertFunc(ctx, v)
+	}
+	return m.VenueRepo.Insert(ctx, v)
+}
+
+func (m MockVenueRepo) Get(ctx context.Context, id string) (*venue.Venue, errs.AppError) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return m.VenueRepo.Get(ctx, id)
+}
+
+func (m MockVenueRepo) List(ctx context.Context) ([]venue.Venue, errs.AppError) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return m.VenueRepo.List(ctx)
+}
+
+func (m MockVenueRepo) Update(ctx context.Context, v venue.Venue) (*venue.Venue, errs.AppError) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, v)
+	}
+	return m.VenueRepo.Update(ctx, v)
+}
+
+func (m MockVenueRepo) Delete(ctx context.Context, id string) errs.AppError {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return m.VenueRepo.Delete(ctx, id)
+}
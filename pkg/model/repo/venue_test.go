@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func TestVenueRepoInsert(t *testing.T) {
+	ctx := context.Background()
+
+	SetVenueRepo(MockVenueRepo{
+		InsertFunc: func(ctx context.Context, t venue.Venue) errs.AppError {
+			return nil
+		},
+	})
+	defer SetVenueRepo(nil)
+
+	newVenue := prototype.PrototypeVenue()
+
+	err := GetVenueRepo().Insert(ctx, newVenue)
+	assert.NoError(t, err)
+}
+
+func TestVenueRepoGet(t *testing.T) {
+	ctx := context.Background()
+
+	SetVenueRepo(MockVenueRepo{
+		GetFunc: func(ctx context.Context, id string) (*venue.Venue, errs.AppError) {
+			venueMock := prototype.PrototypeVenue()
+			return &venueMock, nil
+		},
+	})
+	defer SetVenueRepo(nil)
+
+	newVenue := prototype.PrototypeVenue()
+
+	result, err := GetVenueRepo().Get(ctx, "new-venue-id")
+	assert.NoError(t, err)
+
+	assert.Equal(t, newVenue, *result)
+}
+
+func TestVenueRepoList(t *testing.T) {
+	ctx := context.Background()
+
+	SetVenueRepo(MockVenueRepo{
+		ListFunc: func(ctx context.Context) ([]venue.Venue, errs.AppError) {
+			venueMock := prototype.PrototypeVenue()
+			venueMock2 := prototype.PrototypeVenue()
+
+			return []venue.Venue{venueMock, venueMock2}, nil
+		},
+	})
+	defer SetVenueRepo(nil)
+
+	venues, err := GetVenueRepo().List(ctx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(venues))
+}
+
+func TestVenueRepoUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	SetVenueRepo(MockVenueRepo{
+		UpdateFunc: func(ctx context.Context, t venue.Venue) (*venue.Venue, errs.AppError) {
+			return &t, nil
+		},
+	})
+	defer SetVenueRepo(nil)
+
+	newVenue := prototype.PrototypeVenue()
+
+	venueUpdated, err := GetVenueRepo().Update(ctx, newVenue)
+	assert.NoError(t, err)
+
+	assert.Equal(t, newVenue, *venueUpdated)
+
+}
+
+func TestVenueRepoDelete(t *testing.T) {
+	ctx := context.Background()
+
+	SetVenueRepo(MockVenueRepo{
+		DeleteFunc: func(ctx context.Context, id string) errs.AppError {
+			return nil
+		},
+	})
+	defer SetVenueRepo(nil)
+
+	newVenue := prototype.PrototypeVenue()
+
+	err := GetVenueRepo().Delete(ctx, newVenue.GetID())
+	assert.NoError(t, err)
+}
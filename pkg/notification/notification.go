@@ -46,6 +46,11 @@ func Handler(ctx context.Context, body, messageId string) errs.AppError {
 			if err != nil {
 				return errs.ErrHandlingGameEventHalftime.Throwf(applog.Log, errs.ErrFmt, err.Error())
 			}
+		case model.EventSecondHalf:
+			err := handlers.HandleEventMatchSecondHalf(ctx, pn.Data)
+			if err != nil {
+				return errs.ErrHandlingGameEventSecondHalf.Throwf(applog.Log, errs.ErrFmt, err.Error())
+			}
 		case model.EventExtratime:
 			err := handlers.HandleEventMatchExtratime(ctx, pn.Data)
 			if err != nil {
@@ -66,6 +71,46 @@ func Handler(ctx context.Context, body, messageId string) errs.AppError {
 			if err != nil {
 				return errs.ErrHandlingGameEventFinish.Throwf(applog.Log, errs.ErrFmt, err.Error())
 			}
+		case model.EventExtraTimeFirstHalf, model.EventExtraTimeSecondHalf:
+			err := handlers.HandleEventMatchPeriodStart(ctx, pn.Data)
+			if err != nil {
+				return errs.ErrHandlingGameEventExtraTime.Throwf(applog.Log, errs.ErrFmt, err.Error())
+			}
+		case model.EventPenaltyShootout:
+			err := handlers.HandleEventMatchPeriodStart(ctx, pn.Data)
+			if err != nil {
+				return errs.ErrHandlingGameEventPenalties.Throwf(applog.Log, errs.ErrFmt, err.Error())
+			}
+		case model.EventPenaltyKick:
+			err := handlers.HandleEventMatchPenaltyKick(ctx, pn.Data)
+			if err != nil {
+				return errs.ErrHandlingGameEventPenaltyKick.Throwf(applog.Log, errs.ErrFmt, err.Error())
+			}
+		case model.EventLineup:
+			err := handlers.HandleEventMatchLineup(ctx, pn.Data)
+			if err != nil {
+				return errs.ErrHandlingGameEventLineup.Throwf(applog.Log, errs.ErrFmt, err.Error())
+			}
+		case model.EventPostpone, model.EventSuspend, model.EventResume, model.EventAbandon, model.EventCancel:
+			err := handlers.HandleEventMatchStatusChange(ctx, pn.Data)
+			if err != nil {
+				return errs.ErrHandlingGameEventStatusChange.Throwf(applog.Log, errs.ErrFmt, err.Error())
+			}
+		case model.EventShot, model.EventCorner, model.EventFoul, model.EventOffside, model.EventSave, model.EventPossession:
+			err := handlers.HandleEventMatchStatistic(ctx, pn.Data)
+			if err != nil {
+				return errs.ErrHandlingGameEventStatistic.Throwf(applog.Log, errs.ErrFmt, err.Error())
+			}
+		case model.EventRetraction:
+			err := handlers.HandleEventMatchCorrection(ctx, pn.Data)
+			if err != nil {
+				return errs.ErrHandlingGameEventRetraction.Throwf(applog.Log, errs.ErrFmt, err.Error())
+			}
+		case model.EventAmendment:
+			err := handlers.HandleEventMatchCorrection(ctx, pn.Data)
+			if err != nil {
+				return errs.ErrHandlingGameEventAmendment.Throwf(applog.Log, errs.ErrFmt, err.Error())
+			}
 		default:
 			return errs.ErrActionNotImplemented.Throwf(applog.Log, errs.ErrFmt, pn.Data["matchEventType"])
 		}
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/event"
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model/repo"
 	"github.com/rafaelsanzio/go-flashscore/pkg/player"
 	"github.com/rafaelsanzio/go-flashscore/pkg/prototype"
@@ -112,6 +114,18 @@ func mockFindMatchForTournamentFunc(ctx context.Context, id string, tournamentID
 	return &matchMock, nil
 }
 
+func mockFindMatchInProgressForTournamentFunc(ctx context.Context, id string, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusInProgress
+	return &matchMock, nil
+}
+
+func mockFindMatchHalftimeForTournamentFunc(ctx context.Context, id string, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusHalftime
+	return &matchMock, nil
+}
+
 func mockUpdateMatchFunc(ctx context.Context, m match.Match) (*match.Match, errs.AppError) {
 	return &m, nil
 }
@@ -189,7 +203,7 @@ func TestHandlerMatchEventGoal(t *testing.T) {
 			Name:                       "Handle action game event match goal",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Goal", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "player":"any-player-id", "goalMinute":"10"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:          mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
@@ -198,7 +212,7 @@ func TestHandlerMatchEventGoal(t *testing.T) {
 			Name:                       "Handle action game event match goal error",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Goal", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "player":"any-player-id", "goalMinute":"10"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:          mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
@@ -254,14 +268,14 @@ func TestHandlerMatchEventHalftime(t *testing.T) {
 			Name:                       "Handle action game event match halftime",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Halftime", "tournamentID":"any-tournament-id","matchID":"any-match-id", "halftime":"any-halftime"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
 			ExpectedError:              false,
 		}, {
 			Name:                       "Handle action game event match halftime error",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Halftime", "tournamentID":"any-tournament-id","matchID":"any-match-id", "halftime":"any-halftime"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
 			ExpectedError:              true,
 		},
@@ -290,6 +304,57 @@ func TestHandlerMatchEventHalftime(t *testing.T) {
 	}
 }
 
+func TestHandlerMatchEventSecondHalf(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name                       string
+		Body                       string
+		HandleGetTournamentFunc    func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		FindMatchForTournamentFunc func(ctx context.Context, id string, tournamentID string) (*match.Match, errs.AppError)
+		UpdateMatchFunc            func(ctx context.Context, m match.Match) (*match.Match, errs.AppError)
+		ExpectedError              bool
+	}{
+		{
+			Name:                       "Handle action game event match second half",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"SecondHalf", "tournamentID":"any-tournament-id","matchID":"any-match-id", "timeStarted":"any-time-started"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchHalftimeForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              false,
+		}, {
+			Name:                       "Handle action game event match second half error",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"SecondHalf", "tournamentID":"any-tournament-id","matchID":"any-match-id", "timeStarted":"any-time-started"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
+			FindMatchForTournamentFunc: mockFindMatchHalftimeForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.FindMatchForTournamentFunc,
+			UpdateFunc:                 tc.UpdateMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		err := Handler(ctx, tc.Body, "any-key")
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
 func TestHandlerMatchEventNotFound(t *testing.T) {
 	ctx := context.Background()
 
@@ -332,14 +397,14 @@ func TestHandlerMatchEventExtratime(t *testing.T) {
 			Name:                       "Handle action game event match extratime",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Extratime", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "extratime":"5"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
 			ExpectedError:              false,
 		}, {
 			Name:                       "Handle action game event match extratime error",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Extratime", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "extratime":"5"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
 			ExpectedError:              true,
 		},
@@ -385,7 +450,7 @@ func TestHandlerMatchEventSubstitution(t *testing.T) {
 			Name:                       "Handle action game event match substitution",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Substitution", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "teamID": "any-team-id", "playerOutID": "any-player-out-id", "playerInID": "any-player-in-id", "substitutionMinute":"5"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:          mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
@@ -394,7 +459,7 @@ func TestHandlerMatchEventSubstitution(t *testing.T) {
 			Name:                       "Handle action game event match substitution error",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Substitution", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "teamID": "any-team-id", "playerOutID": "any-player-out-id", "playerInID": "any-player-in-id", "substitutionMinute":"5"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:          mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
@@ -452,7 +517,7 @@ func TestHandlerMatchEventWarning(t *testing.T) {
 			Name:                       "Handle action game event match warning",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Warning", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "teamID": "any-team-id", "playerID": "any-player-id", "warning": "any-warning", "warningMinute":"5"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:          mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
@@ -461,7 +526,7 @@ func TestHandlerMatchEventWarning(t *testing.T) {
 			Name:                       "Handle action game event match warning error",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Warning", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "teamID": "any-team-id", "playerID": "any-player-id", "warning": "any-warning", "warningMinute":"5"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			HandleGetTeamFunc:          mockGetTeamFunc,
 			HandleGetTeamPlayerFunc:    mockGetTeamPlayerFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
@@ -502,6 +567,14 @@ func TestHandlerMatchEventWarning(t *testing.T) {
 	}
 }
 
+func mockListMatchByTournamentFunc(ctx context.Context, tournamentID string) ([]match.Match, errs.AppError) {
+	return []match.Match{prototype.PrototypeMatch()}, nil
+}
+
+func mockSaveStandingsFunc(ctx context.Context, s match.TournamentStandings) errs.AppError {
+	return nil
+}
+
 func TestHandlerMatchEventFinish(t *testing.T) {
 	ctx := context.Background()
 
@@ -517,19 +590,24 @@ func TestHandlerMatchEventFinish(t *testing.T) {
 			Name:                       "Handle action game event match finish",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Finish", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "timeFinished":"18:00"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
 			ExpectedError:              false,
 		}, {
 			Name:                       "Handle action game event match finish error",
 			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Finish", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "timeFinished":"18:00"}}`,
 			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
-			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
 			UpdateMatchFunc:            mockUpdateMatchFunc,
 			ExpectedError:              true,
 		},
 	}
 
+	repo.SetStandingsRepo(repo.MockStandingsRepo{
+		SaveFunc: mockSaveStandingsFunc,
+	})
+	defer repo.SetStandingsRepo(nil)
+
 	for _, tc := range testCases {
 		t.Logf(tc.Name)
 
@@ -541,6 +619,7 @@ func TestHandlerMatchEventFinish(t *testing.T) {
 		repo.SetMatchRepo(repo.MockMatchRepo{
 			FindMatchForTournamentFunc: tc.FindMatchForTournamentFunc,
 			UpdateFunc:                 tc.UpdateMatchFunc,
+			ListByTournamentFunc:       mockListMatchByTournamentFunc,
 		})
 		defer repo.SetMatchRepo(nil)
 
@@ -552,3 +631,340 @@ func TestHandlerMatchEventFinish(t *testing.T) {
 		}
 	}
 }
+
+func mockGetCorrectionEventFunc(ctx context.Context, id string) (*event.Event, errs.AppError) {
+	e := event.New("1", prototype.PrototypeMatch().ID, event.Retraction{EventID: "any-event-id", Reason: "Offside"})
+	return &e, nil
+}
+
+func TestHandlerMatchEventCorrection(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name                       string
+		Body                       string
+		HandleGetTournamentFunc    func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		FindMatchForTournamentFunc func(ctx context.Context, id string, tournamentID string) (*match.Match, errs.AppError)
+		UpdateMatchFunc            func(ctx context.Context, m match.Match) (*match.Match, errs.AppError)
+		ExpectedError              bool
+	}{
+		{
+			Name:                       "Handle action game event match retraction",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Retraction", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "eventID":"any-event-id"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              false,
+		}, {
+			Name:                       "Handle action game event match retraction error",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Retraction", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "eventID":"any-event-id"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              true,
+		}, {
+			Name:                       "Handle action game event match amendment",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Amendment", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "eventID":"any-event-id"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              false,
+		}, {
+			Name:                       "Handle action game event match amendment error",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Amendment", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "eventID":"any-event-id"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.FindMatchForTournamentFunc,
+			UpdateFunc:                 tc.UpdateMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetEventRepo(repo.MockEventRepo{
+			GetFunc: mockGetCorrectionEventFunc,
+		})
+		defer repo.SetEventRepo(nil)
+
+		err := Handler(ctx, tc.Body, "any-key")
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func mockFindMatchAfterSecondHalfForTournamentFunc(ctx context.Context, id string, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusHalftime
+	matchMock.Period = model.PeriodSecondHalf
+	return &matchMock, nil
+}
+
+func mockFindMatchPenaltiesForTournamentFunc(ctx context.Context, id string, tournamentID string) (*match.Match, errs.AppError) {
+	matchMock := prototype.PrototypeMatch()
+	matchMock.Status = model.MatchStatusPenalties
+	matchMock.Period = model.PeriodPenalties
+	return &matchMock, nil
+}
+
+func TestHandlerMatchEventExtraTimeAndPenalties(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name                       string
+		Body                       string
+		HandleGetTournamentFunc    func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		FindMatchForTournamentFunc func(ctx context.Context, id string, tournamentID string) (*match.Match, errs.AppError)
+		UpdateMatchFunc            func(ctx context.Context, m match.Match) (*match.Match, errs.AppError)
+		ExpectedError              bool
+	}{
+		{
+			Name:                       "Handle action game event match extra time first half",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"ExtraTimeFirstHalf", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "timeStarted":"17:55"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              false,
+		}, {
+			Name:                       "Handle action game event match extra time second half error",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"ExtraTimeSecondHalf", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "timeStarted":"18:12"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              true,
+		}, {
+			Name:                       "Handle action game event match penalty shootout",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"PenaltyShootout", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "timeStarted":"18:30"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchAfterSecondHalfForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              false,
+		}, {
+			Name:                       "Handle action game event match penalty kick",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"PenaltyKick", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "teamID":"any-team-id", "playerID":"any-player-id", "scored":"true"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchPenaltiesForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              false,
+		}, {
+			Name:                       "Handle action game event match penalty kick error",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"PenaltyKick", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "teamID":"any-team-id", "playerID":"any-player-id", "scored":"true"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentThrowFunc,
+			FindMatchForTournamentFunc: mockFindMatchPenaltiesForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.FindMatchForTournamentFunc,
+			UpdateFunc:                 tc.UpdateMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: mockGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: mockGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		err := Handler(ctx, tc.Body, "any-key")
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestHandlerMatchEventLineup(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name                       string
+		Body                       string
+		FindMatchForTournamentFunc func(ctx context.Context, id string, tournamentID string) (*match.Match, errs.AppError)
+		ExpectedError              bool
+	}{
+		{
+			Name:                       "Handle action game event match lineup",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Lineup", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "teamID":"any-team-id", "startingXI":"1,2,3,4,5,6,7,8,9,10,11", "bench":"12", "captainID":"1"}}`,
+			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			ExpectedError:              false,
+		}, {
+			Name:                       "Handle action game event match lineup error",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Lineup", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "teamID":"any-team-id", "startingXI":"1,2,3,4,5,6,7,8,9,10,11", "bench":"12", "captainID":"1"}}`,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			ExpectedError:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: mockGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.FindMatchForTournamentFunc,
+			UpdateFunc:                 mockUpdateMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: mockGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: mockGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		err := Handler(ctx, tc.Body, "any-key")
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestHandlerMatchEventStatusChange(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name                       string
+		Body                       string
+		HandleGetTournamentFunc    func(ctx context.Context, id string) (*tournament.Tournament, errs.AppError)
+		FindMatchForTournamentFunc func(ctx context.Context, id string, tournamentID string) (*match.Match, errs.AppError)
+		UpdateMatchFunc            func(ctx context.Context, m match.Match) (*match.Match, errs.AppError)
+		ExpectedError              bool
+	}{
+		{
+			Name:                       "Handle action game event match postpone",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Postpone", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "reason":"Waterlogged pitch", "kickoff":"2022-02-08T19:00:00Z"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              false,
+		}, {
+			Name:                       "Handle action game event match suspend error",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Suspend", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "reason":"Floodlight failure", "time":"16:20"}}`,
+			HandleGetTournamentFunc:    mockGetTournamentFunc,
+			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			UpdateMatchFunc:            mockUpdateMatchFunc,
+			ExpectedError:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: tc.HandleGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.FindMatchForTournamentFunc,
+			UpdateFunc:                 tc.UpdateMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		err := Handler(ctx, tc.Body, "any-key")
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestHandlerMatchEventStatistic(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name                       string
+		Body                       string
+		FindMatchForTournamentFunc func(ctx context.Context, id string, tournamentID string) (*match.Match, errs.AppError)
+		ExpectedError              bool
+	}{
+		{
+			Name:                       "Handle action game event match shot",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Shot", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "teamID":"any-team-id", "playerID":"any-player-id", "onTarget":"true", "minute":"12"}}`,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			ExpectedError:              false,
+		}, {
+			Name:                       "Handle action game event match possession",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Possession", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "home":"58", "away":"42", "minute":"30"}}`,
+			FindMatchForTournamentFunc: mockFindMatchInProgressForTournamentFunc,
+			ExpectedError:              false,
+		}, {
+			Name:                       "Handle action game event match corner error",
+			Body:                       `{"Action":"ActionGameEvents","Data":{"matchEventType":"Corner", "tournamentID":"any-tournament-id", "matchID":"any-match-id", "teamID":"any-team-id", "minute":"20"}}`,
+			FindMatchForTournamentFunc: mockFindMatchForTournamentFunc,
+			ExpectedError:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		repo.SetTournamentRepo(repo.MockTournamentRepo{
+			GetFunc: mockGetTournamentFunc,
+		})
+		defer repo.SetTournamentRepo(nil)
+
+		repo.SetMatchRepo(repo.MockMatchRepo{
+			FindMatchForTournamentFunc: tc.FindMatchForTournamentFunc,
+			UpdateFunc:                 mockUpdateMatchFunc,
+		})
+		defer repo.SetMatchRepo(nil)
+
+		repo.SetTeamRepo(repo.MockTeamRepo{
+			GetFunc: mockGetTeamFunc,
+		})
+		defer repo.SetTeamRepo(nil)
+
+		repo.SetPlayerRepo(repo.MockPlayerRepo{
+			GetTeamPlayerFunc: mockGetTeamPlayerFunc,
+		})
+		defer repo.SetPlayerRepo(nil)
+
+		err := Handler(ctx, tc.Body, "any-key")
+		if tc.ExpectedError {
+			assert.NotNil(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
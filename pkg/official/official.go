@@ -0,0 +1,33 @@
+package official
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+type OfficialRepo interface {
+	Insert(ctx context.Context, o Official) errs.AppError
+	Get(ctx context.Context, id string) (*Official, errs.AppError)
+	List(ctx context.Context) ([]Official, errs.AppError)
+	Update(ctx context.Context, o Official) (*Official, errs.AppError)
+	Delete(ctx context.Context, id string) errs.AppError
+}
+
+type Official struct {
+	ID      string `bson:"_id"`
+	Name    string
+	Country string
+	Role    model.OfficialRole
+	Created time.Time
+}
+
+func (o Official) GetID() string {
+	return o.ID
+}
+
+func (o *Official) SetID(id string) {
+	o.ID = id
+}
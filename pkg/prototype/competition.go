@@ -0,0 +1,13 @@
+package prototype
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/competition"
+)
+
+func PrototypeCompetition() competition.Competition {
+	return competition.Competition{
+		ID:      "1",
+		Name:    "Premier League",
+		Country: "England",
+	}
+}
@@ -1,19 +1,21 @@
 package prototype
 
 import (
+	"time"
+
 	"github.com/rafaelsanzio/go-flashscore/pkg/match"
 	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 )
 
 func PrototypeMatch() match.Match {
 	return match.Match{
-		ID:          "1",
-		Tournament:  PrototypeTournament(),
-		HomeTeam:    PrototypeTeam(),
-		AwayTeam:    PrototypeTeam(),
-		DateOfMatch: "2022-02-01",
-		TimeOfMatch: "16:00",
-		Status:      model.MatchStatusNotStart,
-		Events:      nil,
+		ID:         "1",
+		Tournament: PrototypeTournament(),
+		HomeTeam:   PrototypeTeam(),
+		AwayTeam:   PrototypeTeam(),
+		Kickoff:    time.Date(2022, 2, 1, 16, 0, 0, 0, time.UTC),
+		Status:     model.MatchStatusNotStart,
+		Events:     nil,
+		Score:      match.Score{Status: model.MatchStatusNotStart},
 	}
 }
@@ -0,0 +1,15 @@
+package prototype
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/official"
+)
+
+func PrototypeOfficial() official.Official {
+	return official.Official{
+		ID:      "1",
+		Name:    "Antonio Mateu Lahoz",
+		Country: "Spain",
+		Role:    model.OfficialReferee,
+	}
+}
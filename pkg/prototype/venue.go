@@ -0,0 +1,20 @@
+package prototype
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
+)
+
+func PrototypeVenue() venue.Venue {
+	return venue.Venue{
+		ID:       "1",
+		Name:     "Santiago Bernabéu",
+		City:     "Madrid",
+		Country:  "Spain",
+		Capacity: 81044,
+		TimeZone: "Europe/Madrid",
+		Coordinates: venue.Coordinates{
+			Latitude:  40.453054,
+			Longitude: -3.688344,
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store/query"
+)
+
+// EnsureUniqueIndex creates a unique index on the given keys if it doesn't
+// exist yet, a non empty partial filter limits the index to the documents
+// matching it.
+func (s *Store) EnsureUniqueIndex(ctx context.Context, collection string, keys []string, partial query.Filter) errs.AppError {
+	col := s.client.Database(dbName).Collection(collection)
+
+	index := bson.D{}
+	for _, k := range keys {
+		index = append(index, bson.E{Key: k, Value: 1})
+	}
+
+	opts := options.Index().SetUnique(true)
+	if len(partial) > 0 {
+		opts.SetPartialFilterExpression(bson.M(partial))
+	}
+
+	_, err := col.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: index, Options: opts})
+	if err != nil {
+		return errs.ErrMongoCreateIndex.Throwf(applog.Log, "for collection: %s, keys: %v, err: [%v]", collection, keys, err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
@@ -31,6 +32,9 @@ func (s *Store) InsertOne(ctx context.Context, collection string, data interface
 
 	res, err := col.InsertOne(ctx, b)
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return "", errs.ErrMongoDuplicateKey.Throwf(applog.Log, errs.ErrFmt, err)
+		}
 		return "", errs.ErrMongoInsertOne.Throwf(applog.Log, errs.ErrFmt, err)
 	}
 
@@ -46,3 +50,45 @@ func (s *Store) InsertOne(ctx context.Context, collection string, data interface
 
 	return id, nil
 }
+
+// InsertMany inserts all the documents or none, the documents inserted before
+// a failure are deleted again.
+func (s *Store) InsertMany(ctx context.Context, collection string, data []interface{}) errs.AppError {
+	col := s.client.Database(dbName).Collection(collection)
+
+	ids := []string{}
+	docs := []interface{}{}
+	for _, d := range data {
+		doc, ok := d.(Document)
+		if !ok {
+			return errs.ErrNotDocumentInterface.Throw(applog.Log)
+		}
+
+		if doc.GetID() == "" {
+			doc.SetID(primitive.NewObjectID().Hex())
+		}
+
+		b, err := bson.Marshal(doc)
+		if err != nil {
+			return errs.ErrMarshalingBson.Throwf(applog.Log, errs.ErrFmt, err)
+		}
+
+		ids = append(ids, doc.GetID())
+		docs = append(docs, b)
+	}
+
+	if len(docs) == 0 {
+		return nil
+	}
+
+	_, err := col.InsertMany(ctx, docs)
+	if err != nil {
+		_, err_ := col.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+		if err_ != nil {
+			return errs.ErrMongoInsertMany.Throwf(applog.Log, errs.ErrFmtMore, err, err_)
+		}
+		return errs.ErrMongoInsertMany.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/store/query"
 )
 
 func (s *Store) UpdateOne(ctx context.Context, collection string, data interface{}) errs.AppError {
@@ -18,6 +20,35 @@ func (s *Store) UpdateOne(ctx context.Context, collection string, data interface
 	}
 
 	_, err := col.UpdateOne(ctx, bson.M{"_id": doc.GetID()}, bson.M{"$set": data})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errs.ErrMongoDuplicateKey.Throwf(applog.Log, errs.ErrFmt, err)
+		}
+		return errs.ErrMongoUpdateOne.Throwf(applog.Log, errs.ErrFmt, err)
+	}
+
+	return nil
+}
+
+// UpdateFields sets only the given fields of the document and removes the
+// unset ones, the rest of the document is left as it is.
+func (s *Store) UpdateFields(ctx context.Context, collection string, id string, fields query.Filter, unset ...string) errs.AppError {
+	col := s.client.Database(dbName).Collection(collection)
+
+	update := bson.M{}
+	if len(fields) > 0 {
+		update["$set"] = bson.M(fields)
+	}
+
+	if len(unset) > 0 {
+		removed := bson.M{}
+		for _, f := range unset {
+			removed[f] = ""
+		}
+		update["$unset"] = removed
+	}
+
+	_, err := col.UpdateOne(ctx, bson.M{"_id": id}, update)
 	if err != nil {
 		return errs.ErrMongoUpdateOne.Throwf(applog.Log, errs.ErrFmt, err)
 	}
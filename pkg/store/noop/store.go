@@ -22,14 +22,26 @@ func (s Store) InsertOne(_ context.Context, _ string, _ interface{}) (string, er
 	return "", nil
 }
 
+func (s Store) InsertMany(_ context.Context, _ string, _ []interface{}) errs.AppError {
+	return nil
+}
+
 func (s Store) UpdateOne(_ context.Context, _ string, _ interface{}) errs.AppError {
 	return nil
 }
 
+func (s Store) UpdateFields(_ context.Context, _ string, _ string, _ query.Filter, _ ...string) errs.AppError {
+	return nil
+}
+
 func (s Store) DeleteOne(_ context.Context, _ string, _ string) errs.AppError {
 	return nil
 }
 
+func (s Store) EnsureUniqueIndex(_ context.Context, _ string, _ []string, _ query.Filter) errs.AppError {
+	return nil
+}
+
 type Cursor struct{}
 
 func (c Cursor) Next(_ context.Context) bool {
@@ -6,4 +6,6 @@ const (
 	GT  = "$gt"
 	GTE = "$gte"
 	OR  = "$or"
+
+	EXISTS = "$exists"
 )
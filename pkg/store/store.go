@@ -15,8 +15,11 @@ type Store interface {
 	FindOne(ctx context.Context, collection string, filter query.Filter, v interface{}, opts ...query.FindOneOptions) errs.AppError
 	Find(ctx context.Context, collection string, filter query.Filter, opts ...query.FindOptions) (cursor.Cursor, errs.AppError)
 	InsertOne(ctx context.Context, collection string, data interface{}) (string, errs.AppError)
+	InsertMany(ctx context.Context, collection string, data []interface{}) errs.AppError
 	UpdateOne(ctx context.Context, collection string, data interface{}) errs.AppError
+	UpdateFields(ctx context.Context, collection string, id string, fields query.Filter, unset ...string) errs.AppError
 	DeleteOne(ctx context.Context, collection string, id string) errs.AppError
+	EnsureUniqueIndex(ctx context.Context, collection string, keys []string, partial query.Filter) errs.AppError
 }
 
 var store Store
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/venue"
 )
 
 type TeamRepo interface {
@@ -21,6 +22,7 @@ type Team struct {
 	ShortCode string
 	Country   string
 	City      string
+	Venue     *venue.Venue `json:",omitempty"`
 	Created   time.Time
 }
 
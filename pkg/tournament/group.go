@@ -0,0 +1,131 @@
+package tournament
+
+import (
+	"math/rand"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/applog"
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+type Group struct {
+	Name  string
+	Teams []team.Team
+}
+
+// Qualification sends the top PerGroup teams of every group to the knockout,
+// with the BestThirds best teams placed right after them across the groups.
+type Qualification struct {
+	PerGroup   int
+	BestThirds int
+}
+
+// DrawOptions splits the teams in Groups groups. Without Pots the teams are
+// drawn at random, with Pots every group takes a team from each pot in turn.
+// The same Seed always draws the same groups.
+type DrawOptions struct {
+	Groups int
+	Pots   [][]string
+	Seed   int64
+}
+
+// Draw splits the tournament teams in groups named from A.
+func Draw(t Tournament, opts DrawOptions) ([]Group, errs.AppError) {
+	if opts.Groups < 1 || opts.Groups*2 > len(t.Teams) {
+		return nil, errs.ErrDrawInvalidGroups.Throwf(applog.Log, errs.ErrFmtMore, opts.Groups, len(t.Teams))
+	}
+
+	pots, err := drawPots(t, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]Group, opts.Groups)
+	for i := range groups {
+		groups[i] = Group{Name: string(rune('A' + i)), Teams: []team.Team{}}
+	}
+
+	random := rand.New(rand.NewSource(opts.Seed))
+	for _, pot := range pots {
+		random.Shuffle(len(pot), func(i, j int) {
+			pot[i], pot[j] = pot[j], pot[i]
+		})
+
+		for i, tm := range pot {
+			groups[i].Teams = append(groups[i].Teams, tm)
+		}
+	}
+
+	return groups, nil
+}
+
+// CheckQualification validates the qualification rules against the groups,
+// the third-placed teams are the ones right after the PerGroup qualified.
+func (t Tournament) CheckQualification(q Qualification) errs.AppError {
+	smallest := 0
+	for i, g := range t.Groups {
+		if i == 0 || len(g.Teams) < smallest {
+			smallest = len(g.Teams)
+		}
+	}
+
+	qualified := q.PerGroup*len(t.Groups) + q.BestThirds
+	if q.PerGroup < 1 || q.PerGroup > smallest || q.BestThirds < 0 || q.BestThirds > len(t.Groups) ||
+		(q.BestThirds > 0 && q.PerGroup == smallest) || qualified < 2 {
+		return errs.ErrQualificationRules.Throwf(applog.Log, errs.ErrFmtMore, q.PerGroup, q.BestThirds)
+	}
+
+	return nil
+}
+
+// drawPots returns the teams of each pot, the random draw deals the teams in
+// pots as big as the number of groups.
+func drawPots(t Tournament, opts DrawOptions) ([][]team.Team, errs.AppError) {
+	if len(opts.Pots) == 0 {
+		pots := [][]team.Team{}
+		teams := append([]team.Team{}, t.Teams...)
+		rand.New(rand.NewSource(opts.Seed)).Shuffle(len(teams), func(i, j int) {
+			teams[i], teams[j] = teams[j], teams[i]
+		})
+
+		for start := 0; start < len(teams); start += opts.Groups {
+			end := start + opts.Groups
+			if end > len(teams) {
+				end = len(teams)
+			}
+			pots = append(pots, teams[start:end])
+		}
+		return pots, nil
+	}
+
+	teams := map[string]team.Team{}
+	for _, tm := range t.Teams {
+		teams[tm.ID] = tm
+	}
+
+	pots := [][]team.Team{}
+	drawn := map[string]bool{}
+	for _, ids := range opts.Pots {
+		if len(ids) > opts.Groups {
+			return nil, errs.ErrDrawInvalidPots.Throwf(applog.Log, errs.ErrFmt, ids)
+		}
+
+		pot := []team.Team{}
+		for _, id := range ids {
+			tm, ok := teams[id]
+			if !ok || drawn[id] {
+				return nil, errs.ErrDrawInvalidPots.Throwf(applog.Log, errs.ErrFmt, id)
+			}
+
+			pot = append(pot, tm)
+			drawn[id] = true
+		}
+		pots = append(pots, pot)
+	}
+
+	if len(drawn) != len(t.Teams) {
+		return nil, errs.ErrDrawInvalidPots.Throwf(applog.Log, errs.ErrFmtMore, len(drawn), len(t.Teams))
+	}
+
+	return pots, nil
+}
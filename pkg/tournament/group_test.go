@@ -0,0 +1,106 @@
+package tournament
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+func drawTournament(n int) Tournament {
+	t := Tournament{ID: "1"}
+	for i := 1; i <= n; i++ {
+		t.Teams = append(t.Teams, team.Team{ID: fmt.Sprintf("t%d", i)})
+	}
+	return t
+}
+
+func groupIDs(groups []Group) [][]string {
+	ids := [][]string{}
+	for _, g := range groups {
+		teams := []string{}
+		for _, tm := range g.Teams {
+			teams = append(teams, tm.ID)
+		}
+		ids = append(ids, teams)
+	}
+	return ids
+}
+
+func TestDraw(t *testing.T) {
+	cup := drawTournament(10)
+
+	groups, err := Draw(cup, DrawOptions{Groups: 3, Seed: 42})
+	assert.Nil(t, err)
+	assert.Len(t, groups, 3)
+	assert.Equal(t, []string{"A", "B", "C"}, []string{groups[0].Name, groups[1].Name, groups[2].Name})
+	assert.Len(t, groups[0].Teams, 4)
+	assert.Len(t, groups[1].Teams, 3)
+	assert.Len(t, groups[2].Teams, 3)
+
+	drawn := map[string]bool{}
+	for _, g := range groups {
+		for _, tm := range g.Teams {
+			assert.False(t, drawn[tm.ID])
+			drawn[tm.ID] = true
+		}
+	}
+	assert.Len(t, drawn, 10)
+
+	again, err := Draw(cup, DrawOptions{Groups: 3, Seed: 42})
+	assert.Nil(t, err)
+	assert.Equal(t, groupIDs(groups), groupIDs(again))
+}
+
+func TestDrawPots(t *testing.T) {
+	cup := drawTournament(8)
+	pots := [][]string{{"t1", "t2"}, {"t3", "t4"}, {"t5", "t6"}, {"t7", "t8"}}
+
+	groups, err := Draw(cup, DrawOptions{Groups: 2, Pots: pots, Seed: 7})
+	assert.Nil(t, err)
+	assert.Len(t, groups, 2)
+
+	for _, g := range groups {
+		assert.Len(t, g.Teams, 4)
+		for i, tm := range g.Teams {
+			assert.Contains(t, pots[i], tm.ID)
+		}
+	}
+
+	testCases := []struct {
+		Name string
+		Opts DrawOptions
+	}{
+		{Name: "Should not draw without groups", Opts: DrawOptions{Groups: 0}},
+		{Name: "Should not draw groups of a single team", Opts: DrawOptions{Groups: 5}},
+		{Name: "Should not draw a pot bigger than the groups", Opts: DrawOptions{Groups: 2, Pots: [][]string{{"t1", "t2", "t3"}, {"t4", "t5", "t6"}, {"t7", "t8"}}}},
+		{Name: "Should not draw a team out of the tournament", Opts: DrawOptions{Groups: 2, Pots: append([][]string{{"t9"}}, pots...)}},
+		{Name: "Should not draw a team twice", Opts: DrawOptions{Groups: 2, Pots: append([][]string{{"t1"}}, pots...)}},
+		{Name: "Should not draw without every team in a pot", Opts: DrawOptions{Groups: 2, Pots: pots[:3]}},
+	}
+
+	for _, tc := range testCases {
+		t.Log(tc.Name)
+
+		_, err := Draw(cup, tc.Opts)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestCheckQualification(t *testing.T) {
+	cup := drawTournament(8)
+	cup.Groups = []Group{
+		{Name: "A", Teams: cup.Teams[:4]},
+		{Name: "B", Teams: cup.Teams[4:]},
+	}
+
+	assert.Nil(t, cup.CheckQualification(Qualification{PerGroup: 2}))
+	assert.Nil(t, cup.CheckQualification(Qualification{PerGroup: 2, BestThirds: 2}))
+	assert.Nil(t, cup.CheckQualification(Qualification{PerGroup: 1}))
+	assert.NotNil(t, cup.CheckQualification(Qualification{PerGroup: 0}))
+	assert.NotNil(t, cup.CheckQualification(Qualification{PerGroup: 5}))
+	assert.NotNil(t, cup.CheckQualification(Qualification{PerGroup: 2, BestThirds: 3}))
+	assert.NotNil(t, cup.CheckQualification(Qualification{PerGroup: 4, BestThirds: 1}))
+}
@@ -0,0 +1,83 @@
+package tournament
+
+import (
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+	"github.com/rafaelsanzio/go-flashscore/pkg/team"
+)
+
+// Squad is the players a team registers for a season.
+type Squad struct {
+	TeamID  string
+	Players []string
+}
+
+// IsSeasonOf reports whether the tournament is an edition of the competition.
+func (t Tournament) IsSeasonOf(competitionID string) bool {
+	return competitionID != "" && t.CompetitionID == competitionID
+}
+
+// InSeason reports whether the tournament passes the competition and season
+// filters of a listing, an empty filter lets any tournament through.
+func (t Tournament) InSeason(competitionID, season string) bool {
+	if competitionID != "" && t.CompetitionID != competitionID {
+		return false
+	}
+	return season == "" || t.Season == season
+}
+
+// CloneSeason returns the next season of the tournament with the same teams
+// and rules. The draw and the squads belong to a single season and are not
+// carried forward.
+func (t Tournament) CloneSeason(name, season string) Tournament {
+	if name == "" {
+		name = t.Name
+	}
+
+	next := Tournament{
+		Name:          name,
+		CompetitionID: t.CompetitionID,
+		Season:        season,
+		Teams:         append([]team.Team{}, t.Teams...),
+		Substitutions: t.Substitutions,
+		TieBreakers:   append([]model.TieBreaker{}, t.TieBreakers...),
+	}
+
+	if t.Qualification != nil {
+		q := *t.Qualification
+		next.Qualification = &q
+	}
+
+	return next
+}
+
+// Squad returns the season squad of the team, nil when the team registered
+// none and any player of the team can play.
+func (t Tournament) Squad(teamID string) *Squad {
+	for i := range t.Squads {
+		if t.Squads[i].TeamID == teamID {
+			return &t.Squads[i]
+		}
+	}
+	return nil
+}
+
+// SetSquad registers the squad of the team, replacing the one it had.
+func (t *Tournament) SetSquad(squad Squad) {
+	for i := range t.Squads {
+		if t.Squads[i].TeamID == squad.TeamID {
+			t.Squads[i] = squad
+			return
+		}
+	}
+	t.Squads = append(t.Squads, squad)
+}
+
+// HasPlayer reports whether the player is in the squad.
+func (s Squad) HasPlayer(playerID string) bool {
+	for _, id := range s.Players {
+		if id == playerID {
+			return true
+		}
+	}
+	return false
+}
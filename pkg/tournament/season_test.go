@@ -0,0 +1,73 @@
+package tournament
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
+)
+
+func TestCloneSeason(t *testing.T) {
+	season := drawTournament(4)
+	season.Name = "Premier League"
+	season.CompetitionID = "pl"
+	season.Season = "2026/27"
+	season.Substitutions = SubstitutionRules{MaxSubstitutions: 5, MaxWindows: 3}
+	season.TieBreakers = []model.TieBreaker{model.TieBreakerHeadToHead}
+	season.Groups = []Group{{Name: "A", Teams: season.Teams}}
+	season.DrawSeed = 42
+	season.Qualification = &Qualification{PerGroup: 2}
+	season.SetSquad(Squad{TeamID: "t1", Players: []string{"p1"}})
+
+	next := season.CloneSeason("", "2027/28")
+	assert.Empty(t, next.ID)
+	assert.Equal(t, "Premier League", next.Name)
+	assert.Equal(t, "pl", next.CompetitionID)
+	assert.Equal(t, "2027/28", next.Season)
+	assert.Equal(t, season.Teams, next.Teams)
+	assert.Equal(t, season.Substitutions, next.Substitutions)
+	assert.Equal(t, season.TieBreakers, next.TieBreakers)
+	assert.Equal(t, season.Qualification, next.Qualification)
+	assert.Empty(t, next.Groups)
+	assert.Empty(t, next.DrawSeed)
+	assert.Empty(t, next.Squads)
+
+	next.Teams[0].ID = "t9"
+	next.Qualification.PerGroup = 1
+	assert.Equal(t, "t1", season.Teams[0].ID)
+	assert.Equal(t, 2, season.Qualification.PerGroup)
+
+	assert.Equal(t, "Premier League 2027/28", season.CloneSeason("Premier League 2027/28", "2027/28").Name)
+}
+
+func TestInSeason(t *testing.T) {
+	season := Tournament{CompetitionID: "pl", Season: "2026/27"}
+
+	assert.True(t, season.IsSeasonOf("pl"))
+	assert.False(t, season.IsSeasonOf("la-liga"))
+	assert.False(t, Tournament{}.IsSeasonOf(""))
+
+	assert.True(t, season.InSeason("", ""))
+	assert.True(t, season.InSeason("pl", ""))
+	assert.True(t, season.InSeason("pl", "2026/27"))
+	assert.True(t, season.InSeason("", "2026/27"))
+	assert.False(t, season.InSeason("pl", "2025/26"))
+	assert.False(t, season.InSeason("la-liga", ""))
+}
+
+func TestSquad(t *testing.T) {
+	season := drawTournament(2)
+	assert.Nil(t, season.Squad("t1"))
+
+	season.SetSquad(Squad{TeamID: "t1", Players: []string{"p1", "p2"}})
+	season.SetSquad(Squad{TeamID: "t2", Players: []string{"p3"}})
+	season.SetSquad(Squad{TeamID: "t1", Players: []string{"p4"}})
+	assert.Len(t, season.Squads, 2)
+
+	squad := season.Squad("t1")
+	assert.NotNil(t, squad)
+	assert.True(t, squad.HasPlayer("p4"))
+	assert.False(t, squad.HasPlayer("p1"))
+	assert.Nil(t, season.Squad("t3"))
+}
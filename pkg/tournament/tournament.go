@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+	"github.com/rafaelsanzio/go-flashscore/pkg/model"
 	"github.com/rafaelsanzio/go-flashscore/pkg/team"
 )
 
@@ -12,15 +13,42 @@ type TournamentRepo interface {
 	Insert(ctx context.Context, t Tournament) errs.AppError
 	Get(ctx context.Context, id string) (*Tournament, errs.AppError)
 	List(ctx context.Context) ([]Tournament, errs.AppError)
+	ListByCompetition(ctx context.Context, competitionID string) ([]Tournament, errs.AppError)
 	Update(ctx context.Context, t Tournament) (*Tournament, errs.AppError)
 	Delete(ctx context.Context, id string) errs.AppError
 }
 
 type Tournament struct {
-	ID      string `bson:"_id"`
-	Name    string
-	Teams   []team.Team
-	Created time.Time
+	ID            string `bson:"_id"`
+	Name          string
+	CompetitionID string `json:",omitempty" bson:",omitempty"`
+	Season        string `json:",omitempty" bson:",omitempty"`
+	Teams         []team.Team
+	Squads        []Squad `json:",omitempty" bson:",omitempty"`
+	Substitutions SubstitutionRules
+	TieBreakers   []model.TieBreaker `json:",omitempty" bson:",omitempty"`
+	Groups        []Group            `json:",omitempty" bson:",omitempty"`
+	DrawSeed      int64              `json:",omitempty" bson:",omitempty"`
+	Qualification *Qualification     `json:",omitempty" bson:",omitempty"`
+	Created       time.Time
+}
+
+// tie-breakers of a tournament that sets none
+var DefaultTieBreakers = []model.TieBreaker{
+	model.TieBreakerGoalDifference,
+	model.TieBreakerGoalsScored,
+	model.TieBreakerHeadToHead,
+	model.TieBreakerFairPlay,
+}
+
+// SubstitutionRules limits the substitutions of each team in a match, a zero
+// maximum means no limit. Substitutions at halftime don't use a window.
+type SubstitutionRules struct {
+	MaxSubstitutions        int
+	MaxWindows              int
+	ExtraTimeSubstitutions  int
+	ExtraTimeWindows        int
+	ConcussionSubstitutions int
 }
 
 func (t Tournament) GetID() string {
@@ -43,3 +71,11 @@ func (t *Tournament) FindTeam(teamID string) bool {
 	}
 	return false
 }
+
+// GetTieBreakers returns the order teams level on points are ranked by.
+func (t Tournament) GetTieBreakers() []model.TieBreaker {
+	if len(t.TieBreakers) == 0 {
+		return DefaultTieBreakers
+	}
+	return t.TieBreakers
+}
@@ -0,0 +1,40 @@
+package venue
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelsanzio/go-flashscore/pkg/errs"
+)
+
+type VenueRepo interface {
+	Insert(ctx context.Context, v Venue) errs.AppError
+	Get(ctx context.Context, id string) (*Venue, errs.AppError)
+	List(ctx context.Context) ([]Venue, errs.AppError)
+	Update(ctx context.Context, v Venue) (*Venue, errs.AppError)
+	Delete(ctx context.Context, id string) errs.AppError
+}
+
+type Venue struct {
+	ID          string `bson:"_id"`
+	Name        string
+	City        string
+	Country     string
+	Capacity    int
+	TimeZone    string `json:",omitempty" bson:",omitempty"`
+	Coordinates Coordinates
+	Created     time.Time
+}
+
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func (v Venue) GetID() string {
+	return v.ID
+}
+
+func (v *Venue) SetID(id string) {
+	v.ID = id
+}